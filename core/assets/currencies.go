@@ -25,6 +25,9 @@ func format(i *big.Int, precision int) string {
 	return fmt.Sprintf("%v", r.FloatString(precision))
 }
 
+// LinkDecimals is the number of decimals of the canonical LINK token
+const LinkDecimals = 18
+
 // Link contains a field to represent the smallest units of LINK
 type Link big.Int
 
@@ -46,7 +49,32 @@ func (l *Link) Link() string {
 	if l == nil {
 		return "0"
 	}
-	return format((*big.Int)(l), 18)
+	return format((*big.Int)(l), LinkDecimals)
+}
+
+// Format returns Link formatted as a string in LINK units, for a LINK token
+// with the given number of decimals
+func (l *Link) Format(decimals uint8) string {
+	if l == nil {
+		return "0"
+	}
+	return format((*big.Int)(l), int(decimals))
+}
+
+// Rescale converts an amount of a LINK token with fromDecimals decimals to
+// the equivalent amount of one with toDecimals decimals. Precision below the
+// smallest unit of the target token is truncated.
+func (l *Link) Rescale(fromDecimals, toDecimals uint8) *Link {
+	if l == nil {
+		return nil
+	}
+	i := new(big.Int).Set((*big.Int)(l))
+	if toDecimals > fromDecimals {
+		i.Mul(i, getDenominator(int(toDecimals-fromDecimals)))
+	} else if fromDecimals > toDecimals {
+		i.Quo(i, getDenominator(int(fromDecimals-toDecimals)))
+	}
+	return (*Link)(i)
 }
 
 // SetInt64 delegates to *big.Int.SetInt64
@@ -203,6 +231,12 @@ func (e *Eth) String() string {
 	return format(e.ToInt(), 18)
 }
 
+// Format returns the amount formatted in whole units of a native token with
+// the given number of decimals
+func (e *Eth) Format(decimals uint8) string {
+	return format(e.ToInt(), int(decimals))
+}
+
 // SetInt64 delegates to *big.Int.SetInt64
 func (e *Eth) SetInt64(w int64) *Eth {
 	return (*Eth)(e.ToInt().SetInt64(w))
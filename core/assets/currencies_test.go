@@ -52,6 +52,23 @@ func TestAssets_NewLinkAndLink(t *testing.T) {
 	assert.Equal(t, "0", nilLink.Link())
 }
 
+func TestAssets_Link_FormatAndRescale(t *testing.T) {
+	t.Parallel()
+
+	link := assets.NewLink(123456789)
+	assert.Equal(t, link.Link(), link.Format(assets.LinkDecimals))
+	assert.Equal(t, "1.23456789", link.Format(8))
+
+	assert.Equal(t, "123456789", link.Rescale(8, 8).String())
+	assert.Equal(t, "1234567890000000000", link.Rescale(8, 18).String())
+	assert.Equal(t, "1", link.Rescale(8, 0).String())
+	assert.Equal(t, "123456789", link.String(), "Rescale must not modify the receiver")
+
+	var nilLink *assets.Link
+	assert.Equal(t, "0", nilLink.Format(8))
+	assert.Nil(t, nilLink.Rescale(18, 8))
+}
+
 func TestAssets_Link_MarshalJson(t *testing.T) {
 	t.Parallel()
 
@@ -104,6 +121,16 @@ func TestAssets_NewEthAndString(t *testing.T) {
 	assert.Equal(t, "115792089237316195423570985008687907853269984665640564039457.584007913129639936", eth.String())
 }
 
+func TestAssets_Eth_Format(t *testing.T) {
+	t.Parallel()
+
+	eth := assets.NewEth(123456789)
+
+	assert.Equal(t, eth.String(), eth.Format(18))
+	assert.Equal(t, "1.23456789", eth.Format(8))
+	assert.Equal(t, "123456789", eth.Format(0))
+}
+
 func TestAssets_Eth_IsZero(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,182 @@
+package chains
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// ChainCfgVersion is the current schema version of ChainCfg. Bump it and
+// append to chainCfgUpgrades whenever a stored field is renamed or reshaped.
+const ChainCfgVersion = 2
+
+// chainCfgVersionKey holds the schema version inside the stored JSON, so that
+// Scan can upgrade a row without reading any other column
+const chainCfgVersionKey = "cfgVersion"
+
+// chainCfgUpgrades[i] upgrades a ChainCfg from version i+1 to version i+2
+var chainCfgUpgrades = []func(fields map[string]json.RawMessage){
+	// v1 -> v2: EthGasPriceDefault was renamed to EvmGasPriceDefault
+	func(fields map[string]json.RawMessage) {
+		renameChainCfgField(fields, "EthGasPriceDefault", "EvmGasPriceDefault")
+	},
+}
+
+// ChainCfg is the per-chain configuration stored in evm_chains.cfg, keyed by
+// config field name. Rows written by an older version are upgraded to
+// ChainCfgVersion on load.
+type ChainCfg struct {
+	Version int
+	Fields  map[string]json.RawMessage
+	// Upgraded is set by Scan if the stored row was an older version, so
+	// that the upgraded cfg can be written back
+	Upgraded bool
+}
+
+// Scan reads the database value, upgrading it to ChainCfgVersion if needed
+func (c *ChainCfg) Scan(value interface{}) error {
+	var b []byte
+	switch v := value.(type) {
+	case string:
+		b = []byte(v)
+	case []byte:
+		b = v
+	default:
+		return fmt.Errorf("unable to convert %v of %T to ChainCfg", value, value)
+	}
+
+	fields := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(b, &fields); err != nil {
+		return errors.Wrap(err, "unable to unmarshal ChainCfg")
+	}
+	// Rows written before versioning was introduced are version 1
+	version := 1
+	if raw, ok := fields[chainCfgVersionKey]; ok {
+		if err := json.Unmarshal(raw, &version); err != nil {
+			return errors.Wrapf(err, "invalid ChainCfg %s", chainCfgVersionKey)
+		}
+		delete(fields, chainCfgVersionKey)
+	}
+	if version < 1 || version > ChainCfgVersion {
+		return errors.Errorf("unsupported ChainCfg version %d, this node supports versions 1 to %d", version, ChainCfgVersion)
+	}
+	upgraded := version < ChainCfgVersion
+	for ; version < ChainCfgVersion; version++ {
+		chainCfgUpgrades[version-1](fields)
+	}
+
+	*c = ChainCfg{Version: version, Fields: fields, Upgraded: upgraded}
+	return nil
+}
+
+// Value returns the database representation, stamped with ChainCfgVersion
+func (c ChainCfg) Value() (driver.Value, error) {
+	fields := make(map[string]json.RawMessage, len(c.Fields)+1)
+	for k, v := range c.Fields {
+		fields[k] = v
+	}
+	fields[chainCfgVersionKey] = json.RawMessage(fmt.Sprint(ChainCfgVersion))
+	return json.Marshal(fields)
+}
+
+func renameChainCfgField(fields map[string]json.RawMessage, from, to string) {
+	v, ok := fields[from]
+	if !ok {
+		return
+	}
+	delete(fields, from)
+	// Never clobber a value already written under the new name
+	if _, exists := fields[to]; !exists {
+		fields[to] = v
+	}
+}
+
+// NullDuration is a nullable time.Duration as persisted in a ChainCfg field.
+// It is written as a duration string such as "1m30s", and also reads a plain
+// number of nanoseconds. It is the single representation used for every
+// persisted duration field.
+type NullDuration struct {
+	Duration time.Duration
+	Valid    bool
+}
+
+// NewNullDuration returns a valid NullDuration holding d
+func NewNullDuration(d time.Duration) NullDuration {
+	return NullDuration{Duration: d, Valid: true}
+}
+
+// MarshalText implements encoding.TextMarshaler. A null duration is empty.
+func (d NullDuration) MarshalText() ([]byte, error) {
+	if !d.Valid {
+		return []byte{}, nil
+	}
+	return []byte(d.Duration.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Empty text is null.
+func (d *NullDuration) UnmarshalText(text []byte) error {
+	s := string(text)
+	if s == "" {
+		*d = NullDuration{}
+		return nil
+	}
+	if ns, err := strconv.ParseInt(s, 10, 64); err == nil {
+		*d = NewNullDuration(time.Duration(ns))
+		return nil
+	}
+	v, err := time.ParseDuration(s)
+	if err != nil {
+		return errors.Wrapf(err, "invalid duration %q", s)
+	}
+	*d = NewNullDuration(v)
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler
+func (d NullDuration) MarshalJSON() ([]byte, error) {
+	if !d.Valid {
+		return []byte("null"), nil
+	}
+	return json.Marshal(d.Duration.String())
+}
+
+// UnmarshalJSON implements json.Unmarshaler
+func (d *NullDuration) UnmarshalJSON(input []byte) error {
+	if string(input) == "null" {
+		*d = NullDuration{}
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(input, &s); err != nil {
+		// Not a string, so it should be a number of nanoseconds
+		s = string(input)
+	}
+	return d.UnmarshalText([]byte(s))
+}
+
+// Scan reads a JSONB value
+func (d *NullDuration) Scan(value interface{}) error {
+	switch v := value.(type) {
+	case nil:
+		*d = NullDuration{}
+		return nil
+	case string:
+		return d.UnmarshalJSON([]byte(v))
+	case []byte:
+		return d.UnmarshalJSON(v)
+	default:
+		return fmt.Errorf("unable to convert %v of %T to NullDuration", value, value)
+	}
+}
+
+// Value returns the JSONB representation
+func (d NullDuration) Value() (driver.Value, error) {
+	if !d.Valid {
+		return nil, nil
+	}
+	return d.MarshalJSON()
+}
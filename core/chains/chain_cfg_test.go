@@ -0,0 +1,134 @@
+package chains_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/core/chains"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChainCfg_Scan(t *testing.T) {
+	t.Parallel()
+
+	t.Run("upgrades unversioned cfg", func(t *testing.T) {
+		var cfg chains.ChainCfg
+		require.NoError(t, cfg.Scan(`{"EthGasPriceDefault": "5", "EvmMaxGasPriceWei": "10"}`))
+
+		assert.Equal(t, chains.ChainCfgVersion, cfg.Version)
+		assert.True(t, cfg.Upgraded)
+		assert.Equal(t, map[string]json.RawMessage{
+			"EvmGasPriceDefault": json.RawMessage(`"5"`),
+			"EvmMaxGasPriceWei":  json.RawMessage(`"10"`),
+		}, cfg.Fields)
+	})
+
+	t.Run("rename does not clobber the new field", func(t *testing.T) {
+		var cfg chains.ChainCfg
+		require.NoError(t, cfg.Scan([]byte(`{"cfgVersion": 1, "EthGasPriceDefault": "5", "EvmGasPriceDefault": "7"}`)))
+
+		assert.Equal(t, map[string]json.RawMessage{
+			"EvmGasPriceDefault": json.RawMessage(`"7"`),
+		}, cfg.Fields)
+	})
+
+	t.Run("current version is left untouched", func(t *testing.T) {
+		var cfg chains.ChainCfg
+		require.NoError(t, cfg.Scan(`{"cfgVersion": 2, "EthGasPriceDefault": "5"}`))
+
+		assert.False(t, cfg.Upgraded)
+		assert.Equal(t, map[string]json.RawMessage{
+			"EthGasPriceDefault": json.RawMessage(`"5"`),
+		}, cfg.Fields)
+	})
+
+	t.Run("rejects versions from the future", func(t *testing.T) {
+		var cfg chains.ChainCfg
+		err := cfg.Scan(`{"cfgVersion": 99}`)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "unsupported ChainCfg version 99")
+	})
+}
+
+func TestChainCfg_Value(t *testing.T) {
+	t.Parallel()
+
+	cfg := chains.ChainCfg{Fields: map[string]json.RawMessage{
+		"EvmMaxGasPriceWei": json.RawMessage(`"10"`),
+	}}
+	v, err := cfg.Value()
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"cfgVersion": 2, "EvmMaxGasPriceWei": "10"}`, string(v.([]byte)))
+
+	var roundtrip chains.ChainCfg
+	require.NoError(t, roundtrip.Scan(v))
+	assert.Equal(t, chains.ChainCfgVersion, roundtrip.Version)
+	assert.Equal(t, cfg.Fields, roundtrip.Fields)
+}
+
+func TestNullDuration(t *testing.T) {
+	t.Parallel()
+
+	t.Run("round-trips through JSON", func(t *testing.T) {
+		for _, d := range []chains.NullDuration{
+			chains.NewNullDuration(90 * time.Second),
+			chains.NewNullDuration(0),
+			{},
+		} {
+			b, err := json.Marshal(d)
+			require.NoError(t, err)
+
+			var roundtrip chains.NullDuration
+			require.NoError(t, json.Unmarshal(b, &roundtrip))
+			assert.Equal(t, d, roundtrip)
+		}
+	})
+
+	t.Run("round-trips through the DB", func(t *testing.T) {
+		for _, d := range []chains.NullDuration{
+			chains.NewNullDuration(90 * time.Second),
+			{},
+		} {
+			v, err := d.Value()
+			require.NoError(t, err)
+
+			var roundtrip chains.NullDuration
+			require.NoError(t, roundtrip.Scan(v))
+			assert.Equal(t, d, roundtrip)
+		}
+	})
+
+	t.Run("round-trips through text", func(t *testing.T) {
+		for _, d := range []chains.NullDuration{
+			chains.NewNullDuration(90 * time.Second),
+			{},
+		} {
+			b, err := d.MarshalText()
+			require.NoError(t, err)
+
+			var roundtrip chains.NullDuration
+			require.NoError(t, roundtrip.UnmarshalText(b))
+			assert.Equal(t, d, roundtrip)
+		}
+	})
+
+	t.Run("reads a number of nanoseconds", func(t *testing.T) {
+		var d chains.NullDuration
+		require.NoError(t, json.Unmarshal([]byte(`60000000000`), &d))
+		assert.Equal(t, chains.NewNullDuration(time.Minute), d)
+	})
+
+	t.Run("is written as a duration string", func(t *testing.T) {
+		b, err := json.Marshal(chains.NewNullDuration(90 * time.Second))
+		require.NoError(t, err)
+		assert.Equal(t, `"1m30s"`, string(b))
+	})
+
+	t.Run("rejects garbage", func(t *testing.T) {
+		var d chains.NullDuration
+		assert.Error(t, json.Unmarshal([]byte(`"soon"`), &d))
+		assert.Error(t, d.Scan(42))
+	})
+}
@@ -15,9 +15,12 @@ import (
 
 // Chain represents a blockchain with a unique Chain ID
 type Chain struct {
-	id      *big.Int
-	config  ChainSpecificConfig
-	logOnce sync.Once
+	id     *big.Int
+	config ChainSpecificConfig
+
+	// resolved caches the defaults for chains without a config set of their own
+	resolveOnce sync.Once
+	resolved    ChainSpecificConfig
 }
 
 func (c *Chain) setChainID(id int64) {
@@ -30,22 +33,108 @@ func (c *Chain) ID() *big.Int {
 
 func (c *Chain) Config() ChainSpecificConfig {
 	if !c.config.set {
-		c.logOnce.Do(func() {
-			logger.Warnf("chain with ID %s does not have a chain-specific config, using fallback config instead", c.ID())
+		c.resolveOnce.Do(func() {
+			var fallback bool
+			c.resolved, fallback = resolveDefaultSet(c.ID())
+			if fallback {
+				logger.Warnw(fmt.Sprintf("chain with ID %s does not have a chain-specific config, using fallback config instead", c.ID()), "evmChainID", c.ID().String())
+			}
 		})
-		return FallbackConfig
+		return c.resolved
 	}
 	return c.config
 }
 
 // IsArbitrum returns true if the chain is arbitrum mainnet or testnet
 func (c *Chain) IsArbitrum() bool {
-	return c.ID().Cmp(ArbitrumMainnet.ID()) == 0 || c.ID().Cmp(ArbitrumRinkeby.ID()) == 0
+	return c.ID().Cmp(ArbitrumMainnet.ID()) == 0 || c.ID().Cmp(ArbitrumRinkeby.ID()) == 0 || isArbitrumFamily(c.ID())
 }
 
 // IsOptimism returns true if the chain is optimism mainnet or testnet
 func (c *Chain) IsOptimism() bool {
-	return c.ID().Cmp(OptimismMainnet.ID()) == 0 || c.ID().Cmp(OptimismKovan.ID()) == 0
+	return c.ID().Cmp(OptimismMainnet.ID()) == 0 || c.ID().Cmp(OptimismKovan.ID()) == 0 || isOptimismFamily(c.ID())
+}
+
+// Networks belonging to the Optimism and Arbitrum families that have no config
+// set of their own. They get the L2 defaults of their family rather than the
+// generic fallback, which would be wrong for an L2.
+var (
+	optimismFamilyIDs = map[int64]struct{}{
+		420:      {}, // Optimism Goerli
+		11155420: {}, // Optimism Sepolia
+	}
+	arbitrumFamilyIDs = map[int64]struct{}{
+		42170:  {}, // Arbitrum Nova
+		421613: {}, // Arbitrum Goerli
+		421614: {}, // Arbitrum Sepolia
+	}
+)
+
+// Chain IDs used by local development chains, i.e. Geth in dev mode and
+// Hardhat
+var simulatedIDs = map[int64]struct{}{
+	1337:  {},
+	31337: {},
+}
+
+// IsSimulated returns true if id belongs to a local development chain, which
+// gets the simulated defaults rather than the generic fallback
+func IsSimulated(id *big.Int) bool {
+	if !id.IsInt64() {
+		return false
+	}
+	_, ok := simulatedIDs[id.Int64()]
+	return ok
+}
+
+// IsSimulated returns true if the chain is a local development chain
+func (c *Chain) IsSimulated() bool {
+	return IsSimulated(c.ID())
+}
+
+func isOptimismFamily(id *big.Int) bool {
+	if !id.IsInt64() {
+		return false
+	}
+	_, ok := optimismFamilyIDs[id.Int64()]
+	return ok
+}
+
+func isArbitrumFamily(id *big.Int) bool {
+	if !id.IsInt64() {
+		return false
+	}
+	_, ok := arbitrumFamilyIDs[id.Int64()]
+	return ok
+}
+
+// resolveDefaultSet returns the built-in defaults for the given chain ID. It
+// checks for a chain-specific set first, then for a known L2 family or a
+// simulated chain, and finally uses FallbackConfig. The boolean is true if FallbackConfig was used.
+func resolveDefaultSet(id *big.Int) (ChainSpecificConfig, bool) {
+	if id.IsInt64() {
+		chainsMu.RLock()
+		chain, exists := chains[id.Int64()]
+		chainsMu.RUnlock()
+		if exists && chain.config.set {
+			return chain.config, false
+		}
+	}
+	var family ChainSpecificConfig
+	switch {
+	case IsSimulated(id):
+		return simulatedConfig, false
+	case isOptimismFamily(id):
+		family = OptimismMainnet.config
+	case isArbitrumFamily(id):
+		family = ArbitrumMainnet.config
+	default:
+		return FallbackConfig, true
+	}
+	// Contract addresses differ between networks of the same family
+	family.LinkContractAddress = ""
+	family.FlagsContractAddress = ""
+	return family, false
 }
 
 // IsL2 returns true if this chain is an L2 chain, notably that the block
@@ -107,23 +196,60 @@ func init() {
 	setConfigs()
 }
 
-var chainsMu sync.Mutex
+var (
+	chainsMu sync.RWMutex
+	// bigChains holds chains whose IDs do not fit in an int64, keyed by the
+	// decimal string of the ID. These are rare enough that the allocation on
+	// lookup does not matter.
+	bigChains = make(map[string]*Chain)
+)
 
 // ChainFromID returns the chain for the given ID
 // If no chain is found, creates a new one and returns that
+// This is on the hot path, so chain IDs that fit in an int64 are looked up
+// without allocating
 func ChainFromID(id *big.Int) *Chain {
 	if !id.IsInt64() {
-		panic(fmt.Sprintf("chain IDs larger than the max 64 bit integer are not currently supported, got: %s", id.String()))
+		return bigChainFromID(id)
+	}
+	key := id.Int64()
+	chainsMu.RLock()
+	chain, exists := chains[key]
+	chainsMu.RUnlock()
+	if exists {
+		return chain
 	}
 	chainsMu.Lock()
 	defer chainsMu.Unlock()
-	chain, exists := chains[id.Int64()]
+	if chain, exists = chains[key]; exists {
+		return chain
+	}
+	logger.Warnw(fmt.Sprintf("Chain ID %s is not known, falling back to generic chain", id), "evmChainID", id.String())
+	chain = new(Chain)
+	chain.id = id
+	chains[key] = chain
+	return chain
+}
+
+func bigChainFromID(id *big.Int) *Chain {
+	key := id.String()
+	chainsMu.Lock()
+	defer chainsMu.Unlock()
+	chain, exists := bigChains[key]
 	if exists {
 		return chain
 	}
-	logger.Warnf("Chain ID %s is not known, falling back to generic chain", id)
+	logger.Warnw(fmt.Sprintf("Chain ID %s is not known, falling back to generic chain", id), "evmChainID", id.String())
 	chain = new(Chain)
 	chain.id = id
-	chains[id.Int64()] = chain
+	bigChains[key] = chain
 	return chain
 }
+
+// DefaultsForChainID returns the built-in config defaults for the given chain
+// ID without registering it as a known chain. The boolean is true if there is
+// no chain-specific or L2 family set for this ID and FallbackConfig was
+// returned instead.
+func DefaultsForChainID(id *big.Int) (ChainSpecificConfig, bool) {
+	return resolveDefaultSet(id)
+}
@@ -29,4 +29,103 @@ func Test_ChainFromID(t *testing.T) {
 		assert.Equal(t, big.NewInt(98765), c3.ID())
 		assert.Equal(t, "", c3.Config().LinkContractAddress)
 	})
+	t.Run("supports chain IDs larger than int64", func(t *testing.T) {
+		id, _ := new(big.Int).SetString("123456789012345678901234567890", 10)
+		c := chains.ChainFromID(id)
+
+		assert.Equal(t, id, c.ID())
+		assert.Equal(t, "", c.Config().LinkContractAddress)
+
+		id2, _ := new(big.Int).SetString("123456789012345678901234567890", 10)
+		c2 := chains.ChainFromID(id2)
+
+		assert.Same(t, c, c2)
+	})
+}
+
+func Test_IsSimulated(t *testing.T) {
+	assert.True(t, chains.IsSimulated(big.NewInt(1337)))
+	assert.True(t, chains.IsSimulated(big.NewInt(31337)))
+	assert.False(t, chains.IsSimulated(big.NewInt(1)))
+	assert.False(t, chains.IsSimulated(big.NewInt(0)))
+
+	c := chains.ChainFromID(big.NewInt(1337))
+	assert.True(t, c.IsSimulated())
+	cfg := c.Config()
+	assert.Equal(t, uint(1), cfg.FinalityDepth)
+	assert.Equal(t, uint32(1), cfg.MinIncomingConfirmations)
+	assert.Equal(t, "FixedPrice", cfg.GasEstimatorMode)
+	assert.Equal(t, uint64(0), cfg.GasBumpThreshold)
+	assert.Equal(t, int64(0), cfg.MinGasPriceWei.Int64())
+
+	hardhat, fallback := chains.DefaultsForChainID(big.NewInt(31337))
+	assert.False(t, fallback)
+	assert.Equal(t, cfg, hardhat)
+}
+
+func BenchmarkChainFromID(b *testing.B) {
+	b.Run("int64 chain ID", func(b *testing.B) {
+		id := big.NewInt(137)
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			chains.ChainFromID(id)
+		}
+	})
+	b.Run("chain ID larger than int64", func(b *testing.B) {
+		id, _ := new(big.Int).SetString("123456789012345678901234567890", 10)
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			chains.ChainFromID(id)
+		}
+	})
+}
+
+func Test_DefaultsForChainID(t *testing.T) {
+	t.Run("returns the chain-specific defaults for a known chain", func(t *testing.T) {
+		cfg, fallback := chains.DefaultsForChainID(big.NewInt(137))
+
+		assert.False(t, fallback)
+		assert.Equal(t, chains.PolygonMainnet.Config(), cfg)
+	})
+	t.Run("returns the fallback defaults for an unknown chain", func(t *testing.T) {
+		cfg, fallback := chains.DefaultsForChainID(big.NewInt(123456789))
+
+		assert.True(t, fallback)
+		assert.Equal(t, chains.FallbackConfig, cfg)
+	})
+	t.Run("returns the fallback defaults for a chain ID exceeding int64", func(t *testing.T) {
+		id, _ := new(big.Int).SetString("99999999999999999999", 10)
+		cfg, fallback := chains.DefaultsForChainID(id)
+
+		assert.True(t, fallback)
+		assert.Equal(t, chains.FallbackConfig, cfg)
+	})
+}
+
+func Test_DefaultsForChainID_L2Families(t *testing.T) {
+	t.Run("unknown Optimism network resolves to Optimism defaults", func(t *testing.T) {
+		cfg, fallback := chains.DefaultsForChainID(big.NewInt(420))
+
+		assert.False(t, fallback)
+		assert.Equal(t, chains.OptimismMainnet.Config().GasEstimatorMode, cfg.GasEstimatorMode)
+		assert.Equal(t, chains.OptimismMainnet.Config().FinalityDepth, cfg.FinalityDepth)
+		assert.Equal(t, chains.OptimismMainnet.Config().L1FinalityDepth, cfg.L1FinalityDepth)
+		assert.Equal(t, "", cfg.LinkContractAddress)
+		assert.NotEqual(t, chains.FallbackConfig.GasEstimatorMode, cfg.GasEstimatorMode)
+	})
+	t.Run("unknown Arbitrum network resolves to Arbitrum defaults", func(t *testing.T) {
+		cfg, fallback := chains.DefaultsForChainID(big.NewInt(421613))
+
+		assert.False(t, fallback)
+		assert.Equal(t, chains.ArbitrumMainnet.Config().GasEstimatorMode, cfg.GasEstimatorMode)
+		assert.Equal(t, chains.ArbitrumMainnet.Config().GasPriceDefault, cfg.GasPriceDefault)
+		assert.Equal(t, "", cfg.LinkContractAddress)
+	})
+	t.Run("chain for an unknown family member uses the family defaults", func(t *testing.T) {
+		c := chains.ChainFromID(big.NewInt(11155420))
+
+		assert.True(t, c.IsOptimism())
+		assert.True(t, c.IsL2())
+		assert.Equal(t, "Optimism", c.Config().GasEstimatorMode)
+	})
 }
@@ -21,13 +21,18 @@ type (
 		EthTxReaperInterval                        time.Duration
 		EthTxReaperThreshold                       time.Duration
 		EthTxResendAfterThreshold                  time.Duration
+		EIP1559DynamicFees                         bool
 		FinalityDepth                              uint
+		FinalityTagSupported                       bool
 		FlagsContractAddress                       string
+		ForceTxType                                int
+		GasBumpOverflowProtection                  bool
 		GasBumpPercent                             uint16
 		GasBumpThreshold                           uint64
 		GasBumpTxDepth                             uint16
 		GasBumpWei                                 big.Int
 		GasEstimatorMode                           string
+		GasEstimatorRequireWarmup                  bool
 		GasLimitDefault                            uint64
 		GasLimitMultiplier                         float32
 		GasLimitTransfer                           uint64
@@ -35,7 +40,10 @@ type (
 		HeadTrackerHistoryDepth                    uint
 		HeadTrackerMaxBufferSize                   uint
 		HeadTrackerSamplingInterval                time.Duration
+		IncomingConfirmationsFinalityFraction      float64
+		L1FinalityDepth                            uint
 		LinkContractAddress                        string
+		LinkDecimals                               uint8
 		LogBackfillBatchSize                       uint32
 		MaxGasPriceWei                             big.Int
 		MaxInFlightTransactions                    uint32
@@ -44,9 +52,18 @@ type (
 		MinIncomingConfirmations                   uint32
 		MinRequiredOutgoingConfirmations           uint64
 		MinimumContractPayment                     *assets.Link
+		NativeTokenDecimals                        uint8
+		NativeTokenSymbol                          string
+		NodeRateLimitBurst                         uint32
+		NodeRateLimitRPS                           float64
+		NodeWSReconnectMaxBackoff                  time.Duration
+		NodeWSReconnectMinBackoff                  time.Duration
 		NonceAutoSync                              bool
 		OCRContractConfirmations                   uint16
 		RPCDefaultBatchSize                        uint32
+		RequireEIP155                              bool
+		SimulateTransactionsBeforeSend             bool
+		UseFinalityTag                             bool
 		set                                        bool
 	}
 )
@@ -55,6 +72,9 @@ type (
 // It can be overridden on a per-chain basis and may be used if the chain is unknown
 var FallbackConfig ChainSpecificConfig
 
+// simulatedConfig holds the defaults for every chain ID that IsSimulated
+var simulatedConfig ChainSpecificConfig
+
 func setConfigs() {
 	// --------------------------IMPORTANT---------------------------
 	// All config sets should "inherit" from FallbackConfig and overwrite
@@ -78,12 +98,17 @@ func setConfigs() {
 		EthTxReaperInterval:                        1 * time.Hour,
 		EthTxReaperThreshold:                       168 * time.Hour,
 		EthTxResendAfterThreshold:                  1 * time.Minute,
+		EIP1559DynamicFees:                         false,
 		FinalityDepth:                              50,
+		FinalityTagSupported:                       false,
+		ForceTxType:                                -1,
+		GasBumpOverflowProtection:                  true,
 		GasBumpPercent:                             20,
 		GasBumpThreshold:                           3,
 		GasBumpTxDepth:                             10,
 		GasBumpWei:                                 *assets.GWei(5),
 		GasEstimatorMode:                           "BlockHistory",
+		GasEstimatorRequireWarmup:                  false,
 		GasLimitDefault:                            500000,
 		GasLimitMultiplier:                         1.0,
 		GasLimitTransfer:                           21000,
@@ -91,7 +116,10 @@ func setConfigs() {
 		HeadTrackerHistoryDepth:                    100,
 		HeadTrackerMaxBufferSize:                   3,
 		HeadTrackerSamplingInterval:                1 * time.Second,
+		IncomingConfirmationsFinalityFraction:      0,
+		L1FinalityDepth:                            0,
 		LinkContractAddress:                        "",
+		LinkDecimals:                               18,
 		LogBackfillBatchSize:                       100,
 		MaxGasPriceWei:                             *assets.GWei(5000),
 		MaxInFlightTransactions:                    16,
@@ -100,9 +128,18 @@ func setConfigs() {
 		MinIncomingConfirmations:                   3,
 		MinRequiredOutgoingConfirmations:           12,
 		MinimumContractPayment:                     assets.NewLink(100000000000000), // 0.0001 LINK
+		NativeTokenDecimals:                        18,
+		NativeTokenSymbol:                          "ETH",
+		NodeRateLimitBurst:                         1,
+		NodeRateLimitRPS:                           0,
+		NodeWSReconnectMaxBackoff:                  10 * time.Second,
+		NodeWSReconnectMinBackoff:                  1 * time.Second,
 		NonceAutoSync:                              true,
 		OCRContractConfirmations:                   4,
 		RPCDefaultBatchSize:                        100,
+		RequireEIP155:                              true,
+		SimulateTransactionsBeforeSend:             false,
+		UseFinalityTag:                             false,
 		set:                                        true,
 	}
 
@@ -118,6 +155,13 @@ func setConfigs() {
 	goerli.LinkContractAddress = "0x326c977e6efc84e512bb9c30f76e30c160ed06fb"
 	rinkeby := mainnet
 	rinkeby.LinkContractAddress = "0x01BE23585060835E02B77ef475b0Cc51aA1e0709"
+	// Ethereum serves the `finalized` block tag since the Merge, which Kovan
+	// and Rinkeby never went through
+	mainnet.FinalityTagSupported = true
+	goerli.FinalityTagSupported = true
+	// Reverted transactions are expensive on Ethereum mainnet, so it is worth
+	// an eth_call to catch them before sending
+	mainnet.SimulateTransactionsBeforeSend = true
 
 	// xDai currently uses AuRa (like Parity) consensus so finality rules will be similar to parity
 	// See: https://www.poa.network/for-users/whitepaper/poadao-v1/proof-of-authority
@@ -132,6 +176,7 @@ func setConfigs() {
 	xDaiMainnet.MinGasPriceWei = *assets.GWei(1) // 1 Gwei is the minimum accepted by the validators (unless whitelisted)
 	xDaiMainnet.MaxGasPriceWei = *assets.GWei(500)
 	xDaiMainnet.LinkContractAddress = "0xE2e73A1c69ecF83F464EFCE6A5be353a37cA09b2"
+	xDaiMainnet.NativeTokenSymbol = "XDAI"
 
 	// BSC uses Clique consensus with ~3s block times
 	// Clique offers finality within (N/2)+1 blocks where N is number of signers
@@ -152,8 +197,12 @@ func setConfigs() {
 	bscMainnet.LinkContractAddress = "0x404460c6a5ede2d891e8297795264fde62adbb75"
 	bscMainnet.MinIncomingConfirmations = 3
 	bscMainnet.MinRequiredOutgoingConfirmations = 12
+	// BSC has no EIP-1559 fee market, so never send it dynamic fee transactions
+	bscMainnet.ForceTxType = 0
+	bscMainnet.NativeTokenSymbol = "BNB"
 
 	hecoMainnet := bscMainnet
+	hecoMainnet.NativeTokenSymbol = "HT"
 
 	// Polygon has a 1s block time and looser finality guarantees than ereum.
 	// Re-orgs have been observed at 64 blocks or even deeper
@@ -174,6 +223,7 @@ func setConfigs() {
 	polygonMainnet.LinkContractAddress = "0xb0897686c545045afc77cf20ec7a532e3120e0f1"
 	polygonMainnet.MinIncomingConfirmations = 5
 	polygonMainnet.MinRequiredOutgoingConfirmations = 12
+	polygonMainnet.NativeTokenSymbol = "MATIC"
 	polygonMumbai := polygonMainnet
 	polygonMumbai.LinkContractAddress = "0x326C977E6efc84E512bB9C30f76E30c160eD06FB"
 
@@ -189,6 +239,7 @@ func setConfigs() {
 	arbitrumMainnet.BlockHistoryEstimatorBlockHistorySize = 0 // Force an error if someone set GAS_UPDATER_ENABLED=true by accident; we never want to run the block history estimator on arbitrum
 	arbitrumMainnet.LinkContractAddress = "0xf97f4df75117a78c1A5a0DBb814Af92458539FB4"
 	arbitrumMainnet.OCRContractConfirmations = 1
+	arbitrumMainnet.L1FinalityDepth = mainnet.FinalityDepth // Transactions are final once the batch posted to L1 is final on L1
 	arbitrumRinkeby := arbitrumMainnet
 	arbitrumRinkeby.LinkContractAddress = "0x615fBe6372676474d9e6933d310469c9b68e9726"
 
@@ -207,6 +258,7 @@ func setConfigs() {
 	optimismMainnet.MinIncomingConfirmations = 1
 	optimismMainnet.MinRequiredOutgoingConfirmations = 0
 	optimismMainnet.OCRContractConfirmations = 1
+	optimismMainnet.L1FinalityDepth = mainnet.FinalityDepth // Transactions are final once the batch posted to L1 is final on L1
 	optimismKovan := optimismMainnet
 	optimismKovan.LinkContractAddress = "0x4911b761993b9c8c0d14Ba2d86902AF6B0074F5B"
 	optimismKovan.BlockEmissionIdleWarningThreshold = 30 * time.Minute
@@ -217,6 +269,7 @@ func setConfigs() {
 	fantomMainnet.LinkContractAddress = "0x6f43ff82cca38001b6699a8ac47a2d0e66939407"
 	fantomMainnet.MinIncomingConfirmations = 3
 	fantomMainnet.MinRequiredOutgoingConfirmations = 2
+	fantomMainnet.NativeTokenSymbol = "FTM"
 	fantomTestnet := fantomMainnet
 	fantomTestnet.LinkContractAddress = "0xfafedb041c0dd4fa2dc0d87a6b0979ee6fa7af5f"
 
@@ -228,6 +281,7 @@ func setConfigs() {
 	rskMainnet.MinGasPriceWei = *big.NewInt(0)
 	rskMainnet.MinimumContractPayment = assets.NewLink(1000000000000000)
 	rskMainnet.LinkContractAddress = "0x14adae34bef7ca957ce2dde5add97ea050123827"
+	rskMainnet.NativeTokenSymbol = "RBTC"
 	rskTestnet := rskMainnet
 	rskTestnet.LinkContractAddress = "0x8bbbd80981fe76d44854d8df305e8985c19f0e78"
 
@@ -242,10 +296,25 @@ func setConfigs() {
 	avalancheMainnet.MinIncomingConfirmations = 1
 	avalancheMainnet.MinRequiredOutgoingConfirmations = 1
 	avalancheMainnet.OCRContractConfirmations = 1
+	avalancheMainnet.NativeTokenSymbol = "AVAX"
 
 	avalancheFuji := avalancheMainnet
 	avalancheFuji.LinkContractAddress = "0x0b9d5D9136855f6FEc3c0993feE6E9CE8a297846"
 
+	// Simulated chains (Geth dev mode, Hardhat) mine every transaction
+	// instantly and never re-org, and accept any gas price
+	simulated := FallbackConfig
+	simulated.BalanceMonitorBlockDelay = 0
+	simulated.FinalityDepth = 1
+	simulated.GasBumpThreshold = 0 // Never bump gas, transactions are mined instantly
+	simulated.GasEstimatorMode = "FixedPrice"
+	simulated.HeadTrackerHistoryDepth = 10
+	simulated.MinGasPriceWei = *big.NewInt(0)
+	simulated.MinIncomingConfirmations = 1
+	simulated.MinRequiredOutgoingConfirmations = 1
+	simulated.OCRContractConfirmations = 1
+	simulatedConfig = simulated
+
 	EthMainnet.config = mainnet
 	EthRinkeby.config = rinkeby
 	EthGoerli.config = goerli
@@ -91,9 +91,13 @@ func NewApp(client *Client) *cli.App {
 					Action: client.ReplayFromBlock,
 					Flags: []cli.Flag{
 						cli.IntFlag{
-							Name:  "block-number",
+							Name:  "block-number, block",
 							Usage: "Block number to replay from",
 						},
+						cli.StringFlag{
+							Name:  "chain",
+							Usage: "the chain ID to replay, defaults to the node's default chain",
+						},
 					},
 				},
 			},
@@ -141,6 +145,17 @@ func NewApp(client *Client) *cli.App {
 					Usage:  "Show the node's environment variables",
 					Action: client.GetConfiguration,
 				},
+				{
+					Name:   "defaults",
+					Usage:  "Show the built-in config defaults for a chain ID",
+					Action: client.ShowChainDefaults,
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:  "chain",
+							Usage: "the chain ID to show defaults for",
+						},
+					},
+				},
 				{
 					Name:   "setgasprice",
 					Usage:  "Set the minimum gas price to use for outgoing transactions",
@@ -631,6 +646,50 @@ func NewApp(client *Client) *cli.App {
 			},
 		},
 
+		{
+			Name:  "nodes",
+			Usage: "Commands for checking and pinning eth nodes",
+			Subcommands: []cli.Command{
+				{
+					Name:   "probe",
+					Usage:  "Dial an eth node and show its chain ID, client version and latest block, without adding it",
+					Action: client.ProbeNode,
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:  "ws-url",
+							Usage: "the websocket URL of the node",
+						},
+						cli.StringFlag{
+							Name:  "http-url",
+							Usage: "the optional HTTP URL of the node",
+						},
+					},
+				},
+				{
+					Name:   "pin",
+					Usage:  "Send all of a chain's traffic to the eth node with the given ID, 0 for the primary or 1 onwards for a secondary, until unpinned",
+					Action: client.PinNode,
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:  "chain",
+							Usage: "the chain ID, defaults to the node's default chain",
+						},
+					},
+				},
+				{
+					Name:   "unpin",
+					Usage:  "Spread a chain's traffic across its eth nodes again after pin",
+					Action: client.UnpinNode,
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:  "chain",
+							Usage: "the chain ID, defaults to the node's default chain",
+						},
+					},
+				},
+			},
+		},
+
 		{
 			Name:   "initiators",
 			Usage:  "Commands for managing External Initiators",
@@ -679,6 +738,28 @@ func NewApp(client *Client) *cli.App {
 					Usage:  "get information on a specific Ethereum Transaction",
 					Action: client.ShowTransaction,
 				},
+				{
+					Name:   "drain",
+					Usage:  "Stop accepting new Ethereum Transactions, while pending ones are still sent and confirmed",
+					Action: client.DrainTransactions,
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:  "chain",
+							Usage: "the chain ID to drain, defaults to the node's default chain",
+						},
+					},
+				},
+				{
+					Name:   "undrain",
+					Usage:  "Accept new Ethereum Transactions again after drain",
+					Action: client.UndrainTransactions,
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:  "chain",
+							Usage: "the chain ID to undrain, defaults to the node's default chain",
+						},
+					},
+				},
 			},
 		},
 	}...)
@@ -0,0 +1,75 @@
+package cmd
+
+import (
+	"fmt"
+	"math/big"
+	"reflect"
+	"strconv"
+
+	"github.com/pkg/errors"
+	"github.com/smartcontractkit/chainlink/core/chains"
+	clipkg "github.com/urfave/cli"
+)
+
+// ChainDefaultsPresenter presents the built-in config defaults for a chain ID
+type ChainDefaultsPresenter struct {
+	ChainID  string `json:"chainID"`
+	Fallback bool   `json:"fallback"`
+	chains.ChainSpecificConfig
+}
+
+// RenderTable implements TableRenderer
+func (p *ChainDefaultsPresenter) RenderTable(rt RendererTable) error {
+	headers := []string{"Chain ID", "Fallback"}
+	row := []string{p.ChainID, strconv.FormatBool(p.Fallback)}
+
+	v := reflect.ValueOf(&p.ChainSpecificConfig).Elem()
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.PkgPath != "" {
+			continue
+		}
+		headers = append(headers, field.Name)
+		row = append(row, formatChainDefault(v.Field(i)))
+	}
+
+	if _, err := rt.Write([]byte(fmt.Sprintf("⛓ Chain %s defaults\n", p.ChainID))); err != nil {
+		return err
+	}
+	renderList(headers, [][]string{row}, rt.Writer)
+	return nil
+}
+
+func formatChainDefault(field reflect.Value) string {
+	if field.Kind() == reflect.Ptr && field.IsNil() {
+		return ""
+	}
+	if stringer, ok := field.Addr().Interface().(fmt.Stringer); ok {
+		return stringer.String()
+	}
+	if stringer, ok := field.Interface().(fmt.Stringer); ok {
+		return stringer.String()
+	}
+	return fmt.Sprintf("%v", field.Interface())
+}
+
+// ShowChainDefaults renders the built-in config defaults for the chain ID
+// given by --chain. It does not need a running node.
+func (cli *Client) ShowChainDefaults(c *clipkg.Context) error {
+	chainID := c.String("chain")
+	if chainID == "" {
+		return cli.errorOut(errors.New("must pass the chain ID with --chain"))
+	}
+	id, ok := new(big.Int).SetString(chainID, 10)
+	if !ok {
+		return cli.errorOut(errors.Errorf("invalid chain ID: %s", chainID))
+	}
+
+	defaults, fallback := chains.DefaultsForChainID(id)
+	return cli.errorOut(cli.Render(&ChainDefaultsPresenter{
+		ChainID:             id.String(),
+		Fallback:            fallback,
+		ChainSpecificConfig: defaults,
+	}))
+}
@@ -0,0 +1,85 @@
+package cmd_test
+
+import (
+	"bytes"
+	"flag"
+	"testing"
+
+	"github.com/smartcontractkit/chainlink/core/chains"
+	"github.com/smartcontractkit/chainlink/core/cmd"
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli"
+)
+
+func TestChainDefaultsPresenter_RenderTable(t *testing.T) {
+	t.Parallel()
+
+	var (
+		buffer = bytes.NewBufferString("")
+		r      = cmd.RendererTable{Writer: buffer}
+	)
+
+	p := cmd.ChainDefaultsPresenter{
+		ChainID:             "137",
+		Fallback:            false,
+		ChainSpecificConfig: chains.PolygonMainnet.Config(),
+	}
+
+	require.NoError(t, p.RenderTable(r))
+
+	output := buffer.String()
+	assert.Contains(t, output, "137")
+	assert.Contains(t, output, "FinalityDepth")
+	assert.Contains(t, output, "200")
+	assert.Contains(t, output, chains.PolygonMainnet.Config().LinkContractAddress)
+}
+
+func TestClient_ShowChainDefaults(t *testing.T) {
+	t.Parallel()
+
+	r := &cltest.RendererMock{}
+	client := cmd.Client{Renderer: r}
+
+	t.Run("with a known chain", func(t *testing.T) {
+		set := flag.NewFlagSet("test", 0)
+		set.String("chain", "137", "")
+		c := cli.NewContext(nil, set, nil)
+
+		require.NoError(t, client.ShowChainDefaults(c))
+		require.Len(t, r.Renders, 1)
+		p := r.Renders[0].(*cmd.ChainDefaultsPresenter)
+		assert.Equal(t, "137", p.ChainID)
+		assert.False(t, p.Fallback)
+		assert.Equal(t, chains.PolygonMainnet.Config().FinalityDepth, p.FinalityDepth)
+	})
+
+	t.Run("with an unknown chain", func(t *testing.T) {
+		r.Renders = nil
+		set := flag.NewFlagSet("test", 0)
+		set.String("chain", "123456789", "")
+		c := cli.NewContext(nil, set, nil)
+
+		require.NoError(t, client.ShowChainDefaults(c))
+		require.Len(t, r.Renders, 1)
+		p := r.Renders[0].(*cmd.ChainDefaultsPresenter)
+		assert.True(t, p.Fallback)
+		assert.Equal(t, chains.FallbackConfig.FinalityDepth, p.FinalityDepth)
+	})
+
+	t.Run("with an invalid chain ID", func(t *testing.T) {
+		set := flag.NewFlagSet("test", 0)
+		set.String("chain", "notanumber", "")
+		c := cli.NewContext(nil, set, nil)
+
+		assert.Error(t, client.ShowChainDefaults(c))
+	})
+
+	t.Run("without a chain ID", func(t *testing.T) {
+		set := flag.NewFlagSet("test", 0)
+		c := cli.NewContext(nil, set, nil)
+
+		assert.Error(t, client.ShowChainDefaults(c))
+	})
+}
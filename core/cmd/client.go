@@ -70,20 +70,24 @@ type AppFactory interface {
 type ChainlinkAppFactory struct{}
 
 // NewApplication returns a new instance of the node with the given config.
-func (n ChainlinkAppFactory) NewApplication(config config.EVMConfig) (chainlink.Application, error) {
+func (n ChainlinkAppFactory) NewApplication(cfg config.EVMConfig) (chainlink.Application, error) {
 	var ethClient eth.Client
-	if config.EthereumDisabled() {
+	if cfg.EthereumDisabled() {
 		ethClient = &eth.NullClient{}
 	} else {
-		var err error
-		ethClient, err = eth.NewClient(config.EthereumURL(), config.EthereumHTTPURL(), config.EthereumSecondaryURLs())
+		// Rate limits and circuit breakers may be persisted, so NewApplication
+		// sets them once the DB is attached
+		client, err := eth.NewRateLimitedClient(cfg.ChainID(), cfg.EthereumURL(), cfg.EthereumHTTPURL(), cfg.EthereumSecondaryURLs(), 0, 0)
 		if err != nil {
 			return nil, err
 		}
+		client.SetSendOnlyNodeMinAccepts(cfg.SendOnlyNodeMinAccepts())
+		client.SetLowestLatencyRouting(cfg.NodeSelectionMode() == config.NodeSelectionModeLowestLatency)
+		ethClient = client
 	}
 
-	advisoryLock := postgres.NewAdvisoryLock(config.DatabaseURL())
-	return chainlink.NewApplication(config, ethClient, advisoryLock)
+	advisoryLock := postgres.NewAdvisoryLock(cfg.DatabaseURL())
+	return chainlink.NewApplication(cfg, ethClient, advisoryLock)
 }
 
 // Runner implements the Run method.
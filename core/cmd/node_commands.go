@@ -0,0 +1,131 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/smartcontractkit/chainlink/core/services/eth"
+	clipkg "github.com/urfave/cli"
+	"go.uber.org/multierr"
+)
+
+// nodeProbeTimeout bounds how long probing a node may take in total
+const nodeProbeTimeout = 30 * time.Second
+
+// NodeProbePresenter presents the result of probing an eth node
+type NodeProbePresenter struct {
+	WSURL string `json:"wsURL"`
+	eth.NodeProbeResult
+}
+
+// RenderTable implements TableRenderer
+func (p *NodeProbePresenter) RenderTable(rt RendererTable) error {
+	headers := []string{"URL", "Chain ID", "Client Version", "Latest Block Number", "Latest Block Hash"}
+	row := []string{
+		p.WSURL,
+		p.ChainID.String(),
+		p.ClientVersion,
+		fmt.Sprintf("%d", p.LatestBlockNumber),
+		p.LatestBlockHash.Hex(),
+	}
+
+	if _, err := rt.Write([]byte("🔌 Node\n")); err != nil {
+		return err
+	}
+	renderList(headers, [][]string{row}, rt.Writer)
+	return nil
+}
+
+// ProbeNode dials the eth node given by --ws-url (and optionally
+// --http-url) and renders its chain ID, client version and latest block.
+// It does not need a running Chainlink node and does not store anything.
+func (cli *Client) ProbeNode(c *clipkg.Context) error {
+	wsURL := c.String("ws-url")
+	if wsURL == "" {
+		return cli.errorOut(errors.New("must pass the node's websocket URL with --ws-url"))
+	}
+	var httpURL *url.URL
+	if s := c.String("http-url"); s != "" {
+		u, err := url.ParseRequestURI(s)
+		if err != nil {
+			return cli.errorOut(errors.Wrap(err, "invalid --http-url"))
+		}
+		httpURL = u
+	}
+
+	ethClient, err := eth.NewClient(wsURL, httpURL, nil)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), nodeProbeTimeout)
+	defer cancel()
+	result, err := eth.ProbeNode(ctx, ethClient)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	return cli.errorOut(cli.Render(&NodeProbePresenter{
+		WSURL:           wsURL,
+		NodeProbeResult: result,
+	}))
+}
+
+// PinNode sends all traffic of the chain given by the optional chain flag, or
+// the node's default chain, to the eth node with the given ID: 0 for the
+// primary, or 1 onwards for the secondaries in the order of
+// ETH_SECONDARY_URLS. Pinning is not persisted.
+func (cli *Client) PinNode(c *clipkg.Context) error {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("must pass the ID of the node to pin"))
+	}
+	nodeID := c.Args().First()
+	if _, err := strconv.ParseInt(nodeID, 10, 32); err != nil {
+		return cli.errorOut(fmt.Errorf("invalid node ID: %s", nodeID))
+	}
+	return cli.setNodePinned(c, "/v2/nodes/pin/"+nodeID, true)
+}
+
+// UnpinNode restores normal rotation after PinNode
+func (cli *Client) UnpinNode(c *clipkg.Context) error {
+	return cli.setNodePinned(c, "/v2/nodes/pin", false)
+}
+
+func (cli *Client) setNodePinned(c *clipkg.Context, path string, pinned bool) (err error) {
+	if chainID := c.String("chain"); chainID != "" {
+		if _, ok := new(big.Int).SetString(chainID, 10); !ok {
+			return cli.errorOut(fmt.Errorf("invalid chain ID: %s", chainID))
+		}
+		path += "?evmChainID=" + chainID
+	}
+
+	var resp *http.Response
+	if pinned {
+		resp, err = cli.HTTP.Post(path, bytes.NewBufferString("{}"))
+	} else {
+		resp, err = cli.HTTP.Delete(path)
+	}
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	if resp.StatusCode != http.StatusOK {
+		body, err2 := cli.parseResponse(resp)
+		if err2 != nil {
+			return cli.errorOut(fmt.Errorf("parseResponse error: %w", err2))
+		}
+		return cli.errorOut(errors.New(string(body)))
+	}
+	return cli.printResponseBody(resp)
+}
@@ -0,0 +1,79 @@
+package cmd_test
+
+import (
+	"bytes"
+	"flag"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/smartcontractkit/chainlink/core/cmd"
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/services/eth"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli"
+)
+
+func TestNodeProbePresenter_RenderTable(t *testing.T) {
+	t.Parallel()
+
+	var (
+		buffer = bytes.NewBufferString("")
+		r      = cmd.RendererTable{Writer: buffer}
+		hash   = common.HexToHash("0xabcd")
+	)
+
+	p := cmd.NodeProbePresenter{
+		WSURL: "ws://example.com",
+		NodeProbeResult: eth.NodeProbeResult{
+			ChainID:           big.NewInt(137),
+			ClientVersion:     "bor/v0.2.9",
+			LatestBlockNumber: 42,
+			LatestBlockHash:   hash,
+		},
+	}
+
+	require.NoError(t, p.RenderTable(r))
+
+	output := buffer.String()
+	assert.Contains(t, output, "ws://example.com")
+	assert.Contains(t, output, "137")
+	assert.Contains(t, output, "bor/v0.2.9")
+	assert.Contains(t, output, "42")
+	assert.Contains(t, output, hash.Hex())
+}
+
+func TestClient_ProbeNode_InvalidFlags(t *testing.T) {
+	t.Parallel()
+
+	r := &cltest.RendererMock{}
+	client := cmd.Client{Renderer: r}
+
+	t.Run("without a websocket URL", func(t *testing.T) {
+		set := flag.NewFlagSet("test", 0)
+		set.String("ws-url", "", "")
+		c := cli.NewContext(nil, set, nil)
+
+		assert.Error(t, client.ProbeNode(c))
+	})
+
+	t.Run("with a non-websocket URL", func(t *testing.T) {
+		set := flag.NewFlagSet("test", 0)
+		set.String("ws-url", "http://example.com", "")
+		c := cli.NewContext(nil, set, nil)
+
+		assert.Error(t, client.ProbeNode(c))
+	})
+
+	t.Run("with an invalid HTTP URL", func(t *testing.T) {
+		set := flag.NewFlagSet("test", 0)
+		set.String("ws-url", "ws://example.com", "")
+		set.String("http-url", "not a url", "")
+		c := cli.NewContext(nil, set, nil)
+
+		assert.Error(t, client.ProbeNode(c))
+	})
+
+	assert.Empty(t, r.Renders)
+}
@@ -116,7 +116,8 @@ func (cli *Client) getPage(requestURI string, page int, model interface{}) (err
 	return err
 }
 
-// ReplayFromBlock replays chain data from the given block number until the most recent
+// ReplayFromBlock replays chain data from the given block number until the most recent,
+// on the chain given by --chain or the default chain
 func (cli *Client) ReplayFromBlock(c *clipkg.Context) (err error) {
 
 	blockNumber := c.Int64("block-number")
@@ -124,9 +125,17 @@ func (cli *Client) ReplayFromBlock(c *clipkg.Context) (err error) {
 		return cli.errorOut(errors.New("Must pass a positive value in '--block-number' parameter"))
 	}
 
+	queryStr := ""
+	if chainID := c.String("chain"); chainID != "" {
+		if _, ok := new(big.Int).SetString(chainID, 10); !ok {
+			return cli.errorOut(errors.Errorf("invalid chain ID: %s", chainID))
+		}
+		queryStr = "?evmChainID=" + chainID
+	}
+
 	buf := bytes.NewBufferString("{}")
 
-	resp, err := cli.HTTP.Post(fmt.Sprintf("/v2/replay_from_block/%v", blockNumber), buf)
+	resp, err := cli.HTTP.Post(fmt.Sprintf("/v2/replay_from_block/%v%s", blockNumber, queryStr), buf)
 	if err != nil {
 		return cli.errorOut(err)
 	}
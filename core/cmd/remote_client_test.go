@@ -127,6 +127,57 @@ func TestClient_ReplayBlocks(t *testing.T) {
 	set.Int64("block-number", 42, "")
 	c := cli.NewContext(nil, set, nil)
 	assert.NoError(t, client.ReplayFromBlock(c))
+
+	set = flag.NewFlagSet("flagset", 0)
+	set.Int64("block-number", 42, "")
+	set.String("chain", app.GetEVMConfig().ChainID().String(), "")
+	c = cli.NewContext(nil, set, nil)
+	assert.NoError(t, client.ReplayFromBlock(c))
+
+	set = flag.NewFlagSet("flagset", 0)
+	set.Int64("block-number", 42, "")
+	set.String("chain", "12345678", "")
+	c = cli.NewContext(nil, set, nil)
+	assert.Error(t, client.ReplayFromBlock(c))
+
+	set = flag.NewFlagSet("flagset", 0)
+	set.Int64("block-number", 42, "")
+	set.String("chain", "not a chain", "")
+	c = cli.NewContext(nil, set, nil)
+	assert.EqualError(t, client.ReplayFromBlock(c), "invalid chain ID: not a chain")
+}
+
+func TestClient_DrainTransactions(t *testing.T) {
+	t.Parallel()
+
+	app := startNewApplication(t)
+	client, _ := app.NewClientAndRenderer()
+
+	set := flag.NewFlagSet("flagset", 0)
+	c := cli.NewContext(nil, set, nil)
+	require.NoError(t, client.DrainTransactions(c))
+	assert.True(t, app.TxManager.Draining())
+	require.NoError(t, client.UndrainTransactions(c))
+	assert.False(t, app.TxManager.Draining())
+
+	set = flag.NewFlagSet("flagset", 0)
+	set.String("chain", app.GetEVMConfig().ChainID().String(), "")
+	c = cli.NewContext(nil, set, nil)
+	require.NoError(t, client.DrainTransactions(c))
+	assert.True(t, app.TxManager.Draining())
+	require.NoError(t, client.UndrainTransactions(c))
+	assert.False(t, app.TxManager.Draining())
+
+	set = flag.NewFlagSet("flagset", 0)
+	set.String("chain", "12345678", "")
+	c = cli.NewContext(nil, set, nil)
+	assert.Error(t, client.DrainTransactions(c))
+	assert.False(t, app.TxManager.Draining())
+
+	set = flag.NewFlagSet("flagset", 0)
+	set.String("chain", "not a chain", "")
+	c = cli.NewContext(nil, set, nil)
+	assert.EqualError(t, client.DrainTransactions(c), "invalid chain ID: not a chain")
 }
 
 func TestClient_CreateExternalInitiator(t *testing.T) {
@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"math/big"
+	"net/http"
 
 	"github.com/smartcontractkit/chainlink/core/assets"
 	"github.com/smartcontractkit/chainlink/core/store/models"
@@ -79,6 +81,53 @@ func (cli *Client) ShowTransaction(c *cli.Context) (err error) {
 	return err
 }
 
+// DrainTransactions stops the transaction manager of the chain given by the
+// optional chain flag, or the node's default chain, from accepting new
+// transactions, so that pending ones can confirm before a restart
+func (cli *Client) DrainTransactions(c *cli.Context) error {
+	return cli.setTransactionsDraining(c, true)
+}
+
+// UndrainTransactions makes a drained transaction manager accept new
+// transactions again
+func (cli *Client) UndrainTransactions(c *cli.Context) error {
+	return cli.setTransactionsDraining(c, false)
+}
+
+func (cli *Client) setTransactionsDraining(c *cli.Context, draining bool) (err error) {
+	path := "/v2/transactions/drain"
+	if chainID := c.String("chain"); chainID != "" {
+		if _, ok := new(big.Int).SetString(chainID, 10); !ok {
+			return cli.errorOut(fmt.Errorf("invalid chain ID: %s", chainID))
+		}
+		path += "?evmChainID=" + chainID
+	}
+
+	var resp *http.Response
+	if draining {
+		resp, err = cli.HTTP.Post(path, bytes.NewBufferString("{}"))
+	} else {
+		resp, err = cli.HTTP.Delete(path)
+	}
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	if resp.StatusCode != http.StatusOK {
+		body, err2 := cli.parseResponse(resp)
+		if err2 != nil {
+			return cli.errorOut(fmt.Errorf("parseResponse error: %w", err2))
+		}
+		return cli.errorOut(errors.New(string(body)))
+	}
+	return cli.printResponseBody(resp)
+}
+
 // IndexTxAttempts returns the list of transactions in descending order,
 // taking an optional page parameter
 func (cli *Client) IndexTxAttempts(c *cli.Context) error {
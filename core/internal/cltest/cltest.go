@@ -345,6 +345,21 @@ const (
 	UseRealExternalInitiatorManager = "UseRealExternalInitiatorManager"
 )
 
+// EthClientsByChainID may be passed to NewApplication and friends instead of
+// a single eth.Client, so that tests covering several chains can give each
+// its own client, e.g. a healthy client for one chain and a failing one for
+// another. The application gets the client for its configured chain ID.
+type EthClientsByChainID map[int64]eth.Client
+
+// GenEthClient returns the client for chainID, failing the test if there is
+// none
+func (m EthClientsByChainID) GenEthClient(t testing.TB, chainID *big.Int) eth.Client {
+	t.Helper()
+	client, ok := m[chainID.Int64()]
+	require.True(t, ok, "no eth client for chain %s", chainID)
+	return client
+}
+
 // NewApplicationWithConfig creates a New TestApplication with specified test config
 func NewApplicationWithConfig(t testing.TB, c *configtest.TestEVMConfig, flagsAndDeps ...interface{}) (*TestApplication, func()) {
 	t.Helper()
@@ -358,6 +373,8 @@ func NewApplicationWithConfig(t testing.TB, c *configtest.TestEVMConfig, flagsAn
 		switch dep := flag.(type) {
 		case eth.Client:
 			ethClient = dep
+		case EthClientsByChainID:
+			ethClient = dep.GenEthClient(t, c.ChainID())
 		case postgres.AdvisoryLocker:
 			advisoryLocker = dep
 		case webhook.ExternalInitiatorManager:
@@ -4,6 +4,8 @@ import (
 	"math/big"
 	"testing"
 
+	"github.com/smartcontractkit/chainlink/core/internal/mocks"
+	"github.com/smartcontractkit/chainlink/core/services/eth"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -15,3 +17,12 @@ func TestBigHexInt(t *testing.T) {
 	newBig := BigHexInt(x)
 	assert.Equal(t, (*big.Int)(&newBig).Uint64(), x)
 }
+
+func TestEthClientsByChainID(t *testing.T) {
+	healthy := &eth.NullClient{}
+	failing := new(mocks.Client)
+	clients := EthClientsByChainID{1: healthy, 42: failing}
+
+	assert.Same(t, healthy, clients.GenEthClient(t, i(1)))
+	assert.Same(t, failing, clients.GenEthClient(t, i(42)))
+}
@@ -3,6 +3,8 @@
 package mocks
 
 import (
+	big "math/big"
+
 	context "context"
 
 	config "github.com/smartcontractkit/chainlink/core/store/config"
@@ -64,6 +66,22 @@ func (_m *Application) AddJobV2(ctx context.Context, _a1 job.Job, name null.Stri
 	return r0, r1
 }
 
+// DefaultChainID provides a mock function with given fields:
+func (_m *Application) DefaultChainID() *big.Int {
+	ret := _m.Called()
+
+	var r0 *big.Int
+	if rf, ok := ret.Get(0).(func() *big.Int); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*big.Int)
+		}
+	}
+
+	return r0
+}
+
 // DeleteJob provides a mock function with given fields: ctx, jobID
 func (_m *Application) DeleteJob(ctx context.Context, jobID int32) error {
 	ret := _m.Called(ctx, jobID)
@@ -126,6 +144,29 @@ func (_m *Application) GetEthClient() eth.Client {
 	return r0
 }
 
+// GetEVMConfigOrDefault provides a mock function with given fields: chainID
+func (_m *Application) GetEVMConfigOrDefault(chainID *big.Int) (config.EVMConfig, error) {
+	ret := _m.Called(chainID)
+
+	var r0 config.EVMConfig
+	if rf, ok := ret.Get(0).(func(*big.Int) config.EVMConfig); ok {
+		r0 = rf(chainID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(config.EVMConfig)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*big.Int) error); ok {
+		r1 = rf(chainID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetExternalInitiatorManager provides a mock function with given fields:
 func (_m *Application) GetExternalInitiatorManager() webhook.ExternalInitiatorManager {
 	ret := _m.Called()
@@ -284,6 +325,48 @@ func (_m *Application) NewBox() packr.Box {
 	return r0
 }
 
+// PinNode provides a mock function with given fields: chainID, nodeID
+func (_m *Application) PinNode(chainID *big.Int, nodeID int32) error {
+	ret := _m.Called(chainID, nodeID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*big.Int, int32) error); ok {
+		r0 = rf(chainID, nodeID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PinnedNode provides a mock function with given fields: chainID
+func (_m *Application) PinnedNode(chainID *big.Int) (string, bool, error) {
+	ret := _m.Called(chainID)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(*big.Int) string); ok {
+		r0 = rf(chainID)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func(*big.Int) bool); ok {
+		r1 = rf(chainID)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(*big.Int) error); ok {
+		r2 = rf(chainID)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
 // PipelineORM provides a mock function with given fields:
 func (_m *Application) PipelineORM() pipeline.ORM {
 	ret := _m.Called()
@@ -300,13 +383,27 @@ func (_m *Application) PipelineORM() pipeline.ORM {
 	return r0
 }
 
-// ReplayFromBlock provides a mock function with given fields: number
-func (_m *Application) ReplayFromBlock(number uint64) error {
-	ret := _m.Called(number)
+// ReloadChainConfig provides a mock function with given fields: chainID
+func (_m *Application) ReloadChainConfig(chainID *big.Int) error {
+	ret := _m.Called(chainID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*big.Int) error); ok {
+		r0 = rf(chainID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ReplayFromBlock provides a mock function with given fields: ctx, chainID, number
+func (_m *Application) ReplayFromBlock(ctx context.Context, chainID *big.Int, number uint64) error {
+	ret := _m.Called(ctx, chainID, number)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(uint64) error); ok {
-		r0 = rf(number)
+	if rf, ok := ret.Get(0).(func(context.Context, *big.Int, uint64) error); ok {
+		r0 = rf(ctx, chainID, number)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -391,6 +488,20 @@ func (_m *Application) SetServiceLogger(ctx context.Context, service string, lev
 	return r0
 }
 
+// SetTxManagerDraining provides a mock function with given fields: chainID, draining
+func (_m *Application) SetTxManagerDraining(chainID *big.Int, draining bool) error {
+	ret := _m.Called(chainID, draining)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*big.Int, bool) error); ok {
+		r0 = rf(chainID, draining)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Start provides a mock function with given fields:
 func (_m *Application) Start() error {
 	ret := _m.Called()
@@ -419,6 +530,20 @@ func (_m *Application) Stop() error {
 	return r0
 }
 
+// UnpinNode provides a mock function with given fields: chainID
+func (_m *Application) UnpinNode(chainID *big.Int) error {
+	ret := _m.Called(chainID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*big.Int) error); ok {
+		r0 = rf(chainID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // WakeSessionReaper provides a mock function with given fields:
 func (_m *Application) WakeSessionReaper() {
 	_m.Called()
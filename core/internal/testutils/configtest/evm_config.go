@@ -1,6 +1,7 @@
 package configtest
 
 import (
+	"context"
 	"math/big"
 	"testing"
 	"time"
@@ -19,27 +20,43 @@ var (
 type EVMConfigOverrides struct {
 	EvmLogBackfillBatchSize null.Int
 
+	BlockHistoryEstimatorBatchSize        null.Int
 	BlockHistoryEstimatorBlockDelay       null.Int
 	BlockHistoryEstimatorBlockHistorySize null.Int
+	EvmBalanceMonitorBlockDelay           null.Int
+	EvmConfirmerConcurrency               null.Int
 	EvmFinalityDepth                      null.Int
+	EvmFinalityViolationAction            null.String
+	EvmForceTxType                        null.Int
 	EvmMaxGasPriceWei                     *big.Int
 	EvmGasBumpPercent                     null.Int
 	EvmGasBumpTxDepth                     null.Int
 
-	EvmGasLimitDefault null.Int
-
-	EvmHeadTrackerHistoryDepth       null.Int
-	EvmGasBumpWei                    *big.Int
-	EvmGasLimitMultiplier            null.Float
-	EvmGasPriceDefault               *big.Int
-	EvmHeadTrackerSamplingInterval   *time.Duration
-	EvmHeadTrackerMaxBufferSize      null.Int
-	EthTxResendAfterThreshold        *time.Duration
-	EvmNonceAutoSync                 null.Bool
-	EvmRPCDefaultBatchSize           null.Int
-	FlagsContractAddress             null.String
-	GasEstimatorMode                 null.String
-	MinRequiredOutgoingConfirmations null.Int
+	EvmGasLimitDefault  null.Int
+	EvmGasLimitTransfer null.Int
+
+	EvmHeadTrackerBackfillDepth         null.Int
+	EvmHeadTrackerHistoryDepth          null.Int
+	EvmGasBumpWei                       *big.Int
+	EvmGasLimitMultiplier               null.Float
+	EvmGasPriceDefault                  *big.Int
+	EvmHeadTrackerSamplingInterval      *time.Duration
+	EvmHeadTrackerSamplingMode          null.String
+	EvmHeadTrackerMaxBufferSize         null.Int
+	EvmHeadTrackerMaxReorgDepth         null.Int
+	EthTxResendAfterThreshold           *time.Duration
+	EvmInsufficientFundsAction          null.String
+	EvmMaxNonceGap                      null.Int
+	EvmNonceAutoSync                    null.Bool
+	EvmNonceGapAction                   null.String
+	EvmReceiptFetchDepth                null.Int
+	EvmReceiptFetchMaxBlocks            null.Int
+	EvmRPCDefaultBatchSize              null.Int
+	EvmSimulateTransactionsBeforeSend   null.Bool
+	EvmSkipEstimationForSimpleTransfers null.Bool
+	FlagsContractAddress                null.String
+	GasEstimatorMode                    null.String
+	MinRequiredOutgoingConfirmations    null.Int
 }
 
 // TestEVMConfig defaults to whatever config.NewEVMConfig()
@@ -82,6 +99,20 @@ func (c *TestEVMConfig) EvmFinalityDepth() uint {
 	return 15
 }
 
+func (c *TestEVMConfig) EvmFinalityViolationAction() string {
+	if c.Overrides.EvmFinalityViolationAction.Valid {
+		return c.Overrides.EvmFinalityViolationAction.String
+	}
+	return c.EVMConfig.EvmFinalityViolationAction()
+}
+
+func (c *TestEVMConfig) EvmForceTxType() int {
+	if c.Overrides.EvmForceTxType.Valid {
+		return int(c.Overrides.EvmForceTxType.Int64)
+	}
+	return c.EVMConfig.EvmForceTxType()
+}
+
 func (c *TestEVMConfig) EthTxReaperThreshold() time.Duration {
 	return 0
 }
@@ -98,6 +129,12 @@ func (c *TestEVMConfig) MinIncomingConfirmations() uint32 {
 	return 1
 }
 
+// EffectiveIncomingConfirmations applies the real config's rule to the
+// overridden getters
+func (c *TestEVMConfig) EffectiveIncomingConfirmations() uint32 {
+	return config.EffectiveIncomingConfirmationsFor(c)
+}
+
 func (c *TestEVMConfig) MinRequiredOutgoingConfirmations() uint64 {
 	if c.Overrides.MinRequiredOutgoingConfirmations.Valid {
 		return uint64(c.Overrides.MinRequiredOutgoingConfirmations.Int64)
@@ -105,6 +142,13 @@ func (c *TestEVMConfig) MinRequiredOutgoingConfirmations() uint64 {
 	return 1
 }
 
+func (c *TestEVMConfig) EffectiveOutgoingConfirmations(override uint64) uint64 {
+	if override != 0 {
+		return override
+	}
+	return c.MinRequiredOutgoingConfirmations()
+}
+
 func (c *TestEVMConfig) MinimumContractPayment() *assets.Link {
 	return MinimumContractPayment
 }
@@ -113,6 +157,13 @@ func (c *TestEVMConfig) BalanceMonitorEnabled() bool {
 	return false
 }
 
+func (c *TestEVMConfig) EvmHeadTrackerMaxReorgDepth() uint {
+	if c.Overrides.EvmHeadTrackerMaxReorgDepth.Valid {
+		return uint(c.Overrides.EvmHeadTrackerMaxReorgDepth.Int64)
+	}
+	return c.EVMConfig.EvmHeadTrackerMaxReorgDepth()
+}
+
 func (c *TestEVMConfig) EvmHeadTrackerMaxBufferSize() uint {
 	if c.Overrides.EvmHeadTrackerMaxBufferSize.Valid {
 		return uint(c.Overrides.EvmHeadTrackerMaxBufferSize.Int64)
@@ -127,11 +178,32 @@ func (c *TestEVMConfig) EvmGasPriceDefault() *big.Int {
 	return c.EVMConfig.EvmGasPriceDefault()
 }
 
+// GasPriceEnvelope is built from the getters above so that overrides apply
+func (c *TestEVMConfig) GasPriceEnvelope() (min, def, max *big.Int) {
+	return c.EvmMinGasPriceWei(), c.EvmGasPriceDefault(), c.EvmMaxGasPriceWei()
+}
+
 func (c *TestEVMConfig) SetEvmGasPriceDefault(p *big.Int) error {
 	c.Overrides.EvmGasPriceDefault = p
 	return nil
 }
 
+func (c *TestEVMConfig) SetEvmGasPriceDefaultCtx(_ context.Context, p *big.Int) error {
+	return c.SetEvmGasPriceDefault(p)
+}
+
+// BlockHistoryEstimatorBatchSize keeps the "0 means EvmDefaultBatchSize"
+// semantics of the real config when overridden
+func (c *TestEVMConfig) BlockHistoryEstimatorBatchSize() uint32 {
+	if c.Overrides.BlockHistoryEstimatorBatchSize.Valid {
+		if size := uint32(c.Overrides.BlockHistoryEstimatorBatchSize.Int64); size > 0 {
+			return size
+		}
+		return c.EvmDefaultBatchSize()
+	}
+	return c.EVMConfig.BlockHistoryEstimatorBatchSize()
+}
+
 func (c *TestEVMConfig) BlockHistoryEstimatorBlockDelay() uint16 {
 	if c.Overrides.BlockHistoryEstimatorBlockDelay.Valid {
 		return uint16(c.Overrides.BlockHistoryEstimatorBlockDelay.Int64)
@@ -160,6 +232,43 @@ func (c *TestEVMConfig) EvmNonceAutoSync() bool {
 	return c.EVMConfig.EvmNonceAutoSync()
 }
 
+func (c *TestEVMConfig) EvmInsufficientFundsAction() string {
+	if c.Overrides.EvmInsufficientFundsAction.Valid {
+		return c.Overrides.EvmInsufficientFundsAction.String
+	}
+	return c.EVMConfig.EvmInsufficientFundsAction()
+}
+
+func (c *TestEVMConfig) EvmMaxNonceGap() uint64 {
+	if c.Overrides.EvmMaxNonceGap.Valid {
+		return uint64(c.Overrides.EvmMaxNonceGap.Int64)
+	}
+	return c.EVMConfig.EvmMaxNonceGap()
+}
+
+func (c *TestEVMConfig) EvmNonceGapAction() string {
+	if c.Overrides.EvmNonceGapAction.Valid {
+		return c.Overrides.EvmNonceGapAction.String
+	}
+	return c.EVMConfig.EvmNonceGapAction()
+}
+
+// EvmReceiptFetchDepth defaults to the test EvmFinalityDepth, like the real
+// config does
+func (c *TestEVMConfig) EvmReceiptFetchDepth() uint {
+	if c.Overrides.EvmReceiptFetchDepth.Valid {
+		return uint(c.Overrides.EvmReceiptFetchDepth.Int64)
+	}
+	return c.EvmFinalityDepth()
+}
+
+func (c *TestEVMConfig) EvmReceiptFetchMaxBlocks() uint64 {
+	if c.Overrides.EvmReceiptFetchMaxBlocks.Valid {
+		return uint64(c.Overrides.EvmReceiptFetchMaxBlocks.Int64)
+	}
+	return c.EVMConfig.EvmReceiptFetchMaxBlocks()
+}
+
 func (c *TestEVMConfig) EvmGasBumpWei() *big.Int {
 	if c.Overrides.EvmGasBumpWei != nil {
 		return c.Overrides.EvmGasBumpWei
@@ -174,6 +283,36 @@ func (c *TestEVMConfig) EvmGasBumpPercent() uint16 {
 	return c.EVMConfig.EvmGasBumpPercent()
 }
 
+func (c *TestEVMConfig) EvmBalanceMonitorBlockDelay() uint16 {
+	if c.Overrides.EvmBalanceMonitorBlockDelay.Valid {
+		return uint16(c.Overrides.EvmBalanceMonitorBlockDelay.Int64)
+	}
+	return c.EVMConfig.EvmBalanceMonitorBlockDelay()
+}
+
+func (c *TestEVMConfig) EvmConfirmerConcurrency() uint32 {
+	if c.Overrides.EvmConfirmerConcurrency.Valid {
+		return uint32(c.Overrides.EvmConfirmerConcurrency.Int64)
+	}
+	return c.EVMConfig.EvmConfirmerConcurrency()
+}
+
+// EvmSimulateTransactionsBeforeSend defaults to false in tests so that eth
+// clients are not expected to serve eth_call for every transaction
+func (c *TestEVMConfig) EvmSimulateTransactionsBeforeSend() bool {
+	if c.Overrides.EvmSimulateTransactionsBeforeSend.Valid {
+		return c.Overrides.EvmSimulateTransactionsBeforeSend.Bool
+	}
+	return false
+}
+
+func (c *TestEVMConfig) EvmSkipEstimationForSimpleTransfers() bool {
+	if c.Overrides.EvmSkipEstimationForSimpleTransfers.Valid {
+		return c.Overrides.EvmSkipEstimationForSimpleTransfers.Bool
+	}
+	return c.EVMConfig.EvmSkipEstimationForSimpleTransfers()
+}
+
 func (c *TestEVMConfig) EvmRPCDefaultBatchSize() uint32 {
 	if c.Overrides.EvmRPCDefaultBatchSize.Valid {
 		return uint32(c.Overrides.EvmRPCDefaultBatchSize.Int64)
@@ -202,6 +341,18 @@ func (c *TestEVMConfig) FlagsContractAddress() string {
 	return c.EVMConfig.FlagsContractAddress()
 }
 
+// EvmHeadTrackerBackfillDepth follows an overridden history depth unless it
+// is overridden itself, mirroring the default in config.EVMConfig
+func (c *TestEVMConfig) EvmHeadTrackerBackfillDepth() uint {
+	if c.Overrides.EvmHeadTrackerBackfillDepth.Valid {
+		return uint(c.Overrides.EvmHeadTrackerBackfillDepth.Int64)
+	}
+	if c.Overrides.EvmHeadTrackerHistoryDepth.Valid {
+		return uint(c.Overrides.EvmHeadTrackerHistoryDepth.Int64)
+	}
+	return c.EVMConfig.EvmHeadTrackerBackfillDepth()
+}
+
 func (c *TestEVMConfig) EvmHeadTrackerHistoryDepth() uint {
 	if c.Overrides.EvmHeadTrackerHistoryDepth.Valid {
 		return uint(c.Overrides.EvmHeadTrackerHistoryDepth.Int64)
@@ -216,6 +367,13 @@ func (c *TestEVMConfig) EvmHeadTrackerSamplingInterval() time.Duration {
 	return c.EVMConfig.EvmHeadTrackerSamplingInterval()
 }
 
+func (c *TestEVMConfig) EvmHeadTrackerSamplingMode() string {
+	if c.Overrides.EvmHeadTrackerSamplingMode.Valid {
+		return c.Overrides.EvmHeadTrackerSamplingMode.String
+	}
+	return c.EVMConfig.EvmHeadTrackerSamplingMode()
+}
+
 func (c *TestEVMConfig) EvmLogBackfillBatchSize() uint32 {
 	if c.Overrides.EvmLogBackfillBatchSize.Valid {
 		return uint32(c.Overrides.EvmLogBackfillBatchSize.Int64)
@@ -229,3 +387,10 @@ func (c *TestEVMConfig) EvmGasLimitDefault() uint64 {
 	}
 	return c.EVMConfig.EvmGasLimitDefault()
 }
+
+func (c *TestEVMConfig) EvmGasLimitTransfer() uint64 {
+	if c.Overrides.EvmGasLimitTransfer.Valid {
+		return uint64(c.Overrides.EvmGasLimitTransfer.Int64)
+	}
+	return c.EVMConfig.EvmGasLimitTransfer()
+}
@@ -59,6 +59,7 @@ type GeneralConfigOverrides struct {
 	Dialect                                   dialects.DialectName
 	EthereumDisabled                          null.Bool
 	FeatureExternalInitiators                 null.Bool
+	GlobalMaxInFlightTransactions             null.Int
 	LogToDisk                                 null.Bool
 	OCRBootstrapCheckInterval                 *time.Duration
 	OCRKeyBundleID                            *models.Sha256Hash
@@ -207,6 +208,13 @@ func (c *TestGeneralConfig) GlobalLockRetryInterval() models.Duration {
 	return models.MustMakeDuration(10 * time.Millisecond)
 }
 
+func (c *TestGeneralConfig) GlobalMaxInFlightTransactions() uint32 {
+	if c.Overrides.GlobalMaxInFlightTransactions.Valid {
+		return uint32(c.Overrides.GlobalMaxInFlightTransactions.Int64)
+	}
+	return c.GeneralConfig.GlobalMaxInFlightTransactions()
+}
+
 func (c *TestGeneralConfig) ORMMaxIdleConns() int {
 	return 5
 }
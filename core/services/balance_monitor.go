@@ -6,6 +6,7 @@ import (
 	"math"
 	"math/big"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/pkg/errors"
@@ -33,27 +34,47 @@ type (
 		service.Service
 	}
 
+	// BalanceMonitorConfig describes the chain's native token, for logging
+	// balances, and how to fetch them
+	BalanceMonitorConfig interface {
+		ChainID() *big.Int
+		EvmBalanceMonitorBlockDelay() uint16
+		EvmCallTimeout() time.Duration
+		NativeTokenDecimals() uint8
+		NativeTokenSymbol() string
+	}
+
 	balanceMonitor struct {
 		db             *gorm.DB
 		ethClient      eth.Client
 		ethKeyStore    *keystore.Eth
+		config         BalanceMonitorConfig
 		ethBalances    map[gethCommon.Address]*assets.Eth
 		ethBalancesMtx *sync.RWMutex
 		sleeperTask    utils.SleeperTask
+		// latestBlockNum is the number of the latest head, or -1 if none has
+		// been seen yet
+		latestBlockNum int64
+		// fundingErr is set if the last balance check found no funded sending
+		// keys. It is guarded by ethBalancesMtx.
+		fundingErr error
 	}
 
 	NullBalanceMonitor struct{}
 )
 
 // NewBalanceMonitor returns a new balanceMonitor
-func NewBalanceMonitor(db *gorm.DB, ethClient eth.Client, ethKeyStore *keystore.Eth) BalanceMonitor {
+func NewBalanceMonitor(db *gorm.DB, ethClient eth.Client, ethKeyStore *keystore.Eth, config BalanceMonitorConfig) BalanceMonitor {
 	bm := &balanceMonitor{
 		db,
 		ethClient,
 		ethKeyStore,
+		config,
 		make(map[gethCommon.Address]*assets.Eth),
 		new(sync.RWMutex),
 		nil,
+		-1,
+		nil,
 	}
 	bm.sleeperTask = utils.NewSleeperTask(&worker{bm: bm})
 	return bm
@@ -74,8 +95,12 @@ func (bm *balanceMonitor) Ready() error {
 	return nil
 }
 
+// Healthy returns an error if the last balance check found that none of the
+// sending keys is funded, in which case the chain cannot send transactions
 func (bm *balanceMonitor) Healthy() error {
-	return nil
+	bm.ethBalancesMtx.RLock()
+	defer bm.ethBalancesMtx.RUnlock()
+	return bm.fundingErr
 }
 
 // OnNewLongestChain checks the balance for each key
@@ -84,10 +109,29 @@ func (bm *balanceMonitor) OnNewLongestChain(_ context.Context, head models.Head)
 }
 
 func (bm *balanceMonitor) checkBalance(head *models.Head) {
+	if head != nil {
+		atomic.StoreInt64(&bm.latestBlockNum, head.Number)
+	}
 	logger.Debugw("BalanceMonitor: signalling balance worker")
 	bm.sleeperTask.WakeUp()
 }
 
+// blockNumber returns the block to read balances at, trailing the latest head
+// by EvmBalanceMonitorBlockDelay. It returns nil, meaning the node's latest
+// block, if no head has been seen yet or there is no delay.
+func (bm *balanceMonitor) blockNumber() *big.Int {
+	latest := atomic.LoadInt64(&bm.latestBlockNum)
+	delay := int64(bm.config.EvmBalanceMonitorBlockDelay())
+	if latest < 0 || delay == 0 {
+		return nil
+	}
+	n := latest - delay
+	if n < 0 {
+		n = 0
+	}
+	return big.NewInt(n)
+}
+
 func (bm *balanceMonitor) updateBalance(ethBal assets.Eth, address gethCommon.Address) {
 	promUpdateEthBalance(&ethBal, address)
 
@@ -96,20 +140,22 @@ func (bm *balanceMonitor) updateBalance(ethBal assets.Eth, address gethCommon.Ad
 	bm.ethBalances[address] = &ethBal
 	bm.ethBalancesMtx.Unlock()
 
+	symbol, bal := bm.config.NativeTokenSymbol(), ethBal.Format(bm.config.NativeTokenDecimals())
 	loggerFields := []interface{}{
 		"address", address.Hex(),
-		"ethBalance", ethBal.String(),
+		"ethBalance", bal,
 		"weiBalance", ethBal.ToInt(),
+		"nativeTokenSymbol", symbol,
 		"id", "balance_log",
 	}
 
 	if oldBal == nil {
-		logger.Infow(fmt.Sprintf("ETH balance for %s: %s", address.Hex(), ethBal.String()), loggerFields...)
+		logger.Infow(fmt.Sprintf("%s balance for %s: %s", symbol, address.Hex(), bal), loggerFields...)
 		return
 	}
 
 	if ethBal.Cmp(oldBal) != 0 {
-		logger.Infow(fmt.Sprintf("New ETH balance for %s: %s", address.Hex(), ethBal.String()), loggerFields...)
+		logger.Infow(fmt.Sprintf("New %s balance for %s: %s", symbol, address.Hex(), bal), loggerFields...)
 	}
 }
 
@@ -119,6 +165,35 @@ func (bm *balanceMonitor) GetEthBalance(address gethCommon.Address) *assets.Eth
 	return bm.ethBalances[address]
 }
 
+// KeyFundingStatus describes what the balance monitor last saw for a key
+type KeyFundingStatus string
+
+const (
+	// KeyFunded means the last balance reading for the key was non-zero
+	KeyFunded KeyFundingStatus = "funded"
+	// KeyUnfunded means the last balance reading for the key was zero
+	KeyUnfunded KeyFundingStatus = "unfunded"
+	// KeyFundingUnknown means the balance monitor is disabled or has not
+	// yet read a balance for the key
+	KeyFundingUnknown KeyFundingStatus = "unknown"
+)
+
+// FundingStatusForKey reports whether address is funded according to the
+// balance monitor's cached state. It never makes an RPC call.
+func FundingStatusForKey(bm BalanceMonitor, address gethCommon.Address) KeyFundingStatus {
+	if bm == nil {
+		return KeyFundingUnknown
+	}
+	bal := bm.GetEthBalance(address)
+	if bal == nil {
+		return KeyFundingUnknown
+	}
+	if bal.IsZero() {
+		return KeyUnfunded
+	}
+	return KeyFunded
+}
+
 type worker struct {
 	bm *balanceMonitor
 }
@@ -139,16 +214,45 @@ func (w *worker) Work() {
 		}(key)
 	}
 	wg.Wait()
+
+	w.bm.checkFunding(keys)
 }
 
-// Approximately ETH block time
-const ethFetchTimeout = 15 * time.Second
+// checkFunding warns, and reports unhealthy, if there are sending keys but
+// none of them is funded. Keys whose balance could not be read may be funded,
+// so they suppress the warning.
+func (bm *balanceMonitor) checkFunding(keys []ethkey.Key) {
+	var err error
+	if len(keys) > 0 {
+		var funded, unknown bool
+		for _, k := range keys {
+			switch FundingStatusForKey(bm, k.Address.Address()) {
+			case KeyFunded:
+				funded = true
+			case KeyFundingUnknown:
+				unknown = true
+			}
+		}
+		if !funded && !unknown {
+			err = errors.Errorf("none of the %d sending keys for chain %s is funded, so it cannot send transactions", len(keys), bm.config.ChainID())
+		}
+	}
+
+	bm.ethBalancesMtx.Lock()
+	prev := bm.fundingErr
+	bm.fundingErr = err
+	bm.ethBalancesMtx.Unlock()
+
+	if err != nil && prev == nil {
+		logger.Warnw(fmt.Sprintf("BalanceMonitor: %v", err), "evmChainID", bm.config.ChainID())
+	}
+}
 
 func (w *worker) checkAccountBalance(k ethkey.Key) {
-	ctx, cancel := context.WithTimeout(context.Background(), ethFetchTimeout)
+	ctx, cancel := context.WithTimeout(context.Background(), w.bm.config.EvmCallTimeout())
 	defer cancel()
 
-	bal, err := w.bm.ethClient.BalanceAt(ctx, k.Address.Address(), nil)
+	bal, err := w.bm.ethClient.BalanceAt(ctx, k.Address.Address(), w.bm.blockNumber())
 	if err != nil {
 		logger.Errorw(fmt.Sprintf("BalanceMonitor: error getting balance for key %s", k.Address.Hex()),
 			"error", err,
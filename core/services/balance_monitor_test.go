@@ -14,6 +14,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/services"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
 
 	// "github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/mock"
@@ -34,7 +35,7 @@ func TestBalanceMonitor_Start(t *testing.T) {
 		_, k0Addr := cltest.MustAddRandomKeyToKeystore(t, ethKeyStore, 0)
 		_, k1Addr := cltest.MustAddRandomKeyToKeystore(t, ethKeyStore, 0)
 
-		bm := services.NewBalanceMonitor(db, ethClient, ethKeyStore)
+		bm := services.NewBalanceMonitor(db, ethClient, ethKeyStore, cltest.NewTestEVMConfig(t))
 		defer bm.Close()
 
 		k0bal := big.NewInt(42)
@@ -64,7 +65,7 @@ func TestBalanceMonitor_Start(t *testing.T) {
 
 		_, k0Addr := cltest.MustAddRandomKeyToKeystore(t, ethKeyStore, 0)
 
-		bm := services.NewBalanceMonitor(db, ethClient, ethKeyStore)
+		bm := services.NewBalanceMonitor(db, ethClient, ethKeyStore, cltest.NewTestEVMConfig(t))
 		defer bm.Close()
 		k0bal := big.NewInt(42)
 
@@ -86,7 +87,7 @@ func TestBalanceMonitor_Start(t *testing.T) {
 
 		_, k0Addr := cltest.MustAddRandomKeyToKeystore(t, ethKeyStore, 0)
 
-		bm := services.NewBalanceMonitor(db, ethClient, ethKeyStore)
+		bm := services.NewBalanceMonitor(db, ethClient, ethKeyStore, cltest.NewTestEVMConfig(t))
 		defer bm.Close()
 
 		ethClient.On("BalanceAt", mock.Anything, k0Addr, nilBigInt).
@@ -112,7 +113,10 @@ func TestBalanceMonitor_OnNewLongestChain_UpdatesBalance(t *testing.T) {
 		_, k0Addr := cltest.MustAddRandomKeyToKeystore(t, ethKeyStore, 0)
 		_, k1Addr := cltest.MustAddRandomKeyToKeystore(t, ethKeyStore, 0)
 
-		bm := services.NewBalanceMonitor(db, ethClient, ethKeyStore)
+		config := cltest.NewTestEVMConfig(t)
+		config.Overrides.EvmBalanceMonitorBlockDelay = null.IntFrom(0)
+
+		bm := services.NewBalanceMonitor(db, ethClient, ethKeyStore, config)
 		defer bm.Close()
 		k0bal := big.NewInt(42)
 		// Deliberately larger than a 64 bit unsigned integer to test overflow
@@ -152,6 +156,42 @@ func TestBalanceMonitor_OnNewLongestChain_UpdatesBalance(t *testing.T) {
 			return bm.GetEthBalance(k1Addr).ToInt()
 		}).Should(gomega.Equal(k1bal2))
 	})
+
+	t.Run("reads balances trailing the latest head by the block delay", func(t *testing.T) {
+		db := pgtest.NewGormDB(t)
+		ethKeyStore := cltest.NewKeyStore(t, db).Eth()
+
+		ethClient := NewEthClientMock(t)
+		defer ethClient.AssertExpectations(t)
+
+		_, k0Addr := cltest.MustAddRandomKeyToKeystore(t, ethKeyStore, 0)
+
+		config := cltest.NewTestEVMConfig(t)
+		config.Overrides.EvmBalanceMonitorBlockDelay = null.IntFrom(2)
+
+		bm := services.NewBalanceMonitor(db, ethClient, ethKeyStore, config)
+		defer bm.Close()
+		k0bal := big.NewInt(42)
+
+		ethClient.On("BalanceAt", mock.Anything, k0Addr, big.NewInt(8)).Once().Return(k0bal, nil)
+
+		bm.OnNewLongestChain(context.TODO(), *cltest.Head(10))
+
+		gomega.NewGomegaWithT(t).Eventually(func() *big.Int {
+			return bm.GetEthBalance(k0Addr).ToInt()
+		}).Should(gomega.Equal(k0bal))
+
+		// Never reads before genesis
+		k0bal2 := big.NewInt(142)
+
+		ethClient.On("BalanceAt", mock.Anything, k0Addr, big.NewInt(0)).Once().Return(k0bal2, nil)
+
+		bm.OnNewLongestChain(context.TODO(), *cltest.Head(1))
+
+		gomega.NewGomegaWithT(t).Eventually(func() *big.Int {
+			return bm.GetEthBalance(k0Addr).ToInt()
+		}).Should(gomega.Equal(k0bal2))
+	})
 }
 
 func TestBalanceMonitor_FewerRPCCallsWhenBehind(t *testing.T) {
@@ -163,7 +203,7 @@ func TestBalanceMonitor_FewerRPCCallsWhenBehind(t *testing.T) {
 	ethClient := NewEthClientMock(t)
 	ethClient.AssertExpectations(t)
 
-	bm := services.NewBalanceMonitor(db, ethClient, ethKeyStore)
+	bm := services.NewBalanceMonitor(db, ethClient, ethKeyStore, cltest.NewTestEVMConfig(t))
 
 	head := cltest.Head(0)
 
@@ -198,6 +238,78 @@ func TestBalanceMonitor_FewerRPCCallsWhenBehind(t *testing.T) {
 	assert.LessOrEqual(t, atomic.LoadInt32(&callCount), int32(1))
 }
 
+func TestBalanceMonitor_FundingStatusForKey(t *testing.T) {
+	t.Run("unknown when balance monitor is disabled", func(t *testing.T) {
+		assert.Equal(t, services.KeyFundingUnknown, services.FundingStatusForKey(&services.NullBalanceMonitor{}, cltest.NewAddress()))
+	})
+
+	t.Run("reports cached balances", func(t *testing.T) {
+		db := pgtest.NewGormDB(t)
+		ethKeyStore := cltest.NewKeyStore(t, db).Eth()
+
+		ethClient := NewEthClientMock(t)
+		defer ethClient.AssertExpectations(t)
+
+		_, k0Addr := cltest.MustAddRandomKeyToKeystore(t, ethKeyStore, 0)
+		_, k1Addr := cltest.MustAddRandomKeyToKeystore(t, ethKeyStore, 0)
+
+		bm := services.NewBalanceMonitor(db, ethClient, ethKeyStore, cltest.NewTestEVMConfig(t))
+		defer bm.Close()
+
+		assert.Equal(t, services.KeyFundingUnknown, services.FundingStatusForKey(bm, k0Addr))
+
+		ethClient.On("BalanceAt", mock.Anything, k0Addr, nilBigInt).Once().Return(big.NewInt(42), nil)
+		ethClient.On("BalanceAt", mock.Anything, k1Addr, nilBigInt).Once().Return(big.NewInt(0), nil)
+
+		assert.NoError(t, bm.Start())
+
+		gomega.NewGomegaWithT(t).Eventually(func() services.KeyFundingStatus {
+			return services.FundingStatusForKey(bm, k0Addr)
+		}).Should(gomega.Equal(services.KeyFunded))
+		gomega.NewGomegaWithT(t).Eventually(func() services.KeyFundingStatus {
+			return services.FundingStatusForKey(bm, k1Addr)
+		}).Should(gomega.Equal(services.KeyUnfunded))
+		assert.Equal(t, services.KeyFundingUnknown, services.FundingStatusForKey(bm, cltest.NewAddress()))
+	})
+}
+
+func TestBalanceMonitor_Healthy(t *testing.T) {
+	db := pgtest.NewGormDB(t)
+	ethKeyStore := cltest.NewKeyStore(t, db).Eth()
+
+	ethClient := NewEthClientMock(t)
+	defer ethClient.AssertExpectations(t)
+
+	_, k0Addr := cltest.MustAddRandomKeyToKeystore(t, ethKeyStore, 0)
+	_, k1Addr := cltest.MustAddRandomKeyToKeystore(t, ethKeyStore, 0)
+
+	config := cltest.NewTestEVMConfig(t)
+	config.Overrides.EvmBalanceMonitorBlockDelay = null.IntFrom(0)
+
+	bm := services.NewBalanceMonitor(db, ethClient, ethKeyStore, config)
+	defer bm.Close()
+
+	// Nothing is known before the first check
+	assert.NoError(t, bm.Healthy())
+
+	ethClient.On("BalanceAt", mock.Anything, k0Addr, nilBigInt).Once().Return(big.NewInt(0), nil)
+	ethClient.On("BalanceAt", mock.Anything, k1Addr, nilBigInt).Once().Return(big.NewInt(0), nil)
+
+	require.NoError(t, bm.Start())
+
+	gomega.NewGomegaWithT(t).Eventually(bm.Healthy).Should(gomega.MatchError(
+		gomega.ContainSubstring("none of the 2 sending keys for chain %s is funded", config.ChainID()),
+	))
+
+	// Funding one key is enough
+	ethClient.On("BalanceAt", mock.Anything, k0Addr, nilBigInt).Once().Return(big.NewInt(0), nil)
+	ethClient.On("BalanceAt", mock.Anything, k1Addr, nilBigInt).Once().Return(big.NewInt(42), nil)
+
+	bm.OnNewLongestChain(context.TODO(), *cltest.Head(1))
+
+	gomega.NewGomegaWithT(t).Eventually(bm.Healthy).Should(gomega.Succeed())
+}
+
 func Test_ApproximateFloat64(t *testing.T) {
 	tests := []struct {
 		name      string
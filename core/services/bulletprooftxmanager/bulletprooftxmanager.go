@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"math/big"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
@@ -20,6 +21,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
 	"github.com/smartcontractkit/chainlink/core/services/postgres"
 	"github.com/smartcontractkit/chainlink/core/static"
+	"github.com/smartcontractkit/chainlink/core/store/config"
 	"github.com/smartcontractkit/chainlink/core/store/models"
 	"github.com/smartcontractkit/chainlink/core/utils"
 
@@ -38,24 +40,39 @@ type Config interface {
 	BlockHistoryEstimatorBlockHistorySize() uint16
 	BlockHistoryEstimatorTransactionPercentile() uint16
 	ChainID() *big.Int
+	EvmCallTimeout() time.Duration
+	EvmConfirmerConcurrency() uint32
 	EvmFinalityDepth() uint
+	EvmForceTxType() int
 	EvmGasBumpPercent() uint16
+	EvmGasBumpStrategy() string
 	EvmGasBumpThreshold() uint64
 	EvmGasBumpTxDepth() uint16
 	EvmGasBumpWei() *big.Int
+	EvmGasEstimatorRequireWarmup() bool
 	EvmGasLimitDefault() uint64
 	EvmGasLimitMultiplier() float32
+	EvmGasLimitTransfer() uint64
 	EvmGasPriceDefault() *big.Int
+	EvmInsufficientFundsAction() string
 	EvmMaxGasPriceWei() *big.Int
 	EvmMaxInFlightTransactions() uint32
+	EvmMaxNonceGap() uint64
 	EvmMaxQueuedTransactions() uint64
 	EvmMinGasPriceWei() *big.Int
 	EvmNonceAutoSync() bool
+	EvmNonceGapAction() string
+	EvmReceiptFetchDepth() uint
+	EvmReceiptFetchMaxBlocks() uint64
 	EvmRPCDefaultBatchSize() uint32
+	EvmSimulateTransactionsBeforeSend() bool
+	EvmSkipEstimationForSimpleTransfers() bool
+	SignerChainID() *big.Int
 	EthTxReaperInterval() time.Duration
 	EthTxReaperThreshold() time.Duration
 	EthTxResendAfterThreshold() time.Duration
 	GasEstimatorMode() string
+	GlobalMaxInFlightTransactions() uint32
 	TriggerFallbackDBPollInterval() time.Duration
 }
 
@@ -74,8 +91,20 @@ var (
 		Name: "tx_manager_num_tx_reverted",
 		Help: "Number of times a transaction reverted on-chain",
 	})
+	promInsufficientFundsCount = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "tx_manager_num_insufficient_funds",
+		Help: "Number of times a transaction or attempt was rejected by the eth node because the sending key had insufficient funds",
+	}, []string{"evmChainID"})
 )
 
+// ErrDraining is returned when creating a transaction on a chain that is
+// draining for maintenance
+var ErrDraining = errors.New("transaction manager is draining and not accepting new transactions")
+
+// ErrGasEstimatorWarmingUp is returned by Ready while the gas estimator is
+// still collecting data and GAS_ESTIMATOR_REQUIRE_WARMUP is set
+var ErrGasEstimatorWarmingUp = errors.New("gas estimator is warming up")
+
 var _ TxManager = &BulletproofTxManager{}
 
 //go:generate mockery --recursive --name TxManager --output ./mocks/ --case=underscore --structname TxManager --filename tx_manager.go
@@ -85,6 +114,9 @@ type TxManager interface {
 	Trigger(addr common.Address)
 	CreateEthTransaction(db *gorm.DB, fromAddress, toAddress common.Address, payload []byte, gasLimit uint64, meta interface{}, strategy TxStrategy) (etx EthTx, err error)
 	GetGasEstimator() gas.Estimator
+	Drain()
+	Undrain()
+	Draining() bool
 }
 
 type BulletproofTxManager struct {
@@ -101,6 +133,14 @@ type BulletproofTxManager struct {
 	chHeads chan models.Head
 	trigger chan common.Address
 
+	chHalt   chan error
+	haltOnce sync.Once
+
+	draining int32
+
+	// pausedKeys is shared with each EthBroadcaster, see newEthBroadcaster
+	pausedKeys *pausedKeys
+
 	chStop chan struct{}
 	wg     sync.WaitGroup
 
@@ -119,6 +159,8 @@ func NewBulletproofTxManager(db *gorm.DB, ethClient eth.Client, config Config, k
 		eventBroadcaster: eventBroadcaster,
 		chHeads:          make(chan models.Head),
 		trigger:          make(chan common.Address),
+		chHalt:           make(chan error, 1),
+		pausedKeys:       newPausedKeys(),
 		chStop:           make(chan struct{}),
 	}
 	if config.EthTxResendAfterThreshold() > 0 {
@@ -145,8 +187,9 @@ func (b *BulletproofTxManager) Start() (merr error) {
 
 		logger.Debugw("BulletproofTxManager: booting", "keys", keys)
 
-		eb := NewEthBroadcaster(b.db, b.ethClient, b.config, b.keyStore, b.advisoryLocker, b.eventBroadcaster, keys, b.gasEstimator)
+		eb := b.newEthBroadcaster(keys)
 		ec := NewEthConfirmer(b.db, b.ethClient, b.config, b.keyStore, b.advisoryLocker, keys, b.gasEstimator)
+		ec.haltBroadcasting = b.HaltBroadcasting
 		if err := eb.Start(); err != nil {
 			return errors.Wrap(err, "BulletproofTxManager: EthBroadcaster failed to start")
 		}
@@ -200,11 +243,19 @@ func (b *BulletproofTxManager) runLoop(eb *EthBroadcaster, ec *EthConfirmer) {
 	for {
 		select {
 		case address := <-b.trigger:
-			eb.Trigger(address)
+			if eb != nil {
+				eb.Trigger(address)
+			}
 		case head := <-b.chHeads:
 			ec.mb.Deliver(head)
-		case <-b.chStop:
+		case reason := <-b.chHalt:
+			logger.Errorw("BulletproofTxManager: halting EthBroadcaster, no new transactions will be sent on this chain until the node is restarted", "evmChainID", b.config.ChainID(), "reason", reason)
 			logger.ErrorIfCalling(eb.Close)
+			eb = nil
+		case <-b.chStop:
+			if eb != nil {
+				logger.ErrorIfCalling(eb.Close)
+			}
 			logger.ErrorIfCalling(ec.Close)
 			return
 		case <-keysChanged:
@@ -215,18 +266,42 @@ func (b *BulletproofTxManager) runLoop(eb *EthBroadcaster, ec *EthConfirmer) {
 
 			logger.Debugw("BulletproofTxManager: keys changed, reloading", "keys", keys)
 
-			logger.ErrorIfCalling(eb.Close)
 			logger.ErrorIfCalling(ec.Close)
-
-			eb = NewEthBroadcaster(b.db, b.ethClient, b.config, b.keyStore, b.advisoryLocker, b.eventBroadcaster, keys, b.gasEstimator)
 			ec = NewEthConfirmer(b.db, b.ethClient, b.config, b.keyStore, b.advisoryLocker, keys, b.gasEstimator)
-
-			logger.ErrorIfCalling(eb.Start)
+			ec.haltBroadcasting = b.HaltBroadcasting
 			logger.ErrorIfCalling(ec.Start)
+
+			// A halted broadcaster stays halted across key changes
+			if eb != nil {
+				logger.ErrorIfCalling(eb.Close)
+				eb = b.newEthBroadcaster(keys)
+				logger.ErrorIfCalling(eb.Start)
+			}
 		}
 	}
 }
 
+// newEthBroadcaster returns an EthBroadcaster for keys that shares the
+// BulletproofTxManager's paused keys, so that a key paused for insufficient
+// funds stays paused when the broadcaster is recreated
+func (b *BulletproofTxManager) newEthBroadcaster(keys []ethkey.Key) *EthBroadcaster {
+	eb := NewEthBroadcaster(b.db, b.ethClient, b.config, b.keyStore, b.advisoryLocker, b.eventBroadcaster, keys, b.gasEstimator)
+	eb.pausedKeys = b.pausedKeys
+	return eb
+}
+
+// HaltBroadcasting stops the EthBroadcaster so that no further transactions
+// are sent, while the EthConfirmer keeps tracking those already broadcast. It
+// is used when the head tracker sees a re-org deeper than finality depth and
+// ETH_FINALITY_VIOLATION_ACTION is "halt", or when the EthConfirmer finds a
+// nonce gap larger than ETH_MAX_NONCE_GAP and ETH_NONCE_GAP_ACTION is "halt".
+// Only the first call has any effect.
+func (b *BulletproofTxManager) HaltBroadcasting(reason error) {
+	b.haltOnce.Do(func() {
+		b.chHalt <- reason
+	})
+}
+
 // OnNewLongestChain conforms to HeadTrackable
 func (b *BulletproofTxManager) OnNewLongestChain(ctx context.Context, head models.Head) {
 	ok := b.IfStarted(func() {
@@ -253,8 +328,50 @@ func (b *BulletproofTxManager) Trigger(addr common.Address) {
 	}
 }
 
+// Drain stops the BulletproofTxManager from accepting new transactions, so
+// that an operator can let pending ones confirm before restarting the node.
+// Transactions already created are still broadcast and confirmed as usual.
+// The draining state is not persisted.
+func (b *BulletproofTxManager) Drain() {
+	if atomic.CompareAndSwapInt32(&b.draining, 0, 1) {
+		logger.Warnw("BulletproofTxManager: draining, new transactions will be rejected until undrained", "evmChainID", b.config.ChainID())
+	}
+}
+
+// Undrain resumes accepting new transactions after Drain
+func (b *BulletproofTxManager) Undrain() {
+	if atomic.CompareAndSwapInt32(&b.draining, 1, 0) {
+		logger.Infow("BulletproofTxManager: undrained, accepting new transactions", "evmChainID", b.config.ChainID())
+	}
+}
+
+// Draining returns true if new transactions are being rejected by Drain
+func (b *BulletproofTxManager) Draining() bool {
+	return atomic.LoadInt32(&b.draining) == 1
+}
+
+// Ready reports an error if the BulletproofTxManager is not started, is
+// draining, or, if GAS_ESTIMATOR_REQUIRE_WARMUP is set, the gas estimator is
+// not yet warm. A draining node is still healthy, but should not be given new
+// work.
+func (b *BulletproofTxManager) Ready() error {
+	if err := b.StartStopOnce.Ready(); err != nil {
+		return err
+	}
+	if b.Draining() {
+		return ErrDraining
+	}
+	if b.config.EvmGasEstimatorRequireWarmup() && !b.gasEstimator.Warm() {
+		return ErrGasEstimatorWarmingUp
+	}
+	return nil
+}
+
 // CreateEthTransaction inserts a new transaction
 func (b *BulletproofTxManager) CreateEthTransaction(db *gorm.DB, fromAddress, toAddress common.Address, payload []byte, gasLimit uint64, meta interface{}, strategy TxStrategy) (etx EthTx, err error) {
+	if b.Draining() {
+		return etx, errors.Wrap(ErrDraining, "BulletproofTxManager#CreateEthTransaction")
+	}
 	err = CheckEthTxQueueCapacity(db, fromAddress, b.config.EvmMaxQueuedTransactions())
 	if err != nil {
 		return etx, errors.Wrap(err, "BulletproofTxManager#CreateEthTransaction")
@@ -317,9 +434,16 @@ func SendEther(db *gorm.DB, from, to common.Address, value assets.Eth, gasLimit
 	return etx, err
 }
 
-func newAttempt(ethClient eth.Client, ks KeyStore, chainID *big.Int, etx EthTx, gasPrice *big.Int, gasLimit uint64) (EthTxAttempt, error) {
+func newAttempt(ethClient eth.Client, ks KeyStore, chainID *big.Int, forceTxType int, etx EthTx, gasPrice *big.Int, gasLimit uint64) (EthTxAttempt, error) {
 	attempt := EthTxAttempt{}
 
+	// Only legacy transactions can be built, so auto always picks them
+	switch forceTxType {
+	case config.TxTypeAuto, config.TxTypeLegacy:
+	default:
+		return attempt, errors.Errorf("cannot build transaction of type %d for eth_tx %v: only legacy (type 0) transactions are supported", forceTxType, etx.ID)
+	}
+
 	tx := newLegacyTransaction(
 		uint64(*etx.Nonce),
 		etx.ToAddress,
@@ -442,6 +566,15 @@ func CountUnconfirmedTransactions(db *gorm.DB, fromAddress common.Address) (coun
 	return countTransactionsWithState(db, fromAddress, EthTxUnconfirmed)
 }
 
+// CountAllUnconfirmedTransactions returns the number of unconfirmed
+// transactions across all from addresses
+func CountAllUnconfirmedTransactions(db *gorm.DB) (count uint32, err error) {
+	ctx, cancel := postgres.DefaultQueryCtx()
+	defer cancel()
+	err = db.WithContext(ctx).Raw(`SELECT count(*) FROM eth_txes WHERE state = ?`, EthTxUnconfirmed).Scan(&count).Error
+	return
+}
+
 // CountUnstartedTransactions returns the number of unconfirmed transactions
 func CountUnstartedTransactions(db *gorm.DB, fromAddress common.Address) (count uint32, err error) {
 	return countTransactionsWithState(db, fromAddress, EthTxUnstarted)
@@ -489,3 +622,6 @@ func (n *NullTxManager) CreateEthTransaction(*gorm.DB, common.Address, common.Ad
 func (n *NullTxManager) Healthy() error                 { return nil }
 func (n *NullTxManager) Ready() error                   { return nil }
 func (n *NullTxManager) GetGasEstimator() gas.Estimator { return nil }
+func (n *NullTxManager) Drain()                         {}
+func (n *NullTxManager) Undrain()                       {}
+func (n *NullTxManager) Draining() bool                 { return false }
@@ -2,16 +2,20 @@ package bulletprooftxmanager_test
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"math/big"
 	"testing"
 	"time"
 
+	"github.com/ethereum/go-ethereum/rpc"
 	uuid "github.com/satori/go.uuid"
 	"github.com/smartcontractkit/chainlink/core/assets"
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
 	"github.com/smartcontractkit/chainlink/core/services/bulletprooftxmanager"
 	bptxmmocks "github.com/smartcontractkit/chainlink/core/services/bulletprooftxmanager/mocks"
+	gasmocks "github.com/smartcontractkit/chainlink/core/services/gas/mocks"
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
 	ksmocks "github.com/smartcontractkit/chainlink/core/services/keystore/mocks"
 	"github.com/smartcontractkit/chainlink/core/services/postgres"
@@ -141,6 +145,25 @@ func TestBulletproofTxManager_CountUnconfirmedTransactions(t *testing.T) {
 	assert.Equal(t, int(count), 3)
 }
 
+func TestBulletproofTxManager_CountAllUnconfirmedTransactions(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewGormDB(t)
+	ethKeyStore := cltest.NewKeyStore(t, db).Eth()
+
+	_, fromAddress := cltest.MustAddRandomKeyToKeystore(t, ethKeyStore, 0)
+	_, otherAddress := cltest.MustAddRandomKeyToKeystore(t, ethKeyStore, 0)
+
+	cltest.MustInsertUnconfirmedEthTxWithBroadcastAttempt(t, db, 0, otherAddress)
+	cltest.MustInsertUnconfirmedEthTxWithBroadcastAttempt(t, db, 0, fromAddress)
+	cltest.MustInsertUnconfirmedEthTxWithBroadcastAttempt(t, db, 1, fromAddress)
+	cltest.MustInsertUnstartedEthTx(t, db, fromAddress)
+
+	count, err := bulletprooftxmanager.CountAllUnconfirmedTransactions(db)
+	require.NoError(t, err)
+	assert.Equal(t, int(count), 3)
+}
+
 func TestBulletproofTxManager_CountUnstartedTransactions(t *testing.T) {
 	t.Parallel()
 
@@ -217,6 +240,118 @@ func TestBulletproofTxManager_CreateEthTransaction(t *testing.T) {
 	})
 }
 
+func TestBulletproofTxManager_Ready_GasEstimatorWarmup(t *testing.T) {
+	t.Parallel()
+
+	newBptxm := func(t *testing.T, requireWarmup bool) (*bulletprooftxmanager.BulletproofTxManager, *gasmocks.Estimator) {
+		config := new(bptxmmocks.Config)
+		config.On("EthTxResendAfterThreshold").Return(time.Duration(0))
+		config.On("EthTxReaperThreshold").Return(time.Duration(0))
+		config.On("GasEstimatorMode").Return("FixedPrice")
+		config.On("EvmGasEstimatorRequireWarmup").Return(requireWarmup)
+
+		bptxm := bulletprooftxmanager.NewBulletproofTxManager(nil, nil, config, nil, nil, nil)
+		estimator := new(gasmocks.Estimator)
+		bulletprooftxmanager.SetGasEstimator(bptxm, estimator)
+		require.NoError(t, bptxm.StartOnce("BulletproofTxManager", func() error { return nil }))
+		return bptxm, estimator
+	}
+
+	t.Run("not ready while the estimator is cold", func(t *testing.T) {
+		bptxm, estimator := newBptxm(t, true)
+		estimator.On("Warm").Return(false).Once()
+		assert.True(t, errors.Is(bptxm.Ready(), bulletprooftxmanager.ErrGasEstimatorWarmingUp))
+
+		estimator.On("Warm").Return(true).Once()
+		assert.NoError(t, bptxm.Ready())
+		estimator.AssertExpectations(t)
+	})
+
+	t.Run("ignores the estimator if warmup is not required", func(t *testing.T) {
+		bptxm, estimator := newBptxm(t, false)
+		assert.NoError(t, bptxm.Ready())
+		estimator.AssertNotCalled(t, "Warm")
+	})
+}
+
+func TestBulletproofTxManager_Drain_HealthyButNotReady(t *testing.T) {
+	t.Parallel()
+
+	config := new(bptxmmocks.Config)
+	config.On("EthTxResendAfterThreshold").Return(time.Duration(0))
+	config.On("EthTxReaperThreshold").Return(time.Duration(0))
+	config.On("GasEstimatorMode").Return("FixedPrice")
+	config.On("EvmGasEstimatorRequireWarmup").Return(false)
+	config.On("ChainID").Return(big.NewInt(0))
+
+	bptxm := bulletprooftxmanager.NewBulletproofTxManager(nil, nil, config, nil, nil, nil)
+	require.NoError(t, bptxm.StartOnce("BulletproofTxManager", func() error { return nil }))
+
+	bptxm.Drain()
+	assert.NoError(t, bptxm.Healthy())
+	assert.True(t, errors.Is(bptxm.Ready(), bulletprooftxmanager.ErrDraining))
+
+	bptxm.Undrain()
+	assert.NoError(t, bptxm.Healthy())
+	assert.NoError(t, bptxm.Ready())
+}
+
+func TestBulletproofTxManager_Drain(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewGormDB(t)
+	ethKeyStore := cltest.NewKeyStore(t, db).Eth()
+	key, fromAddress := cltest.MustAddRandomKeyToKeystore(t, ethKeyStore, 0)
+
+	ethClient := cltest.NewEthClientMock(t)
+	defer ethClient.AssertExpectations(t)
+	config := cltest.NewTestEVMConfig(t)
+	bptxm := bulletprooftxmanager.NewBulletproofTxManager(db, ethClient, config, ethKeyStore, nil, nil)
+	ec := cltest.NewEthConfirmer(t, db, ethClient, config, ethKeyStore, []ethkey.Key{key})
+
+	// A transaction broadcast before draining
+	etx := cltest.MustInsertUnconfirmedEthTxWithBroadcastAttempt(t, db, 0, fromAddress)
+	attempt := etx.EthTxAttempts[0]
+
+	bptxm.Drain()
+	assert.True(t, bptxm.Draining())
+
+	t.Run("rejects new transactions", func(t *testing.T) {
+		_, err := bptxm.CreateEthTransaction(db, fromAddress, cltest.NewAddress(), []byte{1, 2, 3}, 21000, nil, bulletprooftxmanager.SendEveryStrategy{})
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, bulletprooftxmanager.ErrDraining))
+		cltest.AssertCount(t, db, bulletprooftxmanager.EthTx{}, 1)
+	})
+
+	t.Run("still confirms pending transactions", func(t *testing.T) {
+		ethClient.On("NonceAt", mock.Anything, fromAddress, (*big.Int)(nil)).Return(uint64(1), nil).Once()
+		ethClient.On("BatchCallContext", mock.Anything, mock.MatchedBy(func(b []rpc.BatchElem) bool {
+			return len(b) == 1 && cltest.BatchElemMatchesHash(b[0], attempt.Hash)
+		})).Return(nil).Run(func(args mock.Arguments) {
+			elems := args.Get(1).([]rpc.BatchElem)
+			elems[0].Result = &bulletprooftxmanager.Receipt{
+				TxHash:           attempt.Hash,
+				BlockHash:        utils.NewHash(),
+				BlockNumber:      big.NewInt(42),
+				TransactionIndex: uint(1),
+			}
+		}).Once()
+
+		require.NoError(t, ec.CheckForReceipts(context.Background(), 42))
+
+		require.NoError(t, db.First(&etx, etx.ID).Error)
+		assert.Equal(t, bulletprooftxmanager.EthTxConfirmed, etx.State)
+	})
+
+	t.Run("undrain accepts new transactions again", func(t *testing.T) {
+		bptxm.Undrain()
+		assert.False(t, bptxm.Draining())
+		_, err := bptxm.CreateEthTransaction(db, fromAddress, cltest.NewAddress(), []byte{1, 2, 3}, 21000, nil, bulletprooftxmanager.SendEveryStrategy{})
+		require.NoError(t, err)
+		cltest.AssertCount(t, db, bulletprooftxmanager.EthTx{}, 2)
+	})
+}
+
 func TestBulletproofTxManager_CreateEthTransaction_OutOfEth(t *testing.T) {
 	db := pgtest.NewGormDB(t)
 
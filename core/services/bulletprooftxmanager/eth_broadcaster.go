@@ -15,9 +15,11 @@ import (
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
 	"github.com/smartcontractkit/chainlink/core/services/postgres"
 	"github.com/smartcontractkit/chainlink/core/static"
+	"github.com/smartcontractkit/chainlink/core/store/config"
 	"github.com/smartcontractkit/chainlink/core/utils"
 	"gopkg.in/guregu/null.v4"
 
+	"github.com/ethereum/go-ethereum"
 	gethCommon "github.com/ethereum/go-ethereum/common"
 	"github.com/pkg/errors"
 	"gorm.io/gorm"
@@ -61,6 +63,11 @@ type EthBroadcaster struct {
 	// Each key has its own trigger
 	triggers map[gethCommon.Address]chan struct{}
 
+	// pausedKeys holds the keys paused for insufficient funds. The
+	// BulletproofTxManager replaces it with its own, so that pauses survive
+	// the broadcaster being recreated when keys change.
+	pausedKeys *pausedKeys
+
 	ctx       context.Context
 	ctxCancel context.CancelFunc
 	wg        sync.WaitGroup
@@ -82,6 +89,7 @@ func NewEthBroadcaster(db *gorm.DB, ethClient eth.Client, config Config, keystor
 		eventBroadcaster: eventBroadcaster,
 		keys:             allKeys,
 		triggers:         triggers,
+		pausedKeys:       newPausedKeys(),
 		ctx:              ctx,
 		ctxCancel:        cancel,
 		wg:               sync.WaitGroup{},
@@ -197,11 +205,66 @@ func (eb *EthBroadcaster) monitorEthTxs(k ethkey.Key, triggerCh chan struct{}) {
 }
 
 func (eb *EthBroadcaster) ProcessUnstartedEthTxs(key ethkey.Key) error {
+	if paused, err := eb.checkPaused(key.Address.Address()); paused || err != nil {
+		return err
+	}
 	return eb.advisoryLocker.WithAdvisoryLock(context.TODO(), postgres.AdvisoryLockClassID_EthBroadcaster, key.ID, func() error {
 		return eb.processUnstartedEthTxs(key.Address.Address())
 	})
 }
 
+// checkPaused reports whether broadcasting from address is paused for
+// insufficient funds. A paused key resumes once its balance covers the
+// transaction that was rejected.
+func (eb *EthBroadcaster) checkPaused(address gethCommon.Address) (bool, error) {
+	required, paused := eb.pausedKeys.get(address)
+	if !paused {
+		return false, nil
+	}
+	ctx, cancel := context.WithTimeout(eb.ctx, eb.config.EvmCallTimeout())
+	defer cancel()
+	balance, err := eb.ethClient.BalanceAt(ctx, address, nil)
+	if err != nil {
+		return true, errors.Wrapf(err, "failed to check balance of paused key %s", address.Hex())
+	}
+	if balance.Cmp(required) < 0 {
+		return true, nil
+	}
+	eb.pausedKeys.resume(address)
+	logger.Infow(fmt.Sprintf("EthBroadcaster: key %s has been funded, resuming broadcasting", address.Hex()), "address", address, "balance", balance, "evmChainID", eb.config.ChainID())
+	return false, nil
+}
+
+// pausedKeys maps each key paused for insufficient funds to the balance it
+// needs before broadcasting from it resumes
+type pausedKeys struct {
+	mu       sync.Mutex
+	required map[gethCommon.Address]*big.Int
+}
+
+func newPausedKeys() *pausedKeys {
+	return &pausedKeys{required: make(map[gethCommon.Address]*big.Int)}
+}
+
+func (p *pausedKeys) get(address gethCommon.Address) (*big.Int, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	required, paused := p.required[address]
+	return required, paused
+}
+
+func (p *pausedKeys) pause(address gethCommon.Address, required *big.Int) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.required[address] = required
+}
+
+func (p *pausedKeys) resume(address gethCommon.Address) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	delete(p.required, address)
+}
+
 // NOTE: This MUST NOT be run concurrently for the same address or it could
 // result in undefined state or deadlocks.
 // First handle any in_progress transactions left over from last time.
@@ -219,6 +282,17 @@ func (eb *EthBroadcaster) processUnstartedEthTxs(fromAddress gethCommon.Address)
 		return errors.Wrap(err, "processUnstartedEthTxs failed")
 	}
 	for {
+		if globalMax := eb.config.GlobalMaxInFlightTransactions(); globalMax > 0 {
+			nUnconfirmed, err := CountAllUnconfirmedTransactions(eb.db)
+			if err != nil {
+				return errors.Wrap(err, "CountAllUnconfirmedTransactions failed")
+			}
+			if nUnconfirmed >= globalMax {
+				logger.Warnw(fmt.Sprintf(`EthBroadcaster: transaction throttling; %d transactions are in flight across all keys but the global maximum is %d. %s`, nUnconfirmed, globalMax, static.GlobalMaxInFlightTransactionsWarningLabel), "globalMaxInFlightTransactions", globalMax, "nUnconfirmed", nUnconfirmed, "address", fromAddress)
+				time.Sleep(InFlightTransactionRecheckInterval)
+				continue
+			}
+		}
 		maxInFlightTransactions := eb.config.EvmMaxInFlightTransactions()
 		if maxInFlightTransactions > 0 {
 			nUnconfirmed, err := CountUnconfirmedTransactions(eb.db, fromAddress)
@@ -243,11 +317,18 @@ func (eb *EthBroadcaster) processUnstartedEthTxs(fromAddress gethCommon.Address)
 			return nil
 		}
 		n++
-		gasPrice, gasLimit, err := eb.estimator.EstimateGas(etx.EncodedPayload, etx.GasLimit)
+		gasPrice, gasLimit, err := eb.estimateGas(etx)
 		if err != nil {
 			return errors.Wrap(err, "failed to estimate gas")
 		}
-		a, err := newAttempt(eb.ethClient, eb.keystore, eb.config.ChainID(), *etx, gasPrice, gasLimit)
+		if eb.config.EvmSimulateTransactionsBeforeSend() {
+			if failed, err := eb.simulateTransaction(etx, gasLimit); err != nil {
+				return errors.Wrap(err, "processUnstartedEthTxs failed")
+			} else if failed {
+				continue
+			}
+		}
+		a, err := newAttempt(eb.ethClient, eb.keystore, eb.config.SignerChainID(), eb.config.EvmForceTxType(), *etx, gasPrice, gasLimit)
 		if err != nil {
 			return errors.Wrap(err, "processUnstartedEthTxs failed")
 		}
@@ -265,6 +346,54 @@ func (eb *EthBroadcaster) processUnstartedEthTxs(fromAddress gethCommon.Address)
 	}
 }
 
+// estimateGas returns the gas price and limit for the first attempt of etx.
+// If EvmSkipEstimationForSimpleTransfers is set, transactions with no value
+// and no data go out at the transfer gas limit and default gas price without
+// asking the estimator. The default is clamped to the configured bounds, as
+// the estimators' output is.
+func (eb *EthBroadcaster) estimateGas(etx *EthTx) (*big.Int, uint64, error) {
+	if eb.config.EvmSkipEstimationForSimpleTransfers() && len(etx.EncodedPayload) == 0 && etx.Value.ToInt().Sign() == 0 {
+		return config.ClampGasPrice(eb.config, eb.config.EvmGasPriceDefault()), eb.config.EvmGasLimitTransfer(), nil
+	}
+	return eb.estimator.EstimateGas(etx.EncodedPayload, etx.GasLimit)
+}
+
+// simulateTransaction runs etx through eth_call against the latest block,
+// with the gas limit it will be sent with. If the EVM would fail to execute it,
+// by reverting or otherwise, the transaction is marked as fatally errored and
+// never sent. An error is returned only if the simulation itself could not be
+// run, in which case we bail out and try again on the next poll.
+func (eb *EthBroadcaster) simulateTransaction(etx *EthTx, gasLimit uint64) (failed bool, err error) {
+	ctx, cancel := context.WithTimeout(context.Background(), eb.config.EvmCallTimeout())
+	defer cancel()
+	to := etx.ToAddress
+	_, err = eb.ethClient.CallContract(ctx, ethereum.CallMsg{
+		From:  etx.FromAddress,
+		To:    &to,
+		Gas:   gasLimit,
+		Value: etx.Value.ToInt(),
+		Data:  etx.EncodedPayload,
+	}, nil)
+	if err == nil {
+		return false, nil
+	}
+	if !eth.IsExecutionError(err) {
+		return false, errors.Wrapf(err, "failed to simulate transaction %v", etx.ID)
+	}
+	errMsg := err.Error()
+	if !eth.IsExecutionReverted(err) {
+		logger.Warnw("EthBroadcaster: transaction would fail, refusing to send it", "ethTxID", etx.ID, "err", errMsg, "gasLimit", gasLimit, "evmChainID", eb.config.ChainID())
+		etx.Error = null.StringFrom(fmt.Sprintf("transaction simulation failed: %s", errMsg))
+		return true, saveFatallyErroredTransaction(eb.db, etx)
+	}
+	if reason, rerr := eth.ExtractRevertReasonFromRPCError(err); rerr == nil && reason != "" {
+		errMsg = fmt.Sprintf("%s: %s", errMsg, reason)
+	}
+	logger.Warnw("EthBroadcaster: transaction would revert, refusing to send it", "ethTxID", etx.ID, "err", errMsg, "evmChainID", eb.config.ChainID())
+	etx.Error = null.StringFrom(fmt.Sprintf("transaction simulation reverted: %s", errMsg))
+	return true, saveFatallyErroredTransaction(eb.db, etx)
+}
+
 // handleInProgressEthTx checks if there is any transaction
 // in_progress and if so, finishes the job
 func (eb *EthBroadcaster) handleAnyInProgressEthTx(fromAddress gethCommon.Address) error {
@@ -389,6 +518,14 @@ func (eb *EthBroadcaster) handleInProgressEthTx(etx EthTx, attempt EthTxAttempt,
 			"ACTION REQUIRED: Chainlink wallet with address 0x%x is OUT OF FUNDS",
 			attempt.Hash, attempt.GasPrice.String(), sendError.Error(), etx.FromAddress,
 		), "ethTxID", etx.ID, "err", sendError)
+		promInsufficientFundsCount.WithLabelValues(eb.config.ChainID().String()).Inc()
+		if eb.config.EvmInsufficientFundsAction() == config.InsufficientFundsActionPause {
+			// The transaction costs at most its value plus gas price times gas limit
+			required := new(big.Int).Mul(attempt.GasPrice.ToInt(), new(big.Int).SetUint64(attempt.ChainSpecificGasLimit))
+			required.Add(required, etx.Value.ToInt())
+			eb.pausedKeys.pause(etx.FromAddress, required)
+			logger.Warnw(fmt.Sprintf("EthBroadcaster: pausing broadcasting from %s until its balance reaches %s Wei", etx.FromAddress.Hex(), required.String()), "ethTxID", etx.ID, "address", etx.FromAddress, "evmChainID", eb.config.ChainID())
+		}
 		// NOTE: This bails out of the entire cycle and essentially "blocks" on
 		// any transaction that gets insufficient_eth. This is OK if a
 		// transaction with a large VALUE blocks because this always comes last
@@ -523,7 +660,7 @@ func (eb *EthBroadcaster) tryAgainWithNewEstimation(sendError *eth.SendError, et
 }
 
 func (eb *EthBroadcaster) tryAgainWithNewGas(etx EthTx, attempt EthTxAttempt, initialBroadcastAt time.Time, newGasPrice *big.Int, newGasLimit uint64) error {
-	replacementAttempt, err := newAttempt(eb.ethClient, eb.keystore, eb.config.ChainID(), etx, newGasPrice, newGasLimit)
+	replacementAttempt, err := newAttempt(eb.ethClient, eb.keystore, eb.config.SignerChainID(), eb.config.EvmForceTxType(), etx, newGasPrice, newGasLimit)
 	if err != nil {
 		return errors.Wrap(err, "tryAgainWithHigherGasPrice failed")
 	}
@@ -535,8 +672,10 @@ func (eb *EthBroadcaster) tryAgainWithNewGas(etx EthTx, attempt EthTxAttempt, in
 }
 
 func saveFatallyErroredTransaction(db *gorm.DB, etx *EthTx) error {
-	if etx.State != EthTxInProgress {
-		return errors.Errorf("can only transition to fatal_error from in_progress, transaction is currently %s", etx.State)
+	// Unstarted transactions may be failed when simulation shows they would
+	// revert, before any attempt has been made
+	if etx.State != EthTxInProgress && etx.State != EthTxUnstarted {
+		return errors.Errorf("can only transition to fatal_error from in_progress or unstarted, transaction is currently %s", etx.State)
 	}
 	if !etx.Error.Valid {
 		return errors.New("expected error field to be set")
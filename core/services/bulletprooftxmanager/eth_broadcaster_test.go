@@ -9,6 +9,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/ethereum/go-ethereum"
 	gethCommon "github.com/ethereum/go-ethereum/common"
 	gethTypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/onsi/gomega"
@@ -19,6 +20,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/internal/mocks"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
 	"github.com/smartcontractkit/chainlink/core/services/bulletprooftxmanager"
+	"github.com/smartcontractkit/chainlink/core/services/gas"
 	gasmocks "github.com/smartcontractkit/chainlink/core/services/gas/mocks"
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
 	ksmocks "github.com/smartcontractkit/chainlink/core/services/keystore/mocks"
@@ -304,7 +306,8 @@ func TestEthBroadcaster_ProcessUnstartedEthTxs_Success_WithMultiplier(t *testing
 	defer cleanup()
 
 	ethClient.On("SendTransaction", mock.Anything, mock.MatchedBy(func(tx *gethTypes.Transaction) bool {
-		assert.Equal(t, int(1600), int(tx.Gas()))
+		// 1231 * 1.3 = 1600.3, rounded up
+		assert.Equal(t, int(1601), int(tx.Gas()))
 		return true
 	})).Return(nil).Once()
 
@@ -324,6 +327,118 @@ func TestEthBroadcaster_ProcessUnstartedEthTxs_Success_WithMultiplier(t *testing
 	ethClient.AssertExpectations(t)
 }
 
+func TestEthBroadcaster_ProcessUnstartedEthTxs_SimulatesBeforeSend(t *testing.T) {
+	db := pgtest.NewGormDB(t)
+
+	ethKeyStore := cltest.NewKeyStore(t, db).Eth()
+	key, fromAddress := cltest.MustAddRandomKeyToKeystore(t, ethKeyStore, 0)
+	ethKeyStore.Unlock(cltest.Password)
+
+	config := cltest.NewTestEVMConfig(t)
+	config.Overrides.EvmSimulateTransactionsBeforeSend = null.BoolFrom(true)
+
+	ethClient := cltest.NewEthClientMock(t)
+
+	eb, cleanup := cltest.NewEthBroadcaster(t, db, ethClient, ethKeyStore, config, key)
+	defer cleanup()
+
+	toAddress := gethCommon.HexToAddress("0x6C03DDA95a2AEd917EeCc6eddD4b9D16E6380411")
+	reverting := bulletprooftxmanager.EthTx{
+		FromAddress:    fromAddress,
+		ToAddress:      toAddress,
+		EncodedPayload: []byte{1, 1, 1},
+		Value:          assets.NewEthValue(0),
+		GasLimit:       1000,
+		CreatedAt:      time.Unix(0, 0),
+		State:          bulletprooftxmanager.EthTxUnstarted,
+	}
+	require.NoError(t, db.Save(&reverting).Error)
+	succeeding := bulletprooftxmanager.EthTx{
+		FromAddress:    fromAddress,
+		ToAddress:      toAddress,
+		EncodedPayload: []byte{2, 2, 2},
+		Value:          assets.NewEthValue(0),
+		GasLimit:       1000,
+		CreatedAt:      time.Unix(1, 0),
+		State:          bulletprooftxmanager.EthTxUnstarted,
+	}
+	require.NoError(t, db.Save(&succeeding).Error)
+
+	ethClient.On("CallContract", mock.Anything, mock.MatchedBy(func(msg ethereum.CallMsg) bool {
+		return msg.Data[0] == 1 && msg.From == fromAddress && *msg.To == toAddress && msg.Gas == 1000
+	}), (*big.Int)(nil)).Return(nil, errors.New("execution reverted: not allowed")).Once()
+	ethClient.On("CallContract", mock.Anything, mock.MatchedBy(func(msg ethereum.CallMsg) bool {
+		return msg.Data[0] == 2
+	}), (*big.Int)(nil)).Return([]byte{}, nil).Once()
+	// Only the succeeding transaction is sent, and it gets the first nonce
+	ethClient.On("SendTransaction", mock.Anything, mock.MatchedBy(func(tx *gethTypes.Transaction) bool {
+		return tx.Nonce() == 0 && tx.Data()[0] == 2
+	})).Return(nil).Once()
+
+	require.NoError(t, eb.ProcessUnstartedEthTxs(key))
+	ethClient.AssertExpectations(t)
+
+	require.NoError(t, db.First(&reverting, reverting.ID).Error)
+	assert.Equal(t, bulletprooftxmanager.EthTxFatalError, reverting.State)
+	assert.Nil(t, reverting.Nonce)
+	assert.Equal(t, "transaction simulation reverted: execution reverted: not allowed", reverting.Error.String)
+
+	require.NoError(t, db.First(&succeeding, succeeding.ID).Error)
+	assert.Equal(t, bulletprooftxmanager.EthTxUnconfirmed, succeeding.State)
+	require.NotNil(t, succeeding.Nonce)
+	assert.Equal(t, int64(0), *succeeding.Nonce)
+}
+
+func TestEthBroadcaster_ProcessUnstartedEthTxs_SimulationFailures(t *testing.T) {
+	db := pgtest.NewGormDB(t)
+
+	ethKeyStore := cltest.NewKeyStore(t, db).Eth()
+	key, fromAddress := cltest.MustAddRandomKeyToKeystore(t, ethKeyStore, 0)
+	ethKeyStore.Unlock(cltest.Password)
+
+	config := cltest.NewTestEVMConfig(t)
+	config.Overrides.EvmSimulateTransactionsBeforeSend = null.BoolFrom(true)
+	config.Overrides.EvmGasLimitMultiplier = null.FloatFrom(1.5)
+
+	ethClient := cltest.NewEthClientMock(t)
+
+	eb, cleanup := cltest.NewEthBroadcaster(t, db, ethClient, ethKeyStore, config, key)
+	defer cleanup()
+
+	toAddress := gethCommon.HexToAddress("0x6C03DDA95a2AEd917EeCc6eddD4b9D16E6380411")
+	etx := bulletprooftxmanager.EthTx{
+		FromAddress:    fromAddress,
+		ToAddress:      toAddress,
+		EncodedPayload: []byte{1, 1, 1},
+		Value:          assets.NewEthValue(0),
+		GasLimit:       1000,
+		CreatedAt:      time.Unix(0, 0),
+		State:          bulletprooftxmanager.EthTxUnstarted,
+	}
+	require.NoError(t, db.Save(&etx).Error)
+
+	// The call is simulated with the gas limit it would be sent with
+	withSentGasLimit := mock.MatchedBy(func(msg ethereum.CallMsg) bool {
+		return msg.Gas == 1500
+	})
+
+	// A node that can't be reached leaves the transaction to be tried again
+	ethClient.On("CallContract", mock.Anything, withSentGasLimit, (*big.Int)(nil)).Return(nil, errors.New("connection refused")).Once()
+	require.Error(t, eb.ProcessUnstartedEthTxs(key))
+	require.NoError(t, db.First(&etx, etx.ID).Error)
+	assert.Equal(t, bulletprooftxmanager.EthTxUnstarted, etx.State)
+
+	// Whereas running out of gas would fail the same way when sent
+	ethClient.On("CallContract", mock.Anything, withSentGasLimit, (*big.Int)(nil)).Return(nil, errors.New("out of gas")).Once()
+	require.NoError(t, eb.ProcessUnstartedEthTxs(key))
+	ethClient.AssertExpectations(t)
+
+	require.NoError(t, db.First(&etx, etx.ID).Error)
+	assert.Equal(t, bulletprooftxmanager.EthTxFatalError, etx.State)
+	assert.Nil(t, etx.Nonce)
+	assert.Equal(t, "transaction simulation failed: out of gas", etx.Error.String)
+}
+
 func TestEthBroadcaster_AssignsNonceOnStart(t *testing.T) {
 	var err error
 	db := pgtest.NewGormDB(t)
@@ -1094,6 +1209,258 @@ func TestEthBroadcaster_ProcessUnstartedEthTxs_Errors(t *testing.T) {
 	})
 }
 
+func TestEthBroadcaster_ProcessUnstartedEthTxs_InsufficientFundsPause(t *testing.T) {
+	db := pgtest.NewGormDB(t)
+
+	ethKeyStore := cltest.NewKeyStore(t, db).Eth()
+	key, fromAddress := cltest.MustAddRandomKeyToKeystore(t, ethKeyStore, 0)
+	ethKeyStore.Unlock(cltest.Password)
+
+	config := cltest.NewTestEVMConfig(t)
+	config.Overrides.EvmInsufficientFundsAction = null.StringFrom("pause")
+	chainID := config.ChainID().String()
+
+	ethClient := cltest.NewEthClientMock(t)
+
+	eb, cleanup := cltest.NewEthBroadcaster(t, db, ethClient, ethKeyStore, config, key)
+	defer cleanup()
+
+	etx := bulletprooftxmanager.EthTx{
+		FromAddress:    fromAddress,
+		ToAddress:      gethCommon.HexToAddress("0x6C03DDA95a2AEd917EeCc6eddD4b9D16E6380411"),
+		EncodedPayload: []byte{0, 1},
+		Value:          assets.NewEthValue(142),
+		GasLimit:       242,
+		State:          bulletprooftxmanager.EthTxUnstarted,
+	}
+	require.NoError(t, db.Save(&etx).Error)
+	required := new(big.Int).Add(new(big.Int).Mul(config.EvmGasPriceDefault(), big.NewInt(242)), big.NewInt(142))
+	before := bulletprooftxmanager.InsufficientFundsCount(chainID)
+
+	ethClient.On("SendTransaction", mock.Anything, mock.MatchedBy(func(tx *gethTypes.Transaction) bool {
+		return tx.Nonce() == 0
+	})).Return(errors.New("insufficient funds for transfer")).Once()
+
+	err := eb.ProcessUnstartedEthTxs(key)
+	require.EqualError(t, err, "processUnstartedEthTxs failed: insufficient funds for transfer")
+	assert.Equal(t, before+1, bulletprooftxmanager.InsufficientFundsCount(chainID))
+	ethClient.AssertExpectations(t)
+
+	// Still underfunded, so nothing is sent
+	ethClient.On("BalanceAt", mock.Anything, fromAddress, (*big.Int)(nil)).Return(new(big.Int).Sub(required, big.NewInt(1)), nil).Once()
+	require.NoError(t, eb.ProcessUnstartedEthTxs(key))
+	ethClient.AssertExpectations(t)
+
+	// Funded, so the in_progress transaction is sent again
+	ethClient.On("BalanceAt", mock.Anything, fromAddress, (*big.Int)(nil)).Return(required, nil).Once()
+	ethClient.On("SendTransaction", mock.Anything, mock.MatchedBy(func(tx *gethTypes.Transaction) bool {
+		return tx.Nonce() == 0
+	})).Return(nil).Once()
+	require.NoError(t, eb.ProcessUnstartedEthTxs(key))
+	ethClient.AssertExpectations(t)
+
+	etx, err = cltest.FindEthTxWithAttempts(db, etx.ID)
+	require.NoError(t, err)
+	assert.Equal(t, bulletprooftxmanager.EthTxUnconfirmed, etx.State)
+	assert.Equal(t, before+1, bulletprooftxmanager.InsufficientFundsCount(chainID))
+}
+
+func TestEthBroadcaster_ProcessUnstartedEthTxs_SkipEstimationForSimpleTransfers(t *testing.T) {
+	db := pgtest.NewGormDB(t)
+
+	ethKeyStore := cltest.NewKeyStore(t, db).Eth()
+	key, fromAddress := cltest.MustAddRandomKeyToKeystore(t, ethKeyStore, 0)
+	ethKeyStore.Unlock(cltest.Password)
+
+	config := cltest.NewTestEVMConfig(t)
+	config.Overrides.EvmSkipEstimationForSimpleTransfers = null.BoolFrom(true)
+
+	ethClient := cltest.NewEthClientMock(t)
+	estimator := new(gasmocks.Estimator)
+
+	eb := bulletprooftxmanager.NewEthBroadcaster(db, ethClient, config, ethKeyStore, &postgres.NullAdvisoryLocker{}, &postgres.NullEventBroadcaster{}, []ethkey.Key{key}, estimator)
+
+	etx := bulletprooftxmanager.EthTx{
+		FromAddress:    fromAddress,
+		ToAddress:      cltest.NewAddress(),
+		EncodedPayload: []byte{},
+		Value:          *assets.NewEth(0),
+		GasLimit:       500000,
+		State:          bulletprooftxmanager.EthTxUnstarted,
+	}
+	require.NoError(t, db.Save(&etx).Error)
+
+	ethClient.On("SendTransaction", mock.Anything, mock.MatchedBy(func(tx *gethTypes.Transaction) bool {
+		return tx.Nonce() == 0 && tx.Gas() == config.EvmGasLimitTransfer() && tx.GasPrice().Cmp(config.EvmGasPriceDefault()) == 0
+	})).Return(nil).Once()
+
+	require.NoError(t, eb.ProcessUnstartedEthTxs(key))
+
+	estimator.AssertNotCalled(t, "EstimateGas", mock.Anything, mock.Anything)
+	ethClient.AssertExpectations(t)
+
+	etx, err := cltest.FindEthTxWithAttempts(db, etx.ID)
+	require.NoError(t, err)
+	assert.Equal(t, bulletprooftxmanager.EthTxUnconfirmed, etx.State)
+
+	// Transactions with data still go through the estimator
+	etx = bulletprooftxmanager.EthTx{
+		FromAddress:    fromAddress,
+		ToAddress:      cltest.NewAddress(),
+		EncodedPayload: []byte{42, 42, 0},
+		Value:          *assets.NewEth(0),
+		GasLimit:       500000,
+		State:          bulletprooftxmanager.EthTxUnstarted,
+	}
+	require.NoError(t, db.Save(&etx).Error)
+
+	estimator.On("EstimateGas", []byte{42, 42, 0}, uint64(500000)).Return(big.NewInt(32), uint64(500000), nil).Once()
+	ethClient.On("SendTransaction", mock.Anything, mock.MatchedBy(func(tx *gethTypes.Transaction) bool {
+		return tx.Nonce() == 1 && tx.GasPrice().Cmp(big.NewInt(32)) == 0
+	})).Return(nil).Once()
+
+	require.NoError(t, eb.ProcessUnstartedEthTxs(key))
+
+	estimator.AssertExpectations(t)
+	ethClient.AssertExpectations(t)
+
+	// A default gas price above the max is clamped
+	maxGasPrice := new(big.Int).Sub(config.EvmGasPriceDefault(), big.NewInt(1))
+	config.Overrides.EvmMaxGasPriceWei = maxGasPrice
+	etx = bulletprooftxmanager.EthTx{
+		FromAddress:    fromAddress,
+		ToAddress:      cltest.NewAddress(),
+		EncodedPayload: []byte{},
+		Value:          *assets.NewEth(0),
+		GasLimit:       500000,
+		State:          bulletprooftxmanager.EthTxUnstarted,
+	}
+	require.NoError(t, db.Save(&etx).Error)
+
+	ethClient.On("SendTransaction", mock.Anything, mock.MatchedBy(func(tx *gethTypes.Transaction) bool {
+		return tx.Nonce() == 2 && tx.GasPrice().Cmp(maxGasPrice) == 0
+	})).Return(nil).Once()
+
+	require.NoError(t, eb.ProcessUnstartedEthTxs(key))
+
+	ethClient.AssertExpectations(t)
+}
+
+func TestEthBroadcaster_ProcessUnstartedEthTxs_GlobalMaxInFlightTransactions(t *testing.T) {
+	db := pgtest.NewGormDB(t)
+
+	ethKeyStore := cltest.NewKeyStore(t, db).Eth()
+	key1, fromAddress1 := cltest.MustAddRandomKeyToKeystore(t, ethKeyStore, 0)
+	key2, fromAddress2 := cltest.MustAddRandomKeyToKeystore(t, ethKeyStore, 0)
+	ethKeyStore.Unlock(cltest.Password)
+
+	config := cltest.NewTestEVMConfig(t)
+	config.GeneralConfig.Overrides.GlobalMaxInFlightTransactions = null.IntFrom(1)
+
+	ethClient := cltest.NewEthClientMock(t)
+
+	eb, cleanup := cltest.NewEthBroadcaster(t, db, ethClient, ethKeyStore, config, key1, key2)
+	defer cleanup()
+
+	insertEthTx := func(fromAddress gethCommon.Address) bulletprooftxmanager.EthTx {
+		etx := bulletprooftxmanager.EthTx{
+			FromAddress:    fromAddress,
+			ToAddress:      gethCommon.HexToAddress("0x6C03DDA95a2AEd917EeCc6eddD4b9D16E6380411"),
+			EncodedPayload: []byte{0, 1},
+			Value:          assets.NewEthValue(142),
+			GasLimit:       242,
+			State:          bulletprooftxmanager.EthTxUnstarted,
+		}
+		require.NoError(t, db.Save(&etx).Error)
+		return etx
+	}
+	etxState := func(id int64) bulletprooftxmanager.EthTxState {
+		etx, err := cltest.FindEthTxWithAttempts(db, id)
+		require.NoError(t, err)
+		return etx.State
+	}
+	etx1 := insertEthTx(fromAddress1)
+	etx2 := insertEthTx(fromAddress2)
+
+	ethClient.On("SendTransaction", mock.Anything, mock.MatchedBy(func(tx *gethTypes.Transaction) bool {
+		return tx.Nonce() == 0
+	})).Return(nil).Once()
+	require.NoError(t, eb.ProcessUnstartedEthTxs(key1))
+	ethClient.AssertExpectations(t)
+	assert.Equal(t, bulletprooftxmanager.EthTxUnconfirmed, etxState(etx1.ID))
+
+	// The second key is throttled while the first key's transaction is in
+	// flight
+	done := make(chan error, 1)
+	go func() {
+		done <- eb.ProcessUnstartedEthTxs(key2)
+	}()
+	g := gomega.NewGomegaWithT(t)
+	g.Consistently(func() bulletprooftxmanager.EthTxState {
+		return etxState(etx2.ID)
+	}, 3*bulletprooftxmanager.InFlightTransactionRecheckInterval, cltest.DBPollingInterval).Should(gomega.Equal(bulletprooftxmanager.EthTxUnstarted))
+	ethClient.AssertExpectations(t)
+
+	ethClient.On("SendTransaction", mock.Anything, mock.MatchedBy(func(tx *gethTypes.Transaction) bool {
+		return tx.Nonce() == 0
+	})).Return(nil).Once()
+	require.NoError(t, db.Exec(`UPDATE eth_txes SET state = ? WHERE id = ?`, bulletprooftxmanager.EthTxConfirmed, etx1.ID).Error)
+
+	select {
+	case err := <-done:
+		require.NoError(t, err)
+	case <-time.After(cltest.DBWaitTimeout):
+		t.Fatal("timed out waiting for the second key's transaction to be sent")
+	}
+	ethClient.AssertExpectations(t)
+	assert.Equal(t, bulletprooftxmanager.EthTxUnconfirmed, etxState(etx2.ID))
+}
+
+func TestEthBroadcaster_ProcessUnstartedEthTxs_InsufficientFundsPauseSurvivesRecreate(t *testing.T) {
+	db := pgtest.NewGormDB(t)
+
+	ethKeyStore := cltest.NewKeyStore(t, db).Eth()
+	key, fromAddress := cltest.MustAddRandomKeyToKeystore(t, ethKeyStore, 0)
+	ethKeyStore.Unlock(cltest.Password)
+
+	config := cltest.NewTestEVMConfig(t)
+	config.Overrides.EvmInsufficientFundsAction = null.StringFrom("pause")
+
+	ethClient := cltest.NewEthClientMock(t)
+
+	bptxm := bulletprooftxmanager.NewBulletproofTxManager(db, ethClient, config, ethKeyStore, &postgres.NullAdvisoryLocker{}, &postgres.NullEventBroadcaster{})
+	bulletprooftxmanager.SetGasEstimator(bptxm, gas.NewFixedPriceEstimator(config))
+
+	etx := bulletprooftxmanager.EthTx{
+		FromAddress:    fromAddress,
+		ToAddress:      gethCommon.HexToAddress("0x6C03DDA95a2AEd917EeCc6eddD4b9D16E6380411"),
+		EncodedPayload: []byte{0, 1},
+		Value:          assets.NewEthValue(142),
+		GasLimit:       242,
+		State:          bulletprooftxmanager.EthTxUnstarted,
+	}
+	require.NoError(t, db.Save(&etx).Error)
+	required := new(big.Int).Add(new(big.Int).Mul(config.EvmGasPriceDefault(), big.NewInt(242)), big.NewInt(142))
+
+	eb := bulletprooftxmanager.NewEthBroadcasterFromTxManager(bptxm, []ethkey.Key{key})
+	ethClient.On("SendTransaction", mock.Anything, mock.MatchedBy(func(tx *gethTypes.Transaction) bool {
+		return tx.Nonce() == 0
+	})).Return(errors.New("insufficient funds for transfer")).Once()
+	require.EqualError(t, eb.ProcessUnstartedEthTxs(key), "processUnstartedEthTxs failed: insufficient funds for transfer")
+	ethClient.AssertExpectations(t)
+
+	// The broadcaster is recreated, e.g. because keys changed, and the key
+	// stays paused: its balance is checked and nothing is sent
+	eb = bulletprooftxmanager.NewEthBroadcasterFromTxManager(bptxm, []ethkey.Key{key})
+	ethClient.On("BalanceAt", mock.Anything, fromAddress, (*big.Int)(nil)).Return(new(big.Int).Sub(required, big.NewInt(1)), nil).Once()
+	require.NoError(t, eb.ProcessUnstartedEthTxs(key))
+	ethClient.AssertExpectations(t)
+
+	etx, err := cltest.FindEthTxWithAttempts(db, etx.ID)
+	require.NoError(t, err)
+	assert.Equal(t, bulletprooftxmanager.EthTxInProgress, etx.State)
+}
+
 func TestEthBroadcaster_ProcessUnstartedEthTxs_KeystoreErrors(t *testing.T) {
 	toAddress := gethCommon.HexToAddress("0x6C03DDA95a2AEd917EeCc6eddD4b9D16E6380411")
 	value := assets.NewEthValue(142)
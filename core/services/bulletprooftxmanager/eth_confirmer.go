@@ -4,12 +4,15 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"math"
 	"math/big"
 	"sort"
 	"strings"
 	"sync"
 	"time"
 
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/null"
 	"github.com/smartcontractkit/chainlink/core/services/eth"
@@ -17,12 +20,14 @@ import (
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
 	"github.com/smartcontractkit/chainlink/core/services/postgres"
 	"github.com/smartcontractkit/chainlink/core/static"
+	"github.com/smartcontractkit/chainlink/core/store/config"
 	"github.com/smartcontractkit/chainlink/core/store/models"
 	"github.com/smartcontractkit/chainlink/core/utils"
 
 	gethCommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/lib/pq"
 	"github.com/pkg/errors"
 	"go.uber.org/multierr"
 	"gorm.io/gorm"
@@ -38,6 +43,21 @@ var (
 	// ErrCouldNotGetReceipt is the error string we save if we reach our finality depth for a confirmed transaction without ever getting a receipt
 	// This most likely happened because an external wallet used the account for this nonce
 	ErrCouldNotGetReceipt = "could not get receipt"
+
+	promNonceGap = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "tx_manager_nonce_gap",
+		Help: "Number of nonces missing between the highest confirmed and lowest unconfirmed transaction of a key. Only reported if ETH_MAX_NONCE_GAP is set",
+	}, []string{"evmChainID", "fromAddress"})
+	promGasPriceInclusionRatio = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "tx_manager_gas_price_inclusion_ratio",
+		Help:    "Ratio of the gas price of the attempt that was included on-chain to the initially estimated gas price, per confirmed transaction",
+		Buckets: []float64{1, 1.1, 1.2, 1.5, 2, 3, 5, 10},
+	}, []string{"evmChainID"})
+	promGasBumpsUntilInclusion = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "tx_manager_gas_bumps_until_inclusion",
+		Help:    "Number of gas bumps it took before the attempt that was included on-chain, per confirmed transaction",
+		Buckets: []float64{0, 1, 2, 3, 5, 8, 13, 21},
+	}, []string{"evmChainID"})
 )
 
 // EthConfirmer is a broad service which performs four different tasks in sequence on every new longest chain
@@ -62,6 +82,14 @@ type EthConfirmer struct {
 	ctx       context.Context
 	ctxCancel context.CancelFunc
 	wg        sync.WaitGroup
+
+	// haltBroadcasting is called if a nonce gap is found and
+	// EvmNonceGapAction is "halt"
+	haltBroadcasting func(reason error)
+
+	// receiptFetchFrom is the broadcast block at which the next receipt
+	// fetch window starts if EvmReceiptFetchMaxBlocks is set
+	receiptFetchFrom int64
 }
 
 // NewEthConfirmer instantiates a new eth confirmer
@@ -80,6 +108,8 @@ func NewEthConfirmer(db *gorm.DB, ethClient eth.Client, config Config, keystore
 		context,
 		cancel,
 		sync.WaitGroup{},
+		nil,
+		0,
 	}
 }
 
@@ -164,6 +194,12 @@ func (ec *EthConfirmer) processHead(ctx context.Context, head models.Head) error
 	logger.Debugw("EthConfirmer: finished CheckForReceipts", "headNum", head.Number, "time", time.Since(mark), "id", "eth_confirmer")
 	mark = time.Now()
 
+	// A nonce gap does not stop the remaining steps, since transactions
+	// below the gap still need to be bumped and checked for re-orgs
+	if err := ec.CheckForNonceGaps(); err != nil {
+		logger.Errorw("EthConfirmer: CheckForNonceGaps failed", "err", err)
+	}
+
 	if err := ec.RebroadcastWhereNecessary(ctx, head.Number); err != nil {
 		return errors.Wrap(err, "RebroadcastWhereNecessary failed")
 	}
@@ -178,6 +214,92 @@ func (ec *EthConfirmer) processHead(ctx context.Context, head models.Head) error
 	return errors.Wrap(ec.EnsureConfirmedTransactionsInLongestChain(ctx, head), "EnsureConfirmedTransactionsInLongestChain failed")
 }
 
+// NonceGap describes the nonces missing between the highest confirmed and the
+// lowest unconfirmed transaction of an address
+type NonceGap struct {
+	FromAddress       gethCommon.Address
+	HighestConfirmed  int64
+	LowestUnconfirmed int64
+}
+
+// Size returns the number of missing nonces
+func (g NonceGap) Size() int64 {
+	return g.LowestUnconfirmed - g.HighestConfirmed - 1
+}
+
+// FindNonceGaps returns the gap between the highest confirmed nonce and the
+// lowest unconfirmed nonce for each of the given addresses that has both
+func FindNonceGaps(db *gorm.DB, addresses []gethCommon.Address) (gaps []NonceGap, err error) {
+	if len(addresses) == 0 {
+		return nil, nil
+	}
+	rows, err := db.Raw(`
+SELECT from_address, highest_confirmed, lowest_unconfirmed FROM (
+	SELECT from_address,
+		MAX(nonce) FILTER (WHERE state IN ('confirmed', 'confirmed_missing_receipt')) AS highest_confirmed,
+		MIN(nonce) FILTER (WHERE state = 'unconfirmed') AS lowest_unconfirmed
+	FROM eth_txes
+	WHERE from_address IN (?) AND nonce IS NOT NULL
+	GROUP BY from_address
+) n
+WHERE highest_confirmed IS NOT NULL AND lowest_unconfirmed IS NOT NULL
+ORDER BY from_address`, addresses).Rows()
+	if err != nil {
+		return nil, errors.Wrap(err, "FindNonceGaps failed to query eth_txes")
+	}
+	defer logger.ErrorIfCalling(rows.Close)
+	for rows.Next() {
+		var gap NonceGap
+		if err = rows.Scan(&gap.FromAddress, &gap.HighestConfirmed, &gap.LowestUnconfirmed); err != nil {
+			return nil, errors.Wrap(err, "FindNonceGaps failed to scan row")
+		}
+		gaps = append(gaps, gap)
+	}
+	return gaps, rows.Err()
+}
+
+// CheckForNonceGaps reports any key whose nonce gap exceeds EvmMaxNonceGap,
+// and halts broadcasting if EvmNonceGapAction is "halt". A gap means
+// transactions above it can never be mined until the missing nonces are
+// filled, which needs manual intervention.
+func (ec *EthConfirmer) CheckForNonceGaps() error {
+	maxGap := ec.config.EvmMaxNonceGap()
+	if maxGap == 0 {
+		return nil
+	}
+	addresses := make([]gethCommon.Address, len(ec.keys))
+	for i, key := range ec.keys {
+		addresses[i] = key.Address.Address()
+	}
+	gaps, err := FindNonceGaps(ec.db, addresses)
+	if err != nil {
+		return err
+	}
+	chainID := ec.config.ChainID().String()
+	found := make(map[gethCommon.Address]NonceGap, len(gaps))
+	for _, gap := range gaps {
+		found[gap.FromAddress] = gap
+	}
+	for _, address := range addresses {
+		gap, exists := found[address]
+		if !exists {
+			promNonceGap.WithLabelValues(chainID, address.Hex()).Set(0)
+			continue
+		}
+		size := gap.Size()
+		promNonceGap.WithLabelValues(chainID, address.Hex()).Set(float64(size))
+		if size <= int64(maxGap) {
+			continue
+		}
+		logger.Errorw(fmt.Sprintf("EthConfirmer: %d nonce(s) missing for %s between confirmed nonce %d and unconfirmed nonce %d, which exceeds ETH_MAX_NONCE_GAP of %d. Transactions from this key will not be mined until the gap is filled; this requires manual intervention", size, address.Hex(), gap.HighestConfirmed, gap.LowestUnconfirmed, maxGap),
+			"evmChainID", chainID, "fromAddress", address.Hex(), "nonceGap", size, "maxNonceGap", maxGap)
+		if ec.config.EvmNonceGapAction() == config.NonceGapActionHalt && ec.haltBroadcasting != nil {
+			ec.haltBroadcasting(errors.Errorf("nonce gap of %d for %s exceeds ETH_MAX_NONCE_GAP of %d", size, address.Hex(), maxGap))
+		}
+	}
+	return nil
+}
+
 // SetBroadcastBeforeBlockNum updates already broadcast attempts with the
 // current block number. This is safe no matter how old the head is because if
 // the attempt is already broadcast it _must_ have been before this head.
@@ -196,6 +318,13 @@ func (ec *EthConfirmer) CheckForReceipts(ctx context.Context, blockNum int64) er
 	if len(attempts) == 0 {
 		return nil
 	}
+	if maxBlocks := ec.config.EvmReceiptFetchMaxBlocks(); maxBlocks > 0 {
+		total := len(attempts)
+		attempts, ec.receiptFetchFrom = receiptFetchWindow(attempts, blockNum, ec.receiptFetchFrom, maxBlocks)
+		if len(attempts) < total {
+			logger.Debugw(fmt.Sprintf("EthConfirmer: ETH_RECEIPT_FETCH_MAX_BLOCKS is %d, so only fetching receipts for %d of %d transaction attempts", maxBlocks, len(attempts), total), "blockNum", blockNum)
+		}
+	}
 
 	logger.Debugw(fmt.Sprintf("EthConfirmer: fetching receipts for %v transaction attempts", len(attempts)), "blockNum", blockNum)
 
@@ -244,6 +373,41 @@ func (ec *EthConfirmer) CheckForReceipts(ctx context.Context, blockNum int64) er
 	return nil
 }
 
+// receiptFetchWindow returns the attempts broadcast within maxBlocks blocks
+// starting at the first broadcast block at or after from, and where the next
+// window starts. Once every broadcast block has been covered it starts again
+// at the oldest, so no attempt is skipped for good. Attempts not yet marked
+// with a broadcast block are taken to have been broadcast at blockNum.
+func receiptFetchWindow(attempts []EthTxAttempt, blockNum, from int64, maxBlocks uint64) ([]EthTxAttempt, int64) {
+	broadcastAt := func(a EthTxAttempt) int64 {
+		if a.BroadcastBeforeBlockNum != nil {
+			return *a.BroadcastBeforeBlockNum
+		}
+		return blockNum
+	}
+	oldest, start := int64(math.MaxInt64), int64(math.MaxInt64)
+	for _, a := range attempts {
+		b := broadcastAt(a)
+		if b < oldest {
+			oldest = b
+		}
+		if b >= from && b < start {
+			start = b
+		}
+	}
+	if start == math.MaxInt64 {
+		start = oldest
+	}
+	end := start + int64(maxBlocks)
+	window := make([]EthTxAttempt, 0, len(attempts))
+	for _, a := range attempts {
+		if b := broadcastAt(a); b >= start && b < end {
+			window = append(window, a)
+		}
+	}
+	return window, end
+}
+
 func separateLikelyConfirmedAttempts(from gethCommon.Address, attempts []EthTxAttempt, latestBlockNonce uint64) []EthTxAttempt {
 	if len(attempts) == 0 {
 		return attempts
@@ -275,25 +439,120 @@ func (ec *EthConfirmer) fetchAndSaveReceipts(ctx context.Context, attempts []Eth
 	if batchSize == 0 {
 		batchSize = len(attempts)
 	}
+	concurrency := int(ec.config.EvmConfirmerConcurrency())
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	var wg sync.WaitGroup
+	errors := []error{}
+	var errMu sync.Mutex
+	sem := make(chan struct{}, concurrency)
 	for i := 0; i < len(attempts); i += batchSize {
 		j := i + batchSize
 		if j > len(attempts) {
 			j = len(attempts)
 		}
 
+		sem <- struct{}{}
+		// Stop scheduling further batches once one has failed
+		errMu.Lock()
+		failed := len(errors) > 0
+		errMu.Unlock()
+		if failed {
+			<-sem
+			break
+		}
+
 		logger.Debugw(fmt.Sprintf("EthConfirmer: batch fetching receipts at indexes %v until (excluded) %v", i, j), "blockNum", blockNum)
 
-		batch := attempts[i:j]
+		wg.Add(1)
+		go func(batch []EthTxAttempt) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			if err := ec.fetchAndSaveReceiptsBatch(ctx, batch); err != nil {
+				errMu.Lock()
+				errors = append(errors, err)
+				errMu.Unlock()
+			}
+		}(attempts[i:j])
+	}
+
+	wg.Wait()
 
-		receipts, err := ec.batchFetchReceipts(ctx, batch)
-		if err != nil {
-			return errors.Wrap(err, "batchFetchReceipts failed")
+	return multierr.Combine(errors...)
+}
+
+func (ec *EthConfirmer) fetchAndSaveReceiptsBatch(ctx context.Context, batch []EthTxAttempt) error {
+	receipts, err := ec.batchFetchReceipts(ctx, batch)
+	if err != nil {
+		return errors.Wrap(err, "batchFetchReceipts failed")
+	}
+	if err := ec.saveFetchedReceipts(receipts); err != nil {
+		return errors.Wrap(err, "saveFetchedReceipts failed")
+	}
+	ec.observeInclusions(receipts)
+	return nil
+}
+
+// observeInclusions records how far the gas price of each transaction
+// confirmed by receipts had to be bumped from the initial estimate before it
+// was included. Failing to do so is logged but otherwise ignored, since the
+// receipts have already been saved.
+func (ec *EthConfirmer) observeInclusions(receipts []Receipt) {
+	if len(receipts) == 0 {
+		return
+	}
+	hashes := make([][]byte, len(receipts))
+	included := make(map[gethCommon.Hash]struct{}, len(receipts))
+	for i, r := range receipts {
+		hashes[i] = r.TxHash.Bytes()
+		included[r.TxHash] = struct{}{}
+	}
+
+	ctx, cancel := postgres.DefaultQueryCtx()
+	defer cancel()
+
+	var attempts []EthTxAttempt
+	err := ec.db.WithContext(ctx).
+		Where("eth_tx_id IN (SELECT eth_tx_id FROM eth_tx_attempts WHERE hash = ANY(?))", pq.ByteaArray(hashes)).
+		Order("eth_tx_id ASC, id ASC").
+		Find(&attempts).Error
+	if err != nil {
+		logger.Warnw("EthConfirmer: failed to load attempts for gas price inclusion metrics", "err", err)
+		return
+	}
+
+	chainID := ec.config.ChainID().String()
+	for i := 0; i < len(attempts); {
+		j := i + 1
+		for j < len(attempts) && attempts[j].EthTxID == attempts[i].EthTxID {
+			j++
 		}
-		if err := ec.saveFetchedReceipts(receipts); err != nil {
-			return errors.Wrap(err, "saveFetchedReceipts failed")
+		for k := i; k < j; k++ {
+			if _, ok := included[attempts[k].Hash]; ok {
+				observeInclusion(chainID, attempts[i:j], k-i)
+				break
+			}
 		}
+		i = j
 	}
-	return nil
+}
+
+// observeInclusion records the gas price inclusion metrics for a single
+// eth_tx, given its attempts in the order they were created and the index of
+// the one that was included
+func observeInclusion(chainID string, attempts []EthTxAttempt, included int) {
+	promGasBumpsUntilInclusion.WithLabelValues(chainID).Observe(float64(included))
+	initial := attempts[0].GasPrice.ToInt()
+	if initial.Sign() <= 0 {
+		return
+	}
+	final := attempts[included].GasPrice.ToInt()
+	ratio, _ := new(big.Float).Quo(new(big.Float).SetInt(final), new(big.Float).SetInt(initial)).Float64()
+	promGasPriceInclusionRatio.WithLabelValues(chainID).Observe(ratio)
 }
 
 func (ec *EthConfirmer) findEthTxAttemptsRequiringReceiptFetch() (attempts []EthTxAttempt, err error) {
@@ -525,7 +784,7 @@ func (ec *EthConfirmer) markOldTxesMissingReceiptAsErrored(blockNum int64) error
 	// cutoff is a block height
 	// Any 'confirmed_missing_receipt' eth_tx with all attempts older than this block height will be marked as errored
 	// We will not try to query for receipts for this transaction any more
-	cutoff := blockNum - int64(ec.config.EvmFinalityDepth())
+	cutoff := blockNum - int64(ec.config.EvmReceiptFetchDepth())
 	if cutoff <= 0 {
 		return nil
 	}
@@ -814,10 +1073,10 @@ func (ec *EthConfirmer) attemptForRebroadcast(ctx context.Context, etx EthTx) (a
 		logger.Errorf("invariant violation: EthTx %v was unconfirmed but didn't have any attempts. "+
 			"Falling back to default gas price instead."+
 			"This is a bug! Please report to https://github.com/smartcontractkit/chainlink/issues", etx.ID)
-		bumpedGasPrice = ec.config.EvmGasPriceDefault()
+		bumpedGasPrice = new(big.Int).Set(ec.config.EvmGasPriceDefault())
 		bumpedGasLimit = etx.GasLimit
 	}
-	return newAttempt(ec.ethClient, ec.keystore, ec.config.ChainID(), etx, bumpedGasPrice, bumpedGasLimit)
+	return newAttempt(ec.ethClient, ec.keystore, ec.config.SignerChainID(), ec.config.EvmForceTxType(), etx, bumpedGasPrice, bumpedGasLimit)
 }
 
 func (ec *EthConfirmer) saveInProgressAttempt(attempt *EthTxAttempt) error {
@@ -848,7 +1107,7 @@ func (ec *EthConfirmer) handleInProgressAttempt(ctx context.Context, etx EthTx,
 			"Eth node returned: '%s'. "+
 			"Bumping to %v wei and retrying. "+
 			"ACTION REQUIRED: You should consider increasing ETH_GAS_PRICE_DEFAULT", attempt.GasPrice.String(), sendError.Error(), bumpedGasPrice)
-		replacementAttempt, err := newAttempt(ec.ethClient, ec.keystore, ec.config.ChainID(), etx, bumpedGasPrice, bumpedGasLimit)
+		replacementAttempt, err := newAttempt(ec.ethClient, ec.keystore, ec.config.SignerChainID(), ec.config.EvmForceTxType(), etx, bumpedGasPrice, bumpedGasLimit)
 		if err != nil {
 			return errors.Wrap(err, "newAttempt failed")
 		}
@@ -940,6 +1199,7 @@ func (ec *EthConfirmer) handleInProgressAttempt(ctx context.Context, etx EthTx,
 			"ACTION REQUIRED: Chainlink wallet with address 0x%x is OUT OF FUNDS",
 			attempt.ID, attempt.Hash, attempt.GasPrice.String(), sendError.Error(), etx.FromAddress,
 		), "err", sendError)
+		promInsufficientFundsCount.WithLabelValues(ec.config.ChainID().String()).Inc()
 		return saveInsufficientEthAttempt(ec.db, &attempt, now)
 	}
 
@@ -1167,7 +1427,7 @@ func (ec *EthConfirmer) ForceRebroadcast(beginningNonce uint, endingNonce uint,
 			if overrideGasLimit != 0 {
 				etx.GasLimit = overrideGasLimit
 			}
-			attempt, err := newAttempt(ec.ethClient, ec.keystore, ec.config.ChainID(), *etx, big.NewInt(int64(gasPriceWei)), etx.GasLimit)
+			attempt, err := newAttempt(ec.ethClient, ec.keystore, ec.config.SignerChainID(), ec.config.EvmForceTxType(), *etx, big.NewInt(int64(gasPriceWei)), etx.GasLimit)
 			if err != nil {
 				logger.Errorw("ForceRebroadcast: failed to create new attempt", "ethTxID", etx.ID, "err", err)
 				continue
@@ -1187,7 +1447,7 @@ func (ec *EthConfirmer) sendEmptyTransaction(ctx context.Context, fromAddress ge
 	if gasLimit == 0 {
 		gasLimit = ec.config.EvmGasLimitDefault()
 	}
-	tx, err := sendEmptyTransaction(ec.ethClient, ec.keystore, uint64(nonce), gasLimit, big.NewInt(int64(gasPriceWei)), fromAddress, ec.config.ChainID())
+	tx, err := sendEmptyTransaction(ec.ethClient, ec.keystore, uint64(nonce), gasLimit, big.NewInt(int64(gasPriceWei)), fromAddress, ec.config.SignerChainID())
 	if err != nil {
 		return gethCommon.Hash{}, errors.Wrap(err, "(EthConfirmer).sendEmptyTransaction failed")
 	}
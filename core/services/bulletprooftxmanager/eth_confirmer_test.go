@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"math/big"
 	"reflect"
+	"sync"
 	"testing"
 	"time"
 
@@ -16,6 +17,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/services/bulletprooftxmanager"
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
 	ksmocks "github.com/smartcontractkit/chainlink/core/services/keystore/mocks"
+	"github.com/smartcontractkit/chainlink/core/store/config"
 	"github.com/smartcontractkit/chainlink/core/store/models"
 	"github.com/smartcontractkit/chainlink/core/utils"
 	"gorm.io/gorm"
@@ -552,6 +554,242 @@ func TestEthConfirmer_CheckForReceipts_batching(t *testing.T) {
 	ethClient.AssertExpectations(t)
 }
 
+// Not parallel, since the metrics are shared with the other tests for this chain
+func TestEthConfirmer_CheckForReceipts_observesGasPriceInclusion(t *testing.T) {
+	db := pgtest.NewGormDB(t)
+	ethKeyStore := cltest.NewKeyStore(t, db).Eth()
+
+	key, fromAddress := cltest.MustAddRandomKeyToKeystore(t, ethKeyStore, 0)
+
+	ethClient := cltest.NewEthClientMock(t)
+
+	config := cltest.NewTestEVMConfig(t)
+	chainID := config.ChainID().String()
+
+	ec := cltest.NewEthConfirmer(t, db, ethClient, config, ethKeyStore, []ethkey.Key{key})
+
+	etx := cltest.MustInsertUnconfirmedEthTx(t, db, 0, fromAddress)
+	var attempts []bulletprooftxmanager.EthTxAttempt
+	for _, gasPrice := range []int64{100, 150, 200} {
+		attempt := newBroadcastEthTxAttempt(t, etx.ID, gasPrice)
+		require.NoError(t, db.Create(&attempt).Error)
+		attempts = append(attempts, attempt)
+	}
+
+	ratioCount, ratioSum := bulletprooftxmanager.GasPriceInclusionRatio(chainID)
+	bumpsCount, bumpsSum := bulletprooftxmanager.GasBumpsUntilInclusion(chainID)
+
+	// The first bump, at 150, is included
+	bptxmReceipt := bulletprooftxmanager.Receipt{
+		TxHash:           attempts[1].Hash,
+		BlockHash:        utils.NewHash(),
+		BlockNumber:      big.NewInt(42),
+		TransactionIndex: uint(1),
+	}
+
+	ethClient.On("NonceAt", mock.Anything, mock.Anything, mock.Anything).Return(uint64(10), nil)
+	ethClient.On("BatchCallContext", mock.Anything, mock.MatchedBy(func(b []rpc.BatchElem) bool {
+		return len(b) == 3 &&
+			cltest.BatchElemMatchesHash(b[0], attempts[2].Hash) &&
+			cltest.BatchElemMatchesHash(b[1], attempts[1].Hash) &&
+			cltest.BatchElemMatchesHash(b[2], attempts[0].Hash)
+	})).Return(nil).Run(func(args mock.Arguments) {
+		elems := args.Get(1).([]rpc.BatchElem)
+		elems[0].Result = &bulletprooftxmanager.Receipt{}
+		elems[1].Result = &bptxmReceipt
+		elems[2].Result = &bulletprooftxmanager.Receipt{}
+	}).Once()
+
+	require.NoError(t, ec.CheckForReceipts(context.Background(), 42))
+	ethClient.AssertExpectations(t)
+
+	count, sum := bulletprooftxmanager.GasPriceInclusionRatio(chainID)
+	assert.Equal(t, ratioCount+1, count)
+	assert.InDelta(t, ratioSum+1.5, sum, 1e-9)
+	count, sum = bulletprooftxmanager.GasBumpsUntilInclusion(chainID)
+	assert.Equal(t, bumpsCount+1, count)
+	assert.InDelta(t, bumpsSum+1, sum, 1e-9)
+}
+
+func TestEthConfirmer_ObserveInclusion(t *testing.T) {
+	t.Parallel()
+
+	chainID := "observe-inclusion-test"
+	attempts := []bulletprooftxmanager.EthTxAttempt{
+		newBroadcastEthTxAttempt(t, 1, 100),
+		newBroadcastEthTxAttempt(t, 1, 120),
+		newBroadcastEthTxAttempt(t, 1, 250),
+	}
+
+	bulletprooftxmanager.ObserveInclusion(chainID, attempts, 0)
+	bulletprooftxmanager.ObserveInclusion(chainID, attempts, 2)
+
+	count, sum := bulletprooftxmanager.GasPriceInclusionRatio(chainID)
+	assert.Equal(t, uint64(2), count)
+	assert.InDelta(t, 1+2.5, sum, 1e-9)
+	count, sum = bulletprooftxmanager.GasBumpsUntilInclusion(chainID)
+	assert.Equal(t, uint64(2), count)
+	assert.InDelta(t, 0+2, sum, 1e-9)
+}
+
+func TestEthConfirmer_CheckForReceipts_concurrentBatches(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewGormDB(t)
+	ethKeyStore := cltest.NewKeyStore(t, db).Eth()
+
+	key, fromAddress := cltest.MustAddRandomKeyToKeystore(t, ethKeyStore, 0)
+
+	ethClient := cltest.NewEthClientMock(t)
+
+	config := cltest.NewTestEVMConfig(t)
+	config.Overrides.EvmRPCDefaultBatchSize = null.IntFrom(1)
+	config.Overrides.EvmConfirmerConcurrency = null.IntFrom(3)
+
+	ec := cltest.NewEthConfirmer(t, db, ethClient, config, ethKeyStore, []ethkey.Key{key})
+
+	ctx := context.Background()
+
+	etx := cltest.MustInsertUnconfirmedEthTx(t, db, 0, fromAddress)
+	for i := 0; i < 3; i++ {
+		attempt := newBroadcastEthTxAttempt(t, etx.ID, int64(i+2))
+		require.NoError(t, db.Create(&attempt).Error)
+	}
+
+	ethClient.On("NonceAt", mock.Anything, mock.Anything, mock.Anything).Return(uint64(10), nil)
+
+	// Each batch waits for the others to arrive, which only completes if all
+	// three are in flight at the same time
+	var inFlight sync.WaitGroup
+	inFlight.Add(3)
+	allInFlight := make(chan struct{})
+	go func() {
+		inFlight.Wait()
+		close(allInFlight)
+	}()
+	ethClient.On("BatchCallContext", mock.Anything, mock.MatchedBy(func(b []rpc.BatchElem) bool {
+		return len(b) == 1
+	})).Return(nil).Run(func(args mock.Arguments) {
+		inFlight.Done()
+		select {
+		case <-allInFlight:
+		case <-time.After(5 * time.Second):
+			t.Error("timed out waiting for concurrent batches")
+		}
+		elems := args.Get(1).([]rpc.BatchElem)
+		elems[0].Result = &bulletprooftxmanager.Receipt{}
+	}).Times(3)
+
+	require.NoError(t, ec.CheckForReceipts(ctx, 42))
+	ethClient.AssertExpectations(t)
+}
+
+func TestEthConfirmer_ReceiptFetchWindow(t *testing.T) {
+	t.Parallel()
+
+	// Catching up after downtime: one attempt broadcast on each of blocks
+	// 10-29, and one not yet marked with a broadcast block
+	var attempts []bulletprooftxmanager.EthTxAttempt
+	for i := int64(10); i < 30; i++ {
+		attempt := newBroadcastEthTxAttempt(t, i)
+		n := i
+		attempt.BroadcastBeforeBlockNum = &n
+		attempts = append(attempts, attempt)
+	}
+	attempts = append(attempts, newBroadcastEthTxAttempt(t, 30))
+
+	broadcastBlocks := func(window []bulletprooftxmanager.EthTxAttempt) (blocks []int64) {
+		for _, a := range window {
+			if a.BroadcastBeforeBlockNum == nil {
+				blocks = append(blocks, -1)
+			} else {
+				blocks = append(blocks, *a.BroadcastBeforeBlockNum)
+			}
+		}
+		return
+	}
+
+	// Each head covers the next 5 blocks' worth of attempts
+	var from int64
+	var window []bulletprooftxmanager.EthTxAttempt
+	for _, start := range []int64{10, 15, 20, 25} {
+		window, from = bulletprooftxmanager.ReceiptFetchWindow(attempts, 30, from, 5)
+		assert.Equal(t, []int64{start, start + 1, start + 2, start + 3, start + 4}, broadcastBlocks(window))
+		assert.Equal(t, start+5, from)
+	}
+	// The unmarked attempt counts as broadcast at the current head
+	window, from = bulletprooftxmanager.ReceiptFetchWindow(attempts, 30, from, 5)
+	assert.Equal(t, []int64{-1}, broadcastBlocks(window))
+	assert.Equal(t, int64(35), from)
+	// Then it starts again from the oldest
+	window, from = bulletprooftxmanager.ReceiptFetchWindow(attempts, 31, from, 5)
+	assert.Equal(t, []int64{10, 11, 12, 13, 14}, broadcastBlocks(window))
+	assert.Equal(t, int64(15), from)
+
+	// Gaps between broadcast blocks are skipped rather than using up a head
+	sparse := []bulletprooftxmanager.EthTxAttempt{attempts[0], attempts[1], attempts[19]}
+	window, from = bulletprooftxmanager.ReceiptFetchWindow(sparse, 100, 12, 2)
+	assert.Equal(t, []int64{29}, broadcastBlocks(window))
+	assert.Equal(t, int64(31), from)
+}
+
+func TestEthConfirmer_CheckForReceipts_maxBlocks(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewGormDB(t)
+	ethKeyStore := cltest.NewKeyStore(t, db).Eth()
+
+	key, fromAddress := cltest.MustAddRandomKeyToKeystore(t, ethKeyStore, 0)
+
+	ethClient := cltest.NewEthClientMock(t)
+
+	config := cltest.NewTestEVMConfig(t)
+	config.Overrides.EvmReceiptFetchMaxBlocks = null.IntFrom(2)
+
+	ec := cltest.NewEthConfirmer(t, db, ethClient, config, ethKeyStore, []ethkey.Key{key})
+
+	ctx := context.Background()
+
+	// Three transactions broadcast on blocks 40, 41 and 42
+	var attempts []bulletprooftxmanager.EthTxAttempt
+	for i := int64(0); i < 3; i++ {
+		etx := cltest.MustInsertUnconfirmedEthTx(t, db, i, fromAddress)
+		attempt := newBroadcastEthTxAttempt(t, etx.ID)
+		n := 40 + i
+		attempt.BroadcastBeforeBlockNum = &n
+		require.NoError(t, db.Create(&attempt).Error)
+		attempts = append(attempts, attempt)
+	}
+
+	ethClient.On("NonceAt", mock.Anything, mock.Anything, mock.Anything).Return(uint64(10), nil)
+
+	// The first head only fetches receipts for blocks 40 and 41
+	ethClient.On("BatchCallContext", mock.Anything, mock.MatchedBy(func(b []rpc.BatchElem) bool {
+		return len(b) == 2 &&
+			cltest.BatchElemMatchesHash(b[0], attempts[0].Hash) &&
+			cltest.BatchElemMatchesHash(b[1], attempts[1].Hash)
+	})).Return(nil).Run(func(args mock.Arguments) {
+		elems := args.Get(1).([]rpc.BatchElem)
+		elems[0].Result = &bulletprooftxmanager.Receipt{}
+		elems[1].Result = &bulletprooftxmanager.Receipt{}
+	}).Once()
+
+	require.NoError(t, ec.CheckForReceipts(ctx, 43))
+	ethClient.AssertExpectations(t)
+
+	// The next head picks up block 42
+	ethClient.On("BatchCallContext", mock.Anything, mock.MatchedBy(func(b []rpc.BatchElem) bool {
+		return len(b) == 1 &&
+			cltest.BatchElemMatchesHash(b[0], attempts[2].Hash)
+	})).Return(nil).Run(func(args mock.Arguments) {
+		elems := args.Get(1).([]rpc.BatchElem)
+		elems[0].Result = &bulletprooftxmanager.Receipt{}
+	}).Once()
+
+	require.NoError(t, ec.CheckForReceipts(ctx, 44))
+	ethClient.AssertExpectations(t)
+}
+
 func TestEthConfirmer_CheckForReceipts_only_likely_confirmed(t *testing.T) {
 	t.Parallel()
 
@@ -2235,3 +2473,67 @@ func TestEthConfirmer_ForceRebroadcast(t *testing.T) {
 		ethClient.AssertExpectations(t)
 	})
 }
+
+func TestEthConfirmer_CheckForNonceGaps(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+	db := store.DB
+	ethKeyStore := cltest.NewKeyStore(t, store.DB).Eth()
+	key, fromAddress := cltest.MustAddRandomKeyToKeystore(t, ethKeyStore, 0)
+	_, otherAddress := cltest.MustAddRandomKeyToKeystore(t, ethKeyStore, 0)
+	ethKeyStore.Unlock(cltest.Password)
+	ethClient := cltest.NewEthClientMock(t)
+
+	// Nonces 3 to 6 are missing for fromAddress, e.g. after a re-org deeper
+	// than finality depth; otherAddress has no gap
+	cltest.MustInsertConfirmedEthTxWithAttempt(t, db, 1, 1, fromAddress)
+	cltest.MustInsertConfirmedEthTxWithAttempt(t, db, 2, 1, fromAddress)
+	cltest.MustInsertUnconfirmedEthTxWithBroadcastAttempt(t, db, 7, fromAddress)
+	cltest.MustInsertUnconfirmedEthTxWithBroadcastAttempt(t, db, 8, fromAddress)
+	cltest.MustInsertConfirmedEthTxWithAttempt(t, db, 0, 1, otherAddress)
+	cltest.MustInsertUnconfirmedEthTxWithBroadcastAttempt(t, db, 1, otherAddress)
+
+	gaps, err := bulletprooftxmanager.FindNonceGaps(db, []gethCommon.Address{fromAddress, otherAddress})
+	require.NoError(t, err)
+	require.Len(t, gaps, 2)
+	byAddress := map[gethCommon.Address]bulletprooftxmanager.NonceGap{gaps[0].FromAddress: gaps[0], gaps[1].FromAddress: gaps[1]}
+	assert.Equal(t, int64(4), byAddress[fromAddress].Size())
+	assert.Equal(t, int64(0), byAddress[otherAddress].Size())
+
+	newConfirmer := func(maxGap int64, action string) (*bulletprooftxmanager.EthConfirmer, *[]error) {
+		config := cltest.NewTestEVMConfig(t)
+		config.Overrides.EvmMaxNonceGap = null.IntFrom(maxGap)
+		config.Overrides.EvmNonceGapAction = null.StringFrom(action)
+		ec := cltest.NewEthConfirmer(t, db, ethClient, config, ethKeyStore, []ethkey.Key{key})
+		var halted []error
+		bulletprooftxmanager.SetHaltBroadcastingOnEthConfirmer(func(reason error) { halted = append(halted, reason) }, ec)
+		return ec, &halted
+	}
+
+	t.Run("disabled", func(t *testing.T) {
+		ec, halted := newConfirmer(0, config.NonceGapActionHalt)
+		require.NoError(t, ec.CheckForNonceGaps())
+		assert.Empty(t, *halted)
+	})
+
+	t.Run("gap within the limit", func(t *testing.T) {
+		ec, halted := newConfirmer(4, config.NonceGapActionHalt)
+		require.NoError(t, ec.CheckForNonceGaps())
+		assert.Empty(t, *halted)
+	})
+
+	t.Run("gap above the limit only alerts", func(t *testing.T) {
+		ec, halted := newConfirmer(3, config.NonceGapActionAlert)
+		require.NoError(t, ec.CheckForNonceGaps())
+		assert.Empty(t, *halted)
+	})
+
+	t.Run("gap above the limit halts", func(t *testing.T) {
+		ec, halted := newConfirmer(3, config.NonceGapActionHalt)
+		require.NoError(t, ec.CheckForNonceGaps())
+		require.Len(t, *halted, 1)
+		assert.Contains(t, (*halted)[0].Error(), "nonce gap of 4")
+	})
+}
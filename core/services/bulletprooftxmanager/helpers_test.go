@@ -1,9 +1,65 @@
 package bulletprooftxmanager
 
 import (
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
 	"github.com/smartcontractkit/chainlink/core/services/eth"
+	"github.com/smartcontractkit/chainlink/core/services/gas"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
 )
 
 func SetEthClientOnEthConfirmer(ethClient eth.Client, ethConfirmer *EthConfirmer) {
 	ethConfirmer.ethClient = ethClient
 }
+
+func SetHaltBroadcastingOnEthConfirmer(fn func(reason error), ethConfirmer *EthConfirmer) {
+	ethConfirmer.haltBroadcasting = fn
+}
+
+func SetGasEstimator(b *BulletproofTxManager, estimator gas.Estimator) {
+	b.gasEstimator = estimator
+}
+
+// NewEthBroadcasterFromTxManager returns an EthBroadcaster as the
+// BulletproofTxManager creates it on start and when keys change
+func NewEthBroadcasterFromTxManager(b *BulletproofTxManager, keys []ethkey.Key) *EthBroadcaster {
+	return b.newEthBroadcaster(keys)
+}
+
+func ReceiptFetchWindow(attempts []EthTxAttempt, blockNum, from int64, maxBlocks uint64) ([]EthTxAttempt, int64) {
+	return receiptFetchWindow(attempts, blockNum, from, maxBlocks)
+}
+
+func ObserveInclusion(chainID string, attempts []EthTxAttempt, included int) {
+	observeInclusion(chainID, attempts, included)
+}
+
+// GasPriceInclusionRatio returns the sample count and sum of
+// tx_manager_gas_price_inclusion_ratio for the given chain
+func GasPriceInclusionRatio(chainID string) (uint64, float64) {
+	return histogramSamples(promGasPriceInclusionRatio.WithLabelValues(chainID))
+}
+
+// GasBumpsUntilInclusion returns the sample count and sum of
+// tx_manager_gas_bumps_until_inclusion for the given chain
+func GasBumpsUntilInclusion(chainID string) (uint64, float64) {
+	return histogramSamples(promGasBumpsUntilInclusion.WithLabelValues(chainID))
+}
+
+// InsufficientFundsCount returns tx_manager_num_insufficient_funds for the
+// given chain
+func InsufficientFundsCount(chainID string) float64 {
+	var m dto.Metric
+	if err := promInsufficientFundsCount.WithLabelValues(chainID).Write(&m); err != nil {
+		panic(err)
+	}
+	return m.GetCounter().GetValue()
+}
+
+func histogramSamples(o prometheus.Observer) (uint64, float64) {
+	var m dto.Metric
+	if err := o.(prometheus.Histogram).Write(&m); err != nil {
+		panic(err)
+	}
+	return m.GetHistogram().GetSampleCount(), m.GetHistogram().GetSampleSum()
+}
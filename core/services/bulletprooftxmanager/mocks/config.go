@@ -129,6 +129,20 @@ func (_m *Config) EvmFinalityDepth() uint {
 	return r0
 }
 
+// EvmForceTxType provides a mock function with given fields:
+func (_m *Config) EvmForceTxType() int {
+	ret := _m.Called()
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func() int); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	return r0
+}
+
 // EvmGasBumpPercent provides a mock function with given fields:
 func (_m *Config) EvmGasBumpPercent() uint16 {
 	ret := _m.Called()
@@ -143,6 +157,20 @@ func (_m *Config) EvmGasBumpPercent() uint16 {
 	return r0
 }
 
+// EvmGasBumpStrategy provides a mock function with given fields:
+func (_m *Config) EvmGasBumpStrategy() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
 // EvmGasBumpThreshold provides a mock function with given fields:
 func (_m *Config) EvmGasBumpThreshold() uint64 {
 	ret := _m.Called()
@@ -187,6 +215,20 @@ func (_m *Config) EvmGasBumpWei() *big.Int {
 	return r0
 }
 
+// EvmGasEstimatorRequireWarmup provides a mock function with given fields:
+func (_m *Config) EvmGasEstimatorRequireWarmup() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
 // EvmGasLimitDefault provides a mock function with given fields:
 func (_m *Config) EvmGasLimitDefault() uint64 {
 	ret := _m.Called()
@@ -215,6 +257,20 @@ func (_m *Config) EvmGasLimitMultiplier() float32 {
 	return r0
 }
 
+// EvmGasLimitTransfer provides a mock function with given fields:
+func (_m *Config) EvmGasLimitTransfer() uint64 {
+	ret := _m.Called()
+
+	var r0 uint64
+	if rf, ok := ret.Get(0).(func() uint64); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	return r0
+}
+
 // EvmGasPriceDefault provides a mock function with given fields:
 func (_m *Config) EvmGasPriceDefault() *big.Int {
 	ret := _m.Called()
@@ -231,6 +287,20 @@ func (_m *Config) EvmGasPriceDefault() *big.Int {
 	return r0
 }
 
+// EvmInsufficientFundsAction provides a mock function with given fields:
+func (_m *Config) EvmInsufficientFundsAction() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
 // EvmMaxGasPriceWei provides a mock function with given fields:
 func (_m *Config) EvmMaxGasPriceWei() *big.Int {
 	ret := _m.Called()
@@ -261,6 +331,20 @@ func (_m *Config) EvmMaxInFlightTransactions() uint32 {
 	return r0
 }
 
+// EvmMaxNonceGap provides a mock function with given fields:
+func (_m *Config) EvmMaxNonceGap() uint64 {
+	ret := _m.Called()
+
+	var r0 uint64
+	if rf, ok := ret.Get(0).(func() uint64); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	return r0
+}
+
 // EvmMaxQueuedTransactions provides a mock function with given fields:
 func (_m *Config) EvmMaxQueuedTransactions() uint64 {
 	ret := _m.Called()
@@ -305,6 +389,120 @@ func (_m *Config) EvmNonceAutoSync() bool {
 	return r0
 }
 
+// EvmCallTimeout provides a mock function with given fields:
+func (_m *Config) EvmCallTimeout() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// EvmConfirmerConcurrency provides a mock function with given fields:
+func (_m *Config) EvmConfirmerConcurrency() uint32 {
+	ret := _m.Called()
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	return r0
+}
+
+// SignerChainID provides a mock function with given fields:
+func (_m *Config) SignerChainID() *big.Int {
+	ret := _m.Called()
+
+	var r0 *big.Int
+	if rf, ok := ret.Get(0).(func() *big.Int); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*big.Int)
+		}
+	}
+
+	return r0
+}
+
+// EvmSimulateTransactionsBeforeSend provides a mock function with given fields:
+func (_m *Config) EvmSimulateTransactionsBeforeSend() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// EvmSkipEstimationForSimpleTransfers provides a mock function with given fields:
+func (_m *Config) EvmSkipEstimationForSimpleTransfers() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// EvmNonceGapAction provides a mock function with given fields:
+func (_m *Config) EvmNonceGapAction() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// EvmReceiptFetchDepth provides a mock function with given fields:
+func (_m *Config) EvmReceiptFetchDepth() uint {
+	ret := _m.Called()
+
+	var r0 uint
+	if rf, ok := ret.Get(0).(func() uint); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint)
+	}
+
+	return r0
+}
+
+// EvmReceiptFetchMaxBlocks provides a mock function with given fields:
+func (_m *Config) EvmReceiptFetchMaxBlocks() uint64 {
+	ret := _m.Called()
+
+	var r0 uint64
+	if rf, ok := ret.Get(0).(func() uint64); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	return r0
+}
+
 // EvmRPCDefaultBatchSize provides a mock function with given fields:
 func (_m *Config) EvmRPCDefaultBatchSize() uint32 {
 	ret := _m.Called()
@@ -347,6 +545,20 @@ func (_m *Config) GasEstimatorMode() string {
 	return r0
 }
 
+// GlobalMaxInFlightTransactions provides a mock function with given fields:
+func (_m *Config) GlobalMaxInFlightTransactions() uint32 {
+	ret := _m.Called()
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	return r0
+}
+
 // TriggerFallbackDBPollInterval provides a mock function with given fields:
 func (_m *Config) TriggerFallbackDBPollInterval() time.Duration {
 	ret := _m.Called()
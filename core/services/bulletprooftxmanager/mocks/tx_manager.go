@@ -57,6 +57,25 @@ func (_m *TxManager) CreateEthTransaction(db *gorm.DB, fromAddress common.Addres
 	return r0, r1
 }
 
+// Drain provides a mock function with given fields:
+func (_m *TxManager) Drain() {
+	_m.Called()
+}
+
+// Draining provides a mock function with given fields:
+func (_m *TxManager) Draining() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
 // GetGasEstimator provides a mock function with given fields:
 func (_m *TxManager) GetGasEstimator() gas.Estimator {
 	ret := _m.Called()
@@ -124,3 +143,8 @@ func (_m *TxManager) Start() error {
 func (_m *TxManager) Trigger(addr common.Address) {
 	_m.Called(addr)
 }
+
+// Undrain provides a mock function with given fields:
+func (_m *TxManager) Undrain() {
+	_m.Called()
+}
@@ -11,12 +11,14 @@ import (
 	"reflect"
 	"sync"
 	"syscall"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/gobuffalo/packr"
 	"github.com/pkg/errors"
 	uuid "github.com/satori/go.uuid"
+	"github.com/smartcontractkit/chainlink/core/chains"
 	"github.com/smartcontractkit/chainlink/core/gracefulpanic"
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/service"
@@ -52,6 +54,10 @@ import (
 	"gorm.io/gorm"
 )
 
+// ErrReplayBeyondHead is returned when a replay is requested from a block
+// the node has not seen yet
+var ErrReplayBeyondHead = errors.New("block is beyond the latest head")
+
 //go:generate mockery --name Application --output ../../internal/mocks/ --case=underscore
 
 // Application implements the common functions used in the core node.
@@ -66,6 +72,9 @@ type Application interface {
 	// TODO: Remove this after multichain
 	// See: https://app.clubhouse.io/chainlinklabs/story/12739/generalise-necessary-models-tables-on-the-send-side-to-support-the-concept-of-multiple-chains
 	GetEVMConfig() config.EVMConfig
+	GetEVMConfigOrDefault(chainID *big.Int) (config.EVMConfig, error)
+	DefaultChainID() *big.Int
+	ReloadChainConfig(chainID *big.Int) error
 	GetKeyStore() *keystore.Master
 	GetHeadBroadcaster() httypes.HeadBroadcasterRegistry
 	WakeSessionReaper()
@@ -89,7 +98,14 @@ type Application interface {
 	GetFeedsService() feeds.Service
 
 	// ReplayFromBlock of blocks
-	ReplayFromBlock(number uint64) error
+	ReplayFromBlock(ctx context.Context, chainID *big.Int, number uint64) error
+	// SetTxManagerDraining drains or undrains a chain's transaction manager
+	SetTxManagerDraining(chainID *big.Int, draining bool) error
+	// PinNode, UnpinNode and PinnedNode route a chain's traffic to a single
+	// node, for debugging a provider
+	PinNode(chainID *big.Int, nodeID int32) error
+	UnpinNode(chainID *big.Int) error
+	PinnedNode(chainID *big.Int) (name string, pinned bool, err error)
 }
 
 // ChainlinkApplication contains fields for the JobSubscriber, Scheduler,
@@ -128,6 +144,10 @@ type ChainlinkApplication struct {
 	startStopMu sync.Mutex
 }
 
+// chainHealthCheckInterval is how often chain health is checked for
+// publishing ChainEvents
+const chainHealthCheckInterval = 15 * time.Second
+
 // NewApplication initializes a new store if one is not already
 // present at the configured root directory (default: ~/.chainlink),
 // the logger at the same directory and returns the Application to
@@ -144,6 +164,24 @@ func NewApplication(cfg config.EVMConfig, ethClient eth.Client, advisoryLocker p
 	gormTxm := postgres.NewGormTransactionManager(store.DB)
 
 	setupConfig(cfg, store.DB)
+	logChainSummary(summarizeChain(cfg))
+	if !cfg.EvmChainEnabled() {
+		// The client was created before evm_chains was loaded
+		logger.Infow("Chain is disabled in evm_chains; none of its services will be started", "evmChainID", cfg.ChainID())
+		ethClient = &eth.NullClient{}
+	}
+
+	if nc, ok := ethClient.(eth.NodeConfigurer); ok {
+		nodes, err := eth.NewORM(store.DB).NodeConfigs(cfg.ChainID())
+		if err != nil {
+			return nil, err
+		}
+		if err = nc.ApplyNodeConfigs(nodes); err != nil {
+			return nil, err
+		}
+		nc.SetNodeRateLimit(cfg.NodeRateLimit())
+		nc.SetNodeCircuitBreaker(cfg.NodeCircuitBreakerThreshold(), cfg.NodeCircuitBreakerCooldown())
+	}
 
 	healthChecker := health.NewChecker()
 
@@ -194,13 +232,25 @@ func NewApplication(cfg config.EVMConfig, ethClient eth.Client, advisoryLocker p
 		headTracker = &headtracker.NullTracker{}
 	} else {
 		headBroadcaster = headtracker.NewHeadBroadcaster()
-		orm := headtracker.NewORM(store.DB)
-		headTracker = headtracker.NewHeadTracker(headTrackerLogger, ethClient, cfg, orm, headBroadcaster)
+		if cfg.EvmServiceDisabled(config.EvmServiceHeadTracker) {
+			logger.Infow("HeadTracker is disabled for this chain", "evmChainID", cfg.ChainID())
+			headTracker = &headtracker.NullTracker{}
+		} else {
+			orm := headtracker.NewORM(store.DB)
+			headTracker = headtracker.NewHeadTracker(headTrackerLogger, ethClient, cfg, orm, headBroadcaster)
+		}
 	}
 
 	eventBroadcaster := postgres.NewEventBroadcaster(cfg.DatabaseURL(), cfg.DatabaseListenerMinReconnectInterval(), cfg.DatabaseListenerMaxReconnectDuration())
 	subservices = append(subservices, eventBroadcaster)
 
+	if !cfg.EthereumDisabled() {
+		subservices = append(subservices, newChainHealthMonitor(cfg.ChainID(), headTracker, eventBroadcaster, chainHealthCheckInterval))
+		if interval := cfg.ConfigRevalidationInterval(); interval > 0 {
+			subservices = append(subservices, newConfigRevalidator(cfg, interval))
+		}
+	}
+
 	var txManager bulletprooftxmanager.TxManager
 	var logBroadcaster log.Broadcaster
 	if cfg.EthereumDisabled() {
@@ -213,14 +263,29 @@ func NewApplication(cfg config.EVMConfig, ethClient eth.Client, advisoryLocker p
 			return nil, err2
 		}
 
-		logBroadcaster = log.NewBroadcaster(log.NewORM(store.DB), ethClient, cfg, highestSeenHead)
-		txManager = bulletprooftxmanager.NewBulletproofTxManager(store.DB, ethClient, cfg, keyStore.Eth(), advisoryLocker, eventBroadcaster)
-		subservices = append(subservices, logBroadcaster, txManager)
+		if cfg.EvmServiceDisabled(config.EvmServiceLogPoller) {
+			logger.Infow("LogBroadcaster is disabled for this chain", "evmChainID", cfg.ChainID())
+			logBroadcaster = &log.NullBroadcaster{ErrMsg: "LogBroadcaster is not running because it is disabled for this chain"}
+		} else {
+			logBroadcaster = log.NewBroadcaster(log.NewORM(store.DB), ethClient, cfg, highestSeenHead)
+			subservices = append(subservices, logBroadcaster)
+		}
+		if cfg.EvmServiceDisabled(config.EvmServiceTxBroadcaster) {
+			logger.Infow("TxManager is disabled for this chain", "evmChainID", cfg.ChainID())
+			txManager = &bulletprooftxmanager.NullTxManager{ErrMsg: "TxManager is not running because it is disabled for this chain"}
+		} else {
+			bptxm := bulletprooftxmanager.NewBulletproofTxManager(store.DB, ethClient, cfg, keyStore.Eth(), advisoryLocker, eventBroadcaster)
+			if ht, ok := headTracker.(*headtracker.HeadTracker); ok {
+				ht.SetFinalityViolationHandler(bptxm)
+			}
+			txManager = bptxm
+			subservices = append(subservices, txManager)
+		}
 	}
 
 	var balanceMonitor services.BalanceMonitor
 	if cfg.BalanceMonitorEnabled() {
-		balanceMonitor = services.NewBalanceMonitor(store.DB, ethClient, keyStore.Eth())
+		balanceMonitor = services.NewBalanceMonitor(store.DB, ethClient, keyStore.Eth(), cfg)
 	} else {
 		balanceMonitor = &services.NullBalanceMonitor{}
 	}
@@ -406,9 +471,95 @@ func (app *ChainlinkApplication) SetServiceLogger(ctx context.Context, serviceNa
 	return app.logger.Orm.SetServiceLogLevel(ctx, serviceName, level)
 }
 
-func setupConfig(cfg config.GeneralConfig, db *gorm.DB) {
+func setupConfig(cfg config.EVMConfig, db *gorm.DB) {
 	cfg.SetDB(db)
+	if db == nil {
+		logger.Infow("No database for runtime config; only env and chain defaults will be used, and runtime config changes will be lost on restart", "evmChainID", cfg.ChainID())
+		return
+	}
 
+	if err := cfg.ReloadPersistedConfig(); err != nil {
+		logger.Warnw("Could not load chain config from evm_chains; only env and chain defaults will be used until it is reloaded", "evmChainID", cfg.ChainID(), "error", err)
+	}
+	if err := cfg.ValidatePersisted(); err != nil {
+		logger.Errorw("Invalid runtime config values found in the database; these will be ignored in favour of env or chain defaults until corrected", "error", err)
+	}
+	for _, conflict := range cfg.ConfigOverrideConflicts() {
+		logger.Warnw(fmt.Sprintf("%s is set in the environment but is overridden by a different persisted value; the persisted value will be used", conflict.EnvVar),
+			"evmChainID", cfg.ChainID(), "field", conflict.Field, "envValue", conflict.EnvValue, "persistedValue", conflict.PersistedValue)
+	}
+	if err := checkOCRChainHasPrimaryNode(db, cfg.ChainID()); err != nil {
+		logger.Errorw("OCR jobs on this chain will stall", "evmChainID", cfg.ChainID(), "error", err)
+	}
+}
+
+// chainSummary describes a chain as loaded at startup
+type chainSummary struct {
+	ChainID          string
+	Enabled          bool
+	Default          bool
+	PrimaryNodes     int
+	SendOnlyNodes    int
+	GasEstimatorMode string
+	FallbackDefaults bool
+}
+
+// summarizeChain returns the startup summary for the chain configured in
+// cfg. This node runs a single chain, which is therefore always the default.
+func summarizeChain(cfg config.EVMConfig) chainSummary {
+	_, fallback := chains.DefaultsForChainID(cfg.ChainID())
+	s := chainSummary{
+		ChainID:          cfg.ChainID().String(),
+		Enabled:          !cfg.EthereumDisabled(),
+		Default:          true,
+		GasEstimatorMode: cfg.GasEstimatorMode(),
+		FallbackDefaults: fallback,
+	}
+	if s.Enabled {
+		if cfg.EthereumURL() != "" {
+			s.PrimaryNodes = 1
+		}
+		s.SendOnlyNodes = len(cfg.EthereumSecondaryURLs())
+	}
+	return s
+}
+
+func logChainSummary(s chainSummary) {
+	msg := fmt.Sprintf("Loaded chain %s with %d primary and %d send-only node(s), using %s gas estimator", s.ChainID, s.PrimaryNodes, s.SendOnlyNodes, s.GasEstimatorMode)
+	if !s.Enabled {
+		msg = fmt.Sprintf("Loaded chain %s, which is disabled", s.ChainID)
+	}
+	logger.Infow(msg,
+		"evmChainID", s.ChainID,
+		"enabled", s.Enabled,
+		"default", s.Default,
+		"primaryNodes", s.PrimaryNodes,
+		"sendOnlyNodes", s.SendOnlyNodes,
+		"gasEstimatorMode", s.GasEstimatorMode,
+		"fallbackDefaults", s.FallbackDefaults,
+	)
+}
+
+// checkOCRChainHasPrimaryNode returns an error if the chain hosts OCR jobs
+// but its nodes in the nodes table are all send-only. OCR needs
+// subscription-based head tracking, which send-only nodes cannot provide.
+// Chains without OCR jobs, or without any nodes in the table, are exempt.
+func checkOCRChainHasPrimaryNode(db *gorm.DB, chainID *big.Int) error {
+	var ocrJobs int64
+	if err := db.Raw(`SELECT count(*) FROM jobs WHERE offchainreporting_oracle_spec_id IS NOT NULL`).Row().Scan(&ocrJobs); err != nil {
+		return errors.Wrap(err, "failed to count OCR jobs")
+	}
+	if ocrJobs == 0 {
+		return nil
+	}
+	var nodes, primaries int64
+	if err := db.Raw(`SELECT count(*), count(*) FILTER (WHERE NOT send_only) FROM nodes WHERE evm_chain_id = ?`, utils.NewBig(chainID)).Row().Scan(&nodes, &primaries); err != nil {
+		return errors.Wrap(err, "failed to count nodes")
+	}
+	if nodes > 0 && primaries == 0 {
+		return errors.Errorf("chain %s hosts %d OCR job(s) but only has send-only nodes; OCR requires a primary node for head tracking", chainID, ocrJobs)
+	}
+	return nil
 }
 
 // Start all necessary services. If successful, nil will be returned.  Also
@@ -437,6 +588,12 @@ func (app *ChainlinkApplication) Start() error {
 		return err
 	}
 
+	if !app.Config.EthereumDisabled() && app.EVMConfig.EvmGasPriceDefaultSeedFromNetwork() {
+		if err := app.EVMConfig.SeedEvmGasPriceDefault(context.Background(), app.ethClient); err != nil {
+			logger.Warnw("Failed to seed EvmGasPriceDefault from network, using configured default", "error", err)
+		}
+	}
+
 	if err := app.Store.Start(); err != nil {
 		return err
 	}
@@ -523,6 +680,10 @@ func (app *ChainlinkApplication) stop() error {
 			merr = multierr.Append(merr, service.Close())
 		}
 
+		// Persists a default gas price whose write was deferred, so it
+		// must come before the store is closed
+		logger.Debug("Closing EVM config...")
+		merr = multierr.Append(merr, app.EVMConfig.Close())
 		logger.Debug("Stopping SessionReaper...")
 		merr = multierr.Append(merr, app.SessionReaper.Stop())
 		logger.Debug("Closing Store...")
@@ -556,6 +717,43 @@ func (app *ChainlinkApplication) GetEVMConfig() config.EVMConfig {
 	return app.EVMConfig
 }
 
+// GetEVMConfigOrDefault returns the config for the given chain if this node
+// is running it, and otherwise the config of the default chain. This suits
+// callers such as job specs that reference a valid chain the node is not
+// running. It only returns an error if Ethereum is disabled, since then there
+// is no default chain either.
+// TODO: Look the chain up in the chain set after multichain
+// See: https://app.clubhouse.io/chainlinklabs/story/12739/generalise-necessary-models-tables-on-the-send-side-to-support-the-concept-of-multiple-chains
+func (app *ChainlinkApplication) GetEVMConfigOrDefault(chainID *big.Int) (config.EVMConfig, error) {
+	if app.EVMConfig.EthereumDisabled() {
+		return nil, errors.Errorf("cannot get config for chain %s: Ethereum is disabled so there is no default chain", chainID)
+	}
+	if chainID != nil && chainID.Cmp(app.EVMConfig.ChainID()) != 0 {
+		logger.Debugw(fmt.Sprintf("Chain %s is not running on this node, falling back to default chain %s", chainID, app.EVMConfig.ChainID()), "evmChainID", chainID.String())
+	}
+	return app.EVMConfig, nil
+}
+
+// DefaultChainID returns a copy of the ID of the default chain, or nil if
+// Ethereum is disabled. Unlike GetEVMConfigOrDefault it is meant for callers
+// such as logging that only need to know which chain is the default.
+func (app *ChainlinkApplication) DefaultChainID() *big.Int {
+	if app.EVMConfig.EthereumDisabled() {
+		return nil
+	}
+	return new(big.Int).Set(app.EVMConfig.ChainID())
+}
+
+// ReloadChainConfig re-reads the persisted config for a single chain without
+// restarting any of its services. It returns config.ErrChainNotFound if the
+// chain is not running on this node.
+func (app *ChainlinkApplication) ReloadChainConfig(chainID *big.Int) error {
+	if app.EVMConfig.EthereumDisabled() || chainID == nil || chainID.Cmp(app.EVMConfig.ChainID()) != 0 {
+		return errors.Wrapf(config.ErrChainNotFound, "chain %s is not running on this node", chainID)
+	}
+	return app.EVMConfig.ReloadPersistedConfig()
+}
+
 func (app *ChainlinkApplication) GetKeyStore() *keystore.Master {
 	return app.KeyStore
 }
@@ -691,7 +889,88 @@ func (app *ChainlinkApplication) NewBox() packr.Box {
 	return packr.NewBox("../../../operator_ui/dist")
 }
 
-func (app *ChainlinkApplication) ReplayFromBlock(number uint64) error {
+// ReplayFromBlock makes the log broadcaster re-scan logs for the given chain,
+// or the default chain if chainID is nil, from number onwards. Missed logs are
+// backfilled in batches of EvmLogBackfillBatchSize. It returns
+// config.ErrChainNotFound if the chain is not running on this node, and
+// ErrReplayBeyondHead if number is past the latest head.
+func (app *ChainlinkApplication) ReplayFromBlock(ctx context.Context, chainID *big.Int, number uint64) error {
+	if app.EVMConfig.EthereumDisabled() || (chainID != nil && chainID.Cmp(app.EVMConfig.ChainID()) != 0) {
+		return errors.Wrapf(config.ErrChainNotFound, "chain %s is not running on this node", chainID)
+	}
+	head, err := app.ethClient.HeadByNumber(ctx, nil)
+	if err != nil {
+		return errors.Wrap(err, "failed to get latest head")
+	}
+	if head == nil {
+		logger.Warnw("Latest head is unknown, cannot check the block number to replay from", "blockNumber", number, "evmChainID", app.EVMConfig.ChainID())
+	} else if head.Number < 0 || number > uint64(head.Number) {
+		return errors.Wrapf(ErrReplayBeyondHead, "cannot replay from block %d, latest head is %d", number, head.Number)
+	}
 	app.LogBroadcaster.ReplayFromBlock(int64(number))
 	return nil
 }
+
+// SetTxManagerDraining drains the transaction manager of the given chain, or
+// the default chain if chainID is nil, so that it rejects new transactions
+// while pending ones confirm, or undrains it. It returns
+// config.ErrChainNotFound if the chain is not running on this node.
+func (app *ChainlinkApplication) SetTxManagerDraining(chainID *big.Int, draining bool) error {
+	if app.EVMConfig.EthereumDisabled() || (chainID != nil && chainID.Cmp(app.EVMConfig.ChainID()) != 0) {
+		return errors.Wrapf(config.ErrChainNotFound, "chain %s is not running on this node", chainID)
+	}
+	if draining {
+		app.TxManager.Drain()
+	} else {
+		app.TxManager.Undrain()
+	}
+	return nil
+}
+
+// ErrNodePinningNotSupported is returned by PinNode, UnpinNode and PinnedNode
+// if the chain's eth client cannot pin nodes
+var ErrNodePinningNotSupported = errors.New("eth client does not support pinning nodes")
+
+// PinNode sends all of the given chain's traffic, or the default chain's if
+// chainID is nil, to the node with the given ID, see eth.NodePinner. Pinning
+// is not persisted. It returns config.ErrChainNotFound if the chain is not
+// running on this node, and eth.ErrNodeNotFound for an unknown node ID.
+func (app *ChainlinkApplication) PinNode(chainID *big.Int, nodeID int32) error {
+	pinner, err := app.nodePinner(chainID)
+	if err != nil {
+		return err
+	}
+	return pinner.PinNode(nodeID)
+}
+
+// UnpinNode undoes PinNode
+func (app *ChainlinkApplication) UnpinNode(chainID *big.Int) error {
+	pinner, err := app.nodePinner(chainID)
+	if err != nil {
+		return err
+	}
+	pinner.UnpinNode()
+	return nil
+}
+
+// PinnedNode returns the name of the node that the given chain's traffic is
+// pinned to, if any
+func (app *ChainlinkApplication) PinnedNode(chainID *big.Int) (string, bool, error) {
+	pinner, err := app.nodePinner(chainID)
+	if err != nil {
+		return "", false, err
+	}
+	name, pinned := pinner.PinnedNode()
+	return name, pinned, nil
+}
+
+func (app *ChainlinkApplication) nodePinner(chainID *big.Int) (eth.NodePinner, error) {
+	if app.EVMConfig.EthereumDisabled() || (chainID != nil && chainID.Cmp(app.EVMConfig.ChainID()) != 0) {
+		return nil, errors.Wrapf(config.ErrChainNotFound, "chain %s is not running on this node", chainID)
+	}
+	pinner, ok := app.ethClient.(eth.NodePinner)
+	if !ok {
+		return nil, ErrNodePinningNotSupported
+	}
+	return pinner, nil
+}
@@ -3,14 +3,23 @@
 package chainlink_test
 
 import (
+	"errors"
+	"math/big"
+	"net/url"
 	"syscall"
 	"testing"
 
+	"github.com/smartcontractkit/chainlink/core/chains"
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/services/eth"
+	"github.com/smartcontractkit/chainlink/core/store/config"
 
 	"github.com/onsi/gomega"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/tevino/abool"
+	"gopkg.in/guregu/null.v4"
 )
 
 func TestChainlinkApplication_SignalShutdown(t *testing.T) {
@@ -32,3 +41,126 @@ func TestChainlinkApplication_SignalShutdown(t *testing.T) {
 		return completed.IsSet()
 	}).Should(gomega.BeTrue())
 }
+
+func TestChainlinkApplication_GetEVMConfigOrDefault(t *testing.T) {
+	t.Parallel()
+
+	cfg := cltest.NewTestEVMConfig(t)
+	app := &chainlink.ChainlinkApplication{EVMConfig: cfg}
+
+	for _, id := range []*big.Int{nil, cfg.ChainID(), big.NewInt(424242)} {
+		got, err := app.GetEVMConfigOrDefault(id)
+		require.NoError(t, err)
+		assert.Same(t, cfg, got)
+	}
+
+	cfg.GeneralConfig.Overrides.EthereumDisabled = null.BoolFrom(true)
+	_, err := app.GetEVMConfigOrDefault(big.NewInt(424242))
+	require.EqualError(t, err, "cannot get config for chain 424242: Ethereum is disabled so there is no default chain")
+}
+
+func TestChainlinkApplication_DefaultChainID(t *testing.T) {
+	t.Parallel()
+
+	cfg := cltest.NewTestEVMConfig(t)
+	app := &chainlink.ChainlinkApplication{EVMConfig: cfg}
+
+	id := app.DefaultChainID()
+	require.Equal(t, cfg.ChainID(), id)
+	assert.NotSame(t, cfg.ChainID(), id)
+
+	cfg.GeneralConfig.Overrides.EthereumDisabled = null.BoolFrom(true)
+	assert.Nil(t, app.DefaultChainID())
+}
+
+func TestChainlinkApplication_ReloadChainConfig_NotFound(t *testing.T) {
+	t.Parallel()
+
+	cfg := cltest.NewTestEVMConfig(t)
+	app := &chainlink.ChainlinkApplication{EVMConfig: cfg}
+
+	err := app.ReloadChainConfig(big.NewInt(424242))
+	require.True(t, errors.Is(err, config.ErrChainNotFound))
+}
+
+func TestChainlinkApplication_PinNode(t *testing.T) {
+	t.Parallel()
+
+	cfg := cltest.NewTestEVMConfig(t)
+	app := &chainlink.ChainlinkApplication{EVMConfig: cfg}
+
+	app.SetEthClient(&eth.NullClient{})
+	require.True(t, errors.Is(app.PinNode(nil, 0), chainlink.ErrNodePinningNotSupported))
+
+	ethClient, err := eth.NewClient("ws://localhost:8546", nil, []url.URL{*cltest.MustParseURL("http://localhost:8545")})
+	require.NoError(t, err)
+	app.SetEthClient(ethClient)
+
+	_, pinned, err := app.PinnedNode(nil)
+	require.NoError(t, err)
+	assert.False(t, pinned)
+
+	require.True(t, errors.Is(app.PinNode(nil, 2), eth.ErrNodeNotFound))
+	require.True(t, errors.Is(app.PinNode(big.NewInt(424242), 1), config.ErrChainNotFound))
+
+	require.NoError(t, app.PinNode(cfg.ChainID(), 1))
+	name, pinned, err := app.PinnedNode(nil)
+	require.NoError(t, err)
+	assert.True(t, pinned)
+	assert.Equal(t, "eth-secondary-0", name)
+
+	require.NoError(t, app.UnpinNode(nil))
+	_, pinned, err = app.PinnedNode(cfg.ChainID())
+	require.NoError(t, err)
+	assert.False(t, pinned)
+}
+
+func TestCheckOCRChainHasPrimaryNode(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+	db := store.DB
+	chainID := big.NewInt(4242)
+
+	require.NoError(t, db.Exec(`INSERT INTO evm_chains (id, created_at, updated_at) VALUES (4242, NOW(), NOW())`).Error)
+	require.NoError(t, db.Exec(`INSERT INTO nodes (name, evm_chain_id, http_url, send_only, created_at, updated_at) VALUES ('send-only', 4242, 'http://example.com', true, NOW(), NOW())`).Error)
+
+	// Chains without OCR jobs are exempt
+	require.NoError(t, chainlink.CheckOCRChainHasPrimaryNode(db, chainID))
+
+	_, addr := cltest.MustAddRandomKeyToKeystore(t, cltest.NewKeyStore(t, db).Eth())
+	cltest.MustInsertV2JobSpec(t, store, addr)
+
+	err := chainlink.CheckOCRChainHasPrimaryNode(db, chainID)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "chain 4242 hosts 1 OCR job(s) but only has send-only nodes")
+
+	// Chains with no nodes in the table are exempt
+	require.NoError(t, chainlink.CheckOCRChainHasPrimaryNode(db, big.NewInt(1337)))
+
+	require.NoError(t, db.Exec(`INSERT INTO nodes (name, evm_chain_id, ws_url, send_only, created_at, updated_at) VALUES ('primary', 4242, 'ws://example.com', false, NOW(), NOW())`).Error)
+	require.NoError(t, chainlink.CheckOCRChainHasPrimaryNode(db, chainID))
+}
+
+func TestSummarizeChain(t *testing.T) {
+	t.Parallel()
+
+	cfg := cltest.NewTestEVMConfig(t)
+	_, fallback := chains.DefaultsForChainID(cfg.ChainID())
+	assert.Equal(t, chainlink.ChainSummary{
+		ChainID:          cfg.ChainID().String(),
+		Enabled:          true,
+		Default:          true,
+		PrimaryNodes:     1,
+		SendOnlyNodes:    len(cfg.EthereumSecondaryURLs()),
+		GasEstimatorMode: cfg.GasEstimatorMode(),
+		FallbackDefaults: fallback,
+	}, chainlink.SummarizeChain(cfg))
+
+	cfg.GeneralConfig.Overrides.EthereumDisabled = null.BoolFrom(true)
+	summary := chainlink.SummarizeChain(cfg)
+	assert.False(t, summary.Enabled)
+	assert.Zero(t, summary.PrimaryNodes)
+	assert.Zero(t, summary.SendOnlyNodes)
+}
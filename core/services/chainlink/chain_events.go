@@ -0,0 +1,127 @@
+package chainlink
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/health"
+	"github.com/smartcontractkit/chainlink/core/services/postgres"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// ChainEventType is the kind of state change published for a chain
+type ChainEventType string
+
+const (
+	ChainEventAdded     ChainEventType = "added"
+	ChainEventRemoved   ChainEventType = "removed"
+	ChainEventEnabled   ChainEventType = "enabled"
+	ChainEventDisabled  ChainEventType = "disabled"
+	ChainEventHealthy   ChainEventType = "healthy"
+	ChainEventUnhealthy ChainEventType = "unhealthy"
+)
+
+// ChainEvent is the JSON payload published on a chain's events channel
+type ChainEvent struct {
+	ChainID *utils.Big     `json:"chainID"`
+	Type    ChainEventType `json:"type"`
+	// Error explains why the chain is unhealthy
+	Error string `json:"error,omitempty"`
+}
+
+// ChainEventsChannel returns the Postgres channel on which events for the
+// given chain are published, so that subscribers only receive events for the
+// chains they care about
+func ChainEventsChannel(chainID *big.Int) string {
+	return fmt.Sprintf("%s_%s", postgres.ChannelChainEvents, chainID.String())
+}
+
+// chainHealthMonitor periodically checks the health of a chain and publishes
+// a ChainEvent whenever it becomes healthy or unhealthy, so that other
+// subsystems can react without polling
+type chainHealthMonitor struct {
+	chainID          *big.Int
+	checkable        health.Checkable
+	eventBroadcaster postgres.EventBroadcaster
+	interval         time.Duration
+
+	healthy bool
+	chStop  chan struct{}
+	chDone  chan struct{}
+
+	utils.StartStopOnce
+}
+
+func newChainHealthMonitor(chainID *big.Int, checkable health.Checkable, eventBroadcaster postgres.EventBroadcaster, interval time.Duration) *chainHealthMonitor {
+	return &chainHealthMonitor{
+		chainID:          chainID,
+		checkable:        checkable,
+		eventBroadcaster: eventBroadcaster,
+		interval:         interval,
+		// Chains are assumed healthy until shown otherwise, so that only
+		// actual transitions are published
+		healthy: true,
+		chStop:  make(chan struct{}),
+		chDone:  make(chan struct{}),
+	}
+}
+
+func (m *chainHealthMonitor) Start() error {
+	return m.StartOnce("ChainHealthMonitor", func() error {
+		go m.run()
+		return nil
+	})
+}
+
+func (m *chainHealthMonitor) Close() error {
+	return m.StopOnce("ChainHealthMonitor", func() error {
+		close(m.chStop)
+		<-m.chDone
+		return nil
+	})
+}
+
+func (m *chainHealthMonitor) run() {
+	defer close(m.chDone)
+
+	ticker := time.NewTicker(m.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			m.check()
+		case <-m.chStop:
+			return
+		}
+	}
+}
+
+func (m *chainHealthMonitor) check() {
+	err := m.checkable.Healthy()
+	if healthy := err == nil; healthy == m.healthy {
+		return
+	}
+	m.healthy = err == nil
+
+	event := ChainEvent{ChainID: utils.NewBig(m.chainID), Type: ChainEventHealthy}
+	if err != nil {
+		event.Type = ChainEventUnhealthy
+		event.Error = err.Error()
+	}
+	m.publish(event)
+}
+
+func (m *chainHealthMonitor) publish(event ChainEvent) {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		logger.Errorw("ChainHealthMonitor: failed to marshal chain event", "evmChainID", m.chainID, "error", err)
+		return
+	}
+	if err := m.eventBroadcaster.Notify(ChainEventsChannel(m.chainID), string(payload)); err != nil {
+		logger.Errorw("ChainHealthMonitor: failed to publish chain event", "evmChainID", m.chainID, "type", event.Type, "error", err)
+	}
+}
@@ -0,0 +1,74 @@
+package chainlink
+
+import (
+	"encoding/json"
+	"math/big"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/smartcontractkit/chainlink/core/services/postgres/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeCheckable struct {
+	mu      sync.Mutex
+	healthy error
+}
+
+func (f *fakeCheckable) Ready() error { return nil }
+
+func (f *fakeCheckable) Healthy() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.healthy
+}
+
+func (f *fakeCheckable) setHealthy(err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.healthy = err
+}
+
+func TestChainEventsChannel(t *testing.T) {
+	assert.Equal(t, "evm_chain_events_42", ChainEventsChannel(big.NewInt(42)))
+}
+
+func TestChainHealthMonitor_PublishesOnTransitionToUnhealthy(t *testing.T) {
+	chainID := big.NewInt(42)
+	checkable := &fakeCheckable{}
+	eb := new(mocks.EventBroadcaster)
+
+	published := make(chan ChainEvent, 1)
+	eb.On("Notify", "evm_chain_events_42", mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		var event ChainEvent
+		require.NoError(t, json.Unmarshal([]byte(args.String(1)), &event))
+		published <- event
+	}).Once()
+
+	m := newChainHealthMonitor(chainID, checkable, eb, 10*time.Millisecond)
+	require.NoError(t, m.Start())
+	defer func() { require.NoError(t, m.Close()) }()
+
+	// Healthy chains do not publish anything
+	time.Sleep(50 * time.Millisecond)
+	eb.AssertNotCalled(t, "Notify", mock.Anything, mock.Anything)
+
+	checkable.setHealthy(errors.New("no new heads"))
+
+	select {
+	case event := <-published:
+		assert.Equal(t, ChainEventUnhealthy, event.Type)
+		assert.Equal(t, chainID, event.ChainID.ToInt())
+		assert.Equal(t, "no new heads", event.Error)
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for chain event")
+	}
+
+	// Staying unhealthy does not publish again
+	time.Sleep(50 * time.Millisecond)
+	eb.AssertExpectations(t)
+}
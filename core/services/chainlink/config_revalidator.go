@@ -0,0 +1,113 @@
+package chainlink
+
+import (
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+var promConfigInvalid = promauto.NewGaugeVec(prometheus.GaugeOpts{
+	Name: "evm_config_invalid",
+	Help: "Set to 1 if the chain's config failed its last revalidation and 0 otherwise. Only reported if CONFIG_REVALIDATION_INTERVAL is set",
+}, []string{"evmChainID"})
+
+// validatable is the subset of config.EVMConfig needed to revalidate it
+type validatable interface {
+	ChainID() *big.Int
+	Validate() error
+}
+
+// configRevalidator periodically validates a chain's config again. Runtime
+// changes, such as persisted values set via the API or a reload of the
+// evm_chains cfg, can leave it invalid long after the checks at boot. The
+// last failure is reported by Healthy and the evm_config_invalid metric.
+type configRevalidator struct {
+	cfg      validatable
+	interval time.Duration
+
+	errMu sync.RWMutex
+	err   error
+
+	chStop chan struct{}
+	chDone chan struct{}
+
+	utils.StartStopOnce
+}
+
+func newConfigRevalidator(cfg validatable, interval time.Duration) *configRevalidator {
+	return &configRevalidator{
+		cfg:      cfg,
+		interval: interval,
+		chStop:   make(chan struct{}),
+		chDone:   make(chan struct{}),
+	}
+}
+
+func (r *configRevalidator) Start() error {
+	return r.StartOnce("ConfigRevalidator", func() error {
+		go r.run()
+		return nil
+	})
+}
+
+func (r *configRevalidator) Close() error {
+	return r.StopOnce("ConfigRevalidator", func() error {
+		close(r.chStop)
+		<-r.chDone
+		return nil
+	})
+}
+
+// Healthy returns the error from the last revalidation, if it failed
+func (r *configRevalidator) Healthy() error {
+	if err := r.StartStopOnce.Healthy(); err != nil {
+		return err
+	}
+	r.errMu.RLock()
+	defer r.errMu.RUnlock()
+	return r.err
+}
+
+func (r *configRevalidator) run() {
+	defer close(r.chDone)
+
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			r.revalidate()
+		case <-r.chStop:
+			return
+		}
+	}
+}
+
+func (r *configRevalidator) revalidate() {
+	chainID := r.cfg.ChainID().String()
+	err := r.cfg.Validate()
+	if err != nil {
+		err = errors.Wrapf(err, "config for chain %s is no longer valid", chainID)
+		promConfigInvalid.WithLabelValues(chainID).Set(1)
+	} else {
+		promConfigInvalid.WithLabelValues(chainID).Set(0)
+	}
+
+	r.errMu.Lock()
+	prev := r.err
+	r.err = err
+	r.errMu.Unlock()
+
+	if err != nil && prev == nil {
+		logger.Errorw("ConfigRevalidator: chain config failed revalidation", "evmChainID", chainID, "error", err)
+	} else if err == nil && prev != nil {
+		logger.Infow("ConfigRevalidator: chain config is valid again", "evmChainID", chainID)
+	}
+}
@@ -0,0 +1,59 @@
+package chainlink
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/configtest"
+	"github.com/smartcontractkit/chainlink/core/store/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+)
+
+// mutableConfigSource lets a test change config values after boot
+type mutableConfigSource struct {
+	mu     sync.Mutex
+	values map[string]string
+}
+
+func (s *mutableConfigSource) Lookup(key string) (string, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	v, ok := s.values[key]
+	return v, ok
+}
+
+func (s *mutableConfigSource) set(key, value string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.values[key] = value
+}
+
+func TestConfigRevalidator(t *testing.T) {
+	gcfg := configtest.NewTestGeneralConfigWithOverrides(t, configtest.GeneralConfigOverrides{EthereumDisabled: null.BoolFrom(true)})
+	source := &mutableConfigSource{values: map[string]string{}}
+	cfg := config.NewEVMConfigWithSource(gcfg, source)
+	require.NoError(t, cfg.Validate())
+	chainID := cfg.ChainID().String()
+
+	r := newConfigRevalidator(cfg, 10*time.Millisecond)
+	require.NoError(t, r.Start())
+	defer func() { require.NoError(t, r.Close()) }()
+
+	time.Sleep(50 * time.Millisecond)
+	assert.NoError(t, r.Healthy())
+	assert.Equal(t, float64(0), testutil.ToFloat64(promConfigInvalid.WithLabelValues(chainID)))
+
+	// Tightening the minimum gas price above the default makes it invalid
+	source.set("ETH_MIN_GAS_PRICE_WEI", "100000000000")
+	require.Eventually(t, func() bool { return r.Healthy() != nil }, time.Second, 10*time.Millisecond)
+	assert.Contains(t, r.Healthy().Error(), "ETH_MIN_GAS_PRICE_WEI must be less than or equal to ETH_GAS_PRICE_DEFAULT")
+	assert.Equal(t, float64(1), testutil.ToFloat64(promConfigInvalid.WithLabelValues(chainID)))
+
+	source.set("ETH_MIN_GAS_PRICE_WEI", "1000000000")
+	require.Eventually(t, func() bool { return r.Healthy() == nil }, time.Second, 10*time.Millisecond)
+	assert.Equal(t, float64(0), testutil.ToFloat64(promConfigInvalid.WithLabelValues(chainID)))
+}
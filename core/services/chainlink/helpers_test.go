@@ -0,0 +1,13 @@
+package chainlink
+
+import "github.com/smartcontractkit/chainlink/core/services/eth"
+
+var CheckOCRChainHasPrimaryNode = checkOCRChainHasPrimaryNode
+
+type ChainSummary = chainSummary
+
+var SummarizeChain = summarizeChain
+
+func (app *ChainlinkApplication) SetEthClient(ethClient eth.Client) {
+	app.ethClient = ethClient
+}
@@ -34,7 +34,7 @@ type (
 	}
 
 	Config interface {
-		MinIncomingConfirmations() uint32
+		EffectiveIncomingConfirmations() uint32
 		MinimumContractPayment() *assets.Link
 	}
 )
@@ -79,7 +79,7 @@ func (d *Delegate) ServicesForSpec(job job.Job) (services []job.Service, err err
 		return
 	}
 
-	minIncomingConfirmations := d.config.MinIncomingConfirmations()
+	minIncomingConfirmations := d.config.EffectiveIncomingConfirmations()
 
 	if concreteSpec.MinIncomingConfirmations.Uint32 > minIncomingConfirmations {
 		minIncomingConfirmations = concreteSpec.MinIncomingConfirmations.Uint32
@@ -429,7 +429,7 @@ type testConfig struct {
 	minimumContractPayment   *assets.Link
 }
 
-func (c testConfig) MinIncomingConfirmations() uint32 {
+func (c testConfig) EffectiveIncomingConfirmations() uint32 {
 	return c.minIncomingConfirmations
 }
 
@@ -0,0 +1,144 @@
+package eth
+
+import (
+	"sync"
+	"time"
+)
+
+// Circuit breaker states
+const (
+	CircuitBreakerClosed   = "closed"
+	CircuitBreakerOpen     = "open"
+	CircuitBreakerHalfOpen = "half-open"
+)
+
+// circuitBreaker takes a node out of rotation after threshold consecutive
+// failures. Once cooldown has passed it lets a single request through to
+// probe the node, closing again if it succeeds and re-opening if it fails.
+// A nil circuitBreaker is disabled and always allows requests.
+type circuitBreaker struct {
+	threshold uint32
+	cooldown  time.Duration
+	now       func() time.Time
+
+	mu          sync.Mutex
+	state       string
+	failures    uint32
+	lastFailure time.Time
+	openedAt    time.Time
+}
+
+func newCircuitBreaker(threshold uint32, cooldown time.Duration) *circuitBreaker {
+	if threshold == 0 {
+		return nil
+	}
+	return &circuitBreaker{
+		threshold: threshold,
+		cooldown:  cooldown,
+		now:       time.Now,
+		state:     CircuitBreakerClosed,
+	}
+}
+
+// Allow returns true if a request may be sent to the node. When the cooldown
+// has passed on an open breaker, the first caller is allowed through as the
+// probe and the breaker becomes half-open until the probe's result is
+// recorded.
+func (b *circuitBreaker) Allow() bool {
+	if b == nil {
+		return true
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	switch b.state {
+	case CircuitBreakerOpen:
+		if b.now().Sub(b.openedAt) < b.cooldown {
+			return false
+		}
+		b.state = CircuitBreakerHalfOpen
+		return true
+	case CircuitBreakerHalfOpen:
+		// A probe is already in flight
+		return false
+	default:
+		return true
+	}
+}
+
+// Available returns true if Allow would let a request through, without
+// claiming the half-open probe
+func (b *circuitBreaker) Available() bool {
+	if b == nil {
+		return true
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	switch b.state {
+	case CircuitBreakerOpen:
+		return b.now().Sub(b.openedAt) >= b.cooldown
+	case CircuitBreakerHalfOpen:
+		return false
+	default:
+		return true
+	}
+}
+
+// Record records the outcome of a request that was allowed. It returns true
+// if this failure tripped the breaker open.
+func (b *circuitBreaker) Record(failed bool) (tripped bool) {
+	if b == nil {
+		return false
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	now := b.now()
+	if !failed {
+		b.state = CircuitBreakerClosed
+		b.failures = 0
+		return false
+	}
+	if b.state == CircuitBreakerHalfOpen {
+		b.state = CircuitBreakerOpen
+		b.openedAt = now
+		return true
+	}
+	// Failures further apart than the cooldown are not consecutive
+	if now.Sub(b.lastFailure) > b.cooldown {
+		b.failures = 0
+	}
+	b.lastFailure = now
+	b.failures++
+	if b.failures >= b.threshold && b.state != CircuitBreakerOpen {
+		b.state = CircuitBreakerOpen
+		b.openedAt = now
+		return true
+	}
+	return false
+}
+
+// Cancel records that a request which was allowed was abandoned before
+// completing, e.g. because its context was cancelled. This says nothing
+// about the node, so the failure count is left alone, but if the request was
+// the half-open probe the breaker returns to open and waits another cooldown
+// before probing again.
+func (b *circuitBreaker) Cancel() {
+	if b == nil {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.state == CircuitBreakerHalfOpen {
+		b.state = CircuitBreakerOpen
+		b.openedAt = b.now()
+	}
+}
+
+// State returns the breaker's current state
+func (b *circuitBreaker) State() string {
+	if b == nil {
+		return CircuitBreakerClosed
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.state
+}
@@ -0,0 +1,173 @@
+package eth
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_CircuitBreaker(t *testing.T) {
+	newTestBreaker := func(threshold uint32, cooldown time.Duration) (*circuitBreaker, *time.Time) {
+		now := time.Unix(0, 0)
+		b := newCircuitBreaker(threshold, cooldown)
+		require.NotNil(t, b)
+		b.now = func() time.Time { return now }
+		return b, &now
+	}
+
+	t.Run("is disabled with a threshold of 0", func(t *testing.T) {
+		b := newCircuitBreaker(0, time.Minute)
+		assert.Nil(t, b)
+		for i := 0; i < 10; i++ {
+			assert.True(t, b.Allow())
+			assert.False(t, b.Record(true))
+		}
+		assert.Equal(t, CircuitBreakerClosed, b.State())
+	})
+
+	t.Run("trips after threshold consecutive failures", func(t *testing.T) {
+		b, _ := newTestBreaker(3, time.Minute)
+
+		assert.False(t, b.Record(true))
+		assert.False(t, b.Record(true))
+		assert.True(t, b.Allow())
+		assert.True(t, b.Record(true))
+		assert.Equal(t, CircuitBreakerOpen, b.State())
+		assert.False(t, b.Allow())
+	})
+
+	t.Run("a success resets the failure count", func(t *testing.T) {
+		b, _ := newTestBreaker(2, time.Minute)
+
+		b.Record(true)
+		b.Record(false)
+		assert.False(t, b.Record(true))
+		assert.Equal(t, CircuitBreakerClosed, b.State())
+	})
+
+	t.Run("failures further apart than the cooldown are not consecutive", func(t *testing.T) {
+		b, now := newTestBreaker(2, time.Minute)
+
+		b.Record(true)
+		*now = now.Add(2 * time.Minute)
+		assert.False(t, b.Record(true))
+		assert.Equal(t, CircuitBreakerClosed, b.State())
+	})
+
+	t.Run("flapping node is probed after cooldown and re-opened or closed by the probe", func(t *testing.T) {
+		b, now := newTestBreaker(2, time.Minute)
+
+		// Node goes down
+		b.Record(true)
+		assert.True(t, b.Record(true))
+		assert.False(t, b.Allow())
+
+		// Still cooling down
+		*now = now.Add(30 * time.Second)
+		assert.False(t, b.Allow())
+
+		// Cooldown passed, a single probe is let through
+		*now = now.Add(30 * time.Second)
+		assert.True(t, b.Allow())
+		assert.Equal(t, CircuitBreakerHalfOpen, b.State())
+		assert.False(t, b.Allow())
+
+		// Probe fails, breaker re-opens for another cooldown
+		assert.True(t, b.Record(true))
+		assert.Equal(t, CircuitBreakerOpen, b.State())
+		assert.False(t, b.Allow())
+		*now = now.Add(time.Minute)
+		assert.True(t, b.Allow())
+
+		// Node comes back, probe succeeds and the breaker closes
+		assert.False(t, b.Record(false))
+		assert.Equal(t, CircuitBreakerClosed, b.State())
+		assert.True(t, b.Allow())
+
+		// Node flaps again, a single failure does not trip it
+		assert.False(t, b.Record(true))
+		assert.True(t, b.Allow())
+		assert.True(t, b.Record(true))
+		assert.Equal(t, CircuitBreakerOpen, b.State())
+	})
+
+	t.Run("a cancelled probe re-opens the breaker for another cooldown", func(t *testing.T) {
+		b, now := newTestBreaker(1, time.Minute)
+
+		assert.True(t, b.Record(true))
+		*now = now.Add(time.Minute)
+		assert.True(t, b.Allow())
+
+		b.Cancel()
+		assert.Equal(t, CircuitBreakerOpen, b.State())
+		assert.False(t, b.Allow())
+		*now = now.Add(time.Minute)
+		assert.True(t, b.Allow())
+	})
+
+	t.Run("cancelling a request while closed has no effect", func(t *testing.T) {
+		b, _ := newTestBreaker(2, time.Minute)
+
+		b.Record(true)
+		b.Cancel()
+		assert.Equal(t, CircuitBreakerClosed, b.State())
+		assert.True(t, b.Record(true))
+	})
+}
+
+func Test_Client_CancelledProbe(t *testing.T) {
+	requests := make(chan struct{}, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// The request's context is only cancelled on disconnect once the
+		// body has been read
+		_, err := ioutil.ReadAll(r.Body)
+		require.NoError(t, err)
+		select {
+		case requests <- struct{}{}:
+		default:
+		}
+		<-r.Context().Done()
+	}))
+	defer server.Close()
+	u, err := url.Parse(server.URL)
+	require.NoError(t, err)
+	s := newSecondaryNode(*u, "probe", nil)
+	require.NoError(t, s.Dial())
+
+	c := &client{primary: newNode(url.URL{}, nil, "primary", nil), secondaries: []*secondarynode{s}}
+	c.SetNodeCircuitBreaker(1, time.Minute)
+	now := time.Unix(0, 0)
+	s.breaker.now = func() time.Time { return now }
+
+	// Trip the breaker and wait out the cooldown so the next request is the
+	// half-open probe
+	assert.True(t, s.breaker.Record(true))
+	now = now.Add(time.Minute)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		<-requests
+		cancel()
+	}()
+	// The primary is taken out of rotation so that the call goes to the
+	// secondary
+	c.primary.weight = 0
+	err = c.RoundRobinBatchCallContext(ctx, []rpc.BatchElem{{Method: "eth_chainId", Result: new(string)}})
+	require.Error(t, err)
+
+	// The cancelled probe says nothing about the node, so the breaker goes
+	// back to open and probes again after another cooldown
+	assert.Equal(t, CircuitBreakerOpen, s.breaker.State())
+	assert.False(t, s.breaker.Allow())
+	now = now.Add(time.Minute)
+	assert.True(t, s.breaker.Allow())
+	assert.Equal(t, CircuitBreakerHalfOpen, s.breaker.State())
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"math/big"
+	"math/rand"
 	"net/url"
 	"strings"
 	"sync"
@@ -77,6 +78,9 @@ type Subscription interface {
 	Unsubscribe()
 }
 
+// DefaultQueryTimeout is a sensible sanity limit for queries to the eth node
+const DefaultQueryTimeout = 15 * time.Second
+
 // DefaultQueryCtx returns a context with a sensible sanity limit timeout for
 // queries to the eth node
 func DefaultQueryCtx(ctxs ...context.Context) (ctx context.Context, cancel context.CancelFunc) {
@@ -85,22 +89,74 @@ func DefaultQueryCtx(ctxs ...context.Context) (ctx context.Context, cancel conte
 	} else {
 		ctx = context.Background()
 	}
-	return context.WithTimeout(ctx, 15*time.Second)
+	return context.WithTimeout(ctx, DefaultQueryTimeout)
 }
 
+// ErrTooFewSendOnlyNodeAccepts is returned by SendTransaction when fewer
+// secondary nodes accepted a transaction than SetSendOnlyNodeMinAccepts
+// requires
+var ErrTooFewSendOnlyNodeAccepts = errors.New("too few secondary nodes accepted the transaction")
+
+// ErrNodeNotFound is returned by PinNode for an unknown node ID
+var ErrNodeNotFound = errors.New("node not found")
+
 // client represents an abstract client that manages connections to
 // multiple ethereum nodes
 type client struct {
 	primary     *node
 	secondaries []*secondarynode
+	chainID     *big.Int
 	mocked      bool
 
+	// secondaryMinAccepts is the number of secondaries that must accept a
+	// transaction for SendTransaction to succeed
+	secondaryMinAccepts uint32
+
 	roundRobinCount uint32
+
+	// pinnedNode is one more than the ID of the node that rotated traffic is
+	// pinned to, or 0 if no node is pinned
+	pinnedNode int32
+
+	// lowestLatency routes rotated traffic to the fastest node rather than
+	// round-robin
+	lowestLatency bool
 }
 
 var _ Client = (*client)(nil)
 
+// NodePinner is implemented by clients that can route all of a chain's
+// traffic to a single node, for debugging a specific provider
+type NodePinner interface {
+	PinNode(nodeID int32) error
+	UnpinNode()
+	PinnedNode() (string, bool)
+}
+
+var _ NodePinner = (*client)(nil)
+
+// LatencyTracker is implemented by clients that measure the response time
+// of their nodes
+type LatencyTracker interface {
+	FastestNode() (string, bool)
+}
+
+var _ LatencyTracker = (*client)(nil)
+
+// latencyExplorationInterval is how often a rotated request goes to the next
+// node in turn rather than the fastest one when routing by latency, so that
+// the other nodes' averages stay current
+const latencyExplorationInterval = 10
+
 func NewClient(rpcUrl string, rpcHTTPURL *url.URL, secondaryRPCURLs []url.URL) (*client, error) {
+	return NewRateLimitedClient(nil, rpcUrl, rpcHTTPURL, secondaryRPCURLs, 0, 0)
+}
+
+// NewRateLimitedClient creates a client where requests to each node are
+// limited to rps per second with the given burst. An rps of 0 means unlimited.
+// If chainID is not nil, secondary nodes reporting a different chain ID are
+// excluded on Dial.
+func NewRateLimitedClient(chainID *big.Int, rpcUrl string, rpcHTTPURL *url.URL, secondaryRPCURLs []url.URL, rps float64, burst int) (*client, error) {
 	parsed, err := url.ParseRequestURI(rpcUrl)
 	if err != nil {
 		return nil, err
@@ -110,21 +166,234 @@ func NewClient(rpcUrl string, rpcHTTPURL *url.URL, secondaryRPCURLs []url.URL) (
 		return nil, errors.Errorf("ethereum url scheme must be websocket: %s", parsed.String())
 	}
 
-	c := client{}
+	c := client{chainID: chainID}
 
 	// for now only one primary is supported
-	c.primary = newNode(*parsed, rpcHTTPURL, "eth-primary-0")
+	c.primary = newNode(*parsed, rpcHTTPURL, "eth-primary-0", newLimiter(rps, burst))
 
 	for i, url := range secondaryRPCURLs {
 		if url.Scheme != "http" && url.Scheme != "https" {
 			return nil, errors.Errorf("secondary ethereum rpc url scheme must be http(s): %s", url.String())
 		}
-		s := newSecondaryNode(url, fmt.Sprintf("eth-secondary-%d", i))
+		s := newSecondaryNode(url, fmt.Sprintf("eth-secondary-%d", i), newLimiter(rps, burst))
 		c.secondaries = append(c.secondaries, s)
 	}
 	return &c, nil
 }
 
+// SetNodeRateLimit limits requests to each node to rps per second with the
+// given burst. An rps of 0 means unlimited. It must be called before Dial.
+func (client *client) SetNodeRateLimit(rps float64, burst int) {
+	client.primary.limiter = newLimiter(rps, burst)
+	for _, s := range client.secondaries {
+		s.limiter = newLimiter(rps, burst)
+	}
+}
+
+// SetNodeCircuitBreaker enables a circuit breaker on each secondary node,
+// taking it out of rotation for cooldown after threshold consecutive
+// failures. The primary is not affected since there is no other node to
+// route its requests to. A threshold of 0 disables it.
+func (client *client) SetNodeCircuitBreaker(threshold uint32, cooldown time.Duration) {
+	for _, s := range client.secondaries {
+		s.breaker = newCircuitBreaker(threshold, cooldown)
+	}
+}
+
+// SetSendOnlyNodeMinAccepts requires at least n secondary (send-only) nodes
+// to accept a transaction before SendTransaction reports it as sent, or all
+// reachable secondaries if fewer are reachable. Nodes whose circuit breaker is
+// open are not reachable. A node that already knows the transaction counts as
+// accepting it. 0 sends to secondaries on a best-effort basis.
+func (client *client) SetSendOnlyNodeMinAccepts(n uint32) {
+	client.secondaryMinAccepts = n
+}
+
+// SetLowestLatencyRouting sends rotated traffic to the node with the lowest
+// average response time, rather than a node picked at random by weight, if
+// enabled. One request in latencyExplorationInterval is still picked at
+// random, so that a node which has become faster is noticed. Nodes with a weight of 0 or whose circuit breaker is not
+// closed are skipped.
+func (client *client) SetLowestLatencyRouting(enabled bool) {
+	client.lowestLatency = enabled
+}
+
+// NodeConfigurer is implemented by clients whose nodes take settings from the
+// nodes table and from config values that may be persisted, which are only
+// known once the DB has been attached
+type NodeConfigurer interface {
+	ApplyNodeConfigs(configs []NodeConfig) error
+	SetNodeRateLimit(rps float64, burst int)
+	SetNodeCircuitBreaker(threshold uint32, cooldown time.Duration)
+}
+
+// ApplyNodeConfigs applies the settings stored in the nodes table to the
+// client's nodes, which are still created from ETH_URL and
+// ETH_SECONDARY_URLS. A row applies to the primary if its ws_url is the
+// primary's URL, and to a send-only node if its http_url is that node's URL.
+// Rows matching no node are logged and ignored. It must be called before
+// Dial.
+//
+// A node's weight sets its share of the calls rotated by
+// RoundRobinBatchCallContext relative to the other nodes. A weight of 0 takes
+// the node out of rotation, but it can still be pinned with PinNode.
+func (client *client) ApplyNodeConfigs(configs []NodeConfig) error {
+	for _, cfg := range configs {
+		matched := false
+		if !cfg.SendOnly && cfg.WSURL.Valid && cfg.WSURL.String == client.primary.ws.uri.String() {
+			if err := client.primary.applyConfig(cfg); err != nil {
+				return err
+			}
+			matched = true
+		}
+		for _, s := range client.secondaries {
+			if cfg.SendOnly && cfg.HTTPURL.Valid && cfg.HTTPURL.String == s.uri.String() {
+				if err := s.applyConfig(cfg); err != nil {
+					return err
+				}
+				matched = true
+			}
+		}
+		if !matched {
+			logger.Warnw(fmt.Sprintf("eth.Client: node %s does not match ETH_URL or any of ETH_SECONDARY_URLS, ignoring it", cfg.Name), "nodeName", cfg.Name)
+		}
+	}
+	return nil
+}
+
+// PinNode sends all traffic that would otherwise be spread across nodes to
+// the node with the given ID, bypassing round-robin and circuit breakers. The
+// primary has ID 0 and secondaries are numbered from 1, in the order of
+// ETH_SECONDARY_URLS less any excluded on Dial. Secondaries can only serve
+// batch calls and broadcasts, so subscriptions and other reads always go to
+// the primary. Since this is a debugging tool, pinning an unhealthy node is
+// allowed but logs a warning.
+func (client *client) PinNode(nodeID int32) error {
+	if nodeID < 0 || int(nodeID) > len(client.secondaries) {
+		return errors.Wrapf(ErrNodeNotFound, "no node with ID %d, expected 0 for the primary or 1 to %d for a secondary", nodeID, len(client.secondaries))
+	}
+	name := client.primary.name
+	if nodeID > 0 {
+		s := client.secondaries[nodeID-1]
+		name = s.name
+		if state := s.breaker.State(); state != CircuitBreakerClosed {
+			logger.Warnw(fmt.Sprintf("eth.Client: pinning secondary node %s although its circuit breaker is %s", name, state), "nodeName", name)
+		}
+	}
+	atomic.StoreInt32(&client.pinnedNode, nodeID+1)
+	logger.Warnw(fmt.Sprintf("eth.Client: pinned all traffic to node %s, rotation is disabled until it is unpinned", name), "nodeName", name)
+	return nil
+}
+
+// UnpinNode undoes PinNode, restoring normal rotation
+func (client *client) UnpinNode() {
+	if atomic.SwapInt32(&client.pinnedNode, 0) != 0 {
+		logger.Info("eth.Client: unpinned node, rotation is enabled again")
+	}
+}
+
+// PinnedNode returns the name of the node that traffic is pinned to, if any
+func (client *client) PinnedNode() (string, bool) {
+	id, ok := client.pinnedNodeID()
+	if !ok {
+		return "", false
+	}
+	if id == 0 {
+		return client.primary.name, true
+	}
+	return client.secondaries[id-1].name, true
+}
+
+// FastestNode returns the name of the node with the lowest average response
+// time to round-robin batch calls, skipping nodes with a weight of 0 and
+// secondaries whose circuit breaker is not closed. Returns false if no request
+// has been timed yet.
+func (client *client) FastestNode() (string, bool) {
+	id, ok := client.fastestNodeID()
+	if !ok {
+		return "", false
+	}
+	if id == 0 {
+		return client.primary.name, true
+	}
+	return client.secondaries[id-1].name, true
+}
+
+// fastestNodeID returns the ID of the node with the lowest average latency,
+// numbered as for PinNode
+func (client *client) fastestNodeID() (int32, bool) {
+	fastestID, found := int32(0), false
+	fastest, ok := client.primary.latency.Value()
+	if ok && client.primary.weight > 0 {
+		found = true
+	}
+	for i, s := range client.secondaries {
+		if s.weight <= 0 || s.breaker.State() != CircuitBreakerClosed {
+			continue
+		}
+		if latency, ok := s.latency.Value(); ok && (!found || latency < fastest) {
+			fastestID, fastest, found = int32(i+1), latency, true
+		}
+	}
+	return fastestID, found
+}
+
+// weightedRandomIndex picks the ID of a node at random, with each node's
+// chance proportional to its weight: with weights of 1 and 3, the first node
+// is picked one time in four. Nodes with a weight of 0 and secondaries whose
+// circuit breaker would not allow a request are skipped. If no node is left,
+// the primary is used.
+func (client *client) weightedRandomIndex() int {
+	weights := make([]int, len(client.secondaries)+1)
+	weights[0] = client.primary.weight
+	total := weights[0]
+	for i, s := range client.secondaries {
+		if s.weight <= 0 || !s.breaker.Available() {
+			continue
+		}
+		weights[i+1] = s.weight
+		total += s.weight
+	}
+	if total <= 0 {
+		return 0
+	}
+	pick := rand.Intn(total)
+	for id, w := range weights {
+		if pick < w {
+			return id
+		}
+		pick -= w
+	}
+	return 0
+}
+
+// pinnedNodeID returns the ID of the pinned node, if any. A secondary that
+// has since been excluded on Dial is no longer pinned.
+func (client *client) pinnedNodeID() (int32, bool) {
+	id := atomic.LoadInt32(&client.pinnedNode) - 1
+	return id, id >= 0 && int(id) <= len(client.secondaries)
+}
+
+// recordSecondaryResult records the outcome of a request to s with its
+// circuit breaker. Failures caused by ctx being cancelled are not counted.
+func (client *client) recordSecondaryResult(ctx context.Context, s *secondarynode, failed bool, err error) {
+	if failed && ctx.Err() != nil {
+		s.breaker.Cancel()
+		return
+	}
+	if s.breaker.Record(failed) {
+		logger.Warnw(fmt.Sprintf("eth.Client: circuit breaker tripped for secondary node %s, taking it out of rotation", s.name), "nodeName", s.name, "error", err)
+	}
+}
+
+// isNodeSendFailure returns true if err indicates a problem with the node
+// rather than with the transaction itself
+func isNodeSendFailure(err *SendError) bool {
+	return !err.Fatal() && !err.IsNonceTooLowError() && !err.IsTransactionAlreadyInMempool() &&
+		!err.IsReplacementUnderpriced() && !err.IsTerminallyUnderpriced() && !err.IsTemporarilyUnderpriced() &&
+		!err.IsInsufficientEth() && !err.IsTooExpensive() && !err.IsFeeTooLow() && !err.IsFeeTooHigh()
+}
+
 func (client *client) Dial(ctx context.Context) error {
 	if client.mocked {
 		return nil
@@ -139,9 +408,37 @@ func (client *client) Dial(ctx context.Context) error {
 			return err
 		}
 	}
+	if client.chainID != nil {
+		client.secondaries = client.verifySecondaryChainIDs(ctx)
+	}
 	return nil
 }
 
+// verifySecondaryChainIDs returns the secondaries whose net_version matches
+// the configured chain ID. A secondary serving a different chain would
+// broadcast our transactions to the wrong network, so it is excluded. The
+// primary itself is checked against the configured chain ID by the head
+// tracker.
+func (client *client) verifySecondaryChainIDs(ctx context.Context) []*secondarynode {
+	chainID := client.chainID
+	var verified []*secondarynode
+	for _, s := range client.secondaries {
+		netVersion, err := s.NetVersion(ctx)
+		if err != nil {
+			logger.Warnw(fmt.Sprintf("eth.Client: could not verify chain ID of secondary node %s", s.name), "nodeName", s.name, "error", err)
+			verified = append(verified, s)
+			continue
+		}
+		if netVersion.Cmp(chainID) != 0 {
+			logger.Errorw(fmt.Sprintf("eth.Client: secondary node %s is on chain %s but chain %s is configured, excluding it", s.name, netVersion, chainID),
+				"nodeName", s.name, "nodeChainID", netVersion, "chainID", chainID)
+			continue
+		}
+		verified = append(verified, s)
+	}
+	return verified
+}
+
 func (client *client) Close() {
 	client.primary.Close()
 }
@@ -208,26 +505,58 @@ func (client *client) HeaderByNumber(ctx context.Context, n *big.Int) (*types.He
 	return client.primary.HeaderByNumber(ctx, n)
 }
 
-// SendTransaction also uses the secondary HTTP RPC URLs if set
+// SendTransaction also uses the secondary HTTP RPC URLs if set. If
+// SetSendOnlyNodeMinAccepts is set, it returns ErrTooFewSendOnlyNodeAccepts
+// unless enough reachable secondaries accepted the transaction, even if the
+// primary did. If a node is pinned, the transaction is only sent to that node.
 func (client *client) SendTransaction(ctx context.Context, tx *types.Transaction) error {
+	if id, ok := client.pinnedNodeID(); ok {
+		if id == 0 {
+			return client.primary.SendTransaction(ctx, tx)
+		}
+		return client.secondaries[id-1].SendTransaction(ctx, tx)
+	}
+
 	var wg sync.WaitGroup
 	defer wg.Wait()
+	var accepts, reachable uint32
 	for _, s := range client.secondaries {
+		if !s.breaker.Allow() {
+			continue
+		}
+		reachable++
 		// Parallel send to secondary node
 		wg.Add(1)
 		go func(s *secondarynode) {
 			defer wg.Done()
 			err := NewSendError(s.SendTransaction(ctx, tx))
+			client.recordSecondaryResult(ctx, s, err != nil && isNodeSendFailure(err), err)
 			if err == nil || err.IsNonceTooLowError() || err.IsTransactionAlreadyInMempool() {
 				// Nonce too low or transaction known errors are expected since
 				// the primary SendTransaction may well have succeeded already
+				atomic.AddUint32(&accepts, 1)
+				promSecondaryNodeBroadcasts.WithLabelValues(s.name, "accepted").Inc()
 				return
 			}
+			promSecondaryNodeBroadcasts.WithLabelValues(s.name, "rejected").Inc()
 			logger.Warnw("secondary eth client returned error", "err", err, "tx", tx)
 		}(s)
 	}
 
-	return client.primary.SendTransaction(ctx, tx)
+	err := client.primary.SendTransaction(ctx, tx)
+	if client.secondaryMinAccepts == 0 || (err != nil && !NewSendError(err).IsTransactionAlreadyInMempool()) {
+		return err
+	}
+	wg.Wait()
+	required := client.secondaryMinAccepts
+	if reachable < required {
+		required = reachable
+	}
+	if accepts < required {
+		promSendOnlyNodeMinAcceptsMissed.Inc()
+		return errors.Wrapf(ErrTooFewSendOnlyNodeAccepts, "%d of the %d required secondary nodes accepted transaction %s", accepts, required, tx.Hash().Hex())
+	}
+	return err
 }
 
 func (client *client) PendingNonceAt(ctx context.Context, account common.Address) (uint64, error) {
@@ -315,8 +644,18 @@ func (client *client) BatchCallContext(ctx context.Context, b []rpc.BatchElem) e
 	return client.primary.BatchCallContext(ctx, b)
 }
 
-// RoundRobinBatchCallContext rotates through Primary and all Secondaries, changing node on each call
+// RoundRobinBatchCallContext spreads calls across Primary and all Secondaries,
+// unless a node is pinned. Each call goes to a node picked at random, with a
+// chance proportional to its weight. If SetLowestLatencyRouting is enabled,
+// most calls go to the fastest node instead.
 func (client *client) RoundRobinBatchCallContext(ctx context.Context, b []rpc.BatchElem) error {
+	if id, ok := client.pinnedNodeID(); ok {
+		if id == 0 {
+			return client.BatchCallContext(ctx, b)
+		}
+		return client.secondaries[id-1].BatchCallContext(ctx, b)
+	}
+
 	nSecondaries := len(client.secondaries)
 	if nSecondaries == 0 {
 		return client.BatchCallContext(ctx, b)
@@ -325,12 +664,34 @@ func (client *client) RoundRobinBatchCallContext(ctx context.Context, b []rpc.Ba
 	// NOTE: AddUint32 returns the number after addition, so we must -1 to get the "current" count
 	count := atomic.AddUint32(&client.roundRobinCount, 1) - 1
 	// idx 0 indicates the primary, subsequent indices represent secondaries
-	rr := int(count % uint32(nSecondaries+1))
+	rr := client.weightedRandomIndex()
+	if client.lowestLatency && count%latencyExplorationInterval != 0 {
+		if id, ok := client.fastestNodeID(); ok {
+			rr = int(id)
+		}
+	}
 
 	if rr == 0 {
-		return client.BatchCallContext(ctx, b)
+		return client.timedPrimaryBatchCall(ctx, b)
+	}
+	s := client.secondaries[rr-1]
+	if !s.breaker.Allow() {
+		return client.timedPrimaryBatchCall(ctx, b)
 	}
-	return client.secondaries[rr-1].BatchCallContext(ctx, b)
+	start := time.Now()
+	err := s.BatchCallContext(ctx, b)
+	recordLatency(ctx, s.latency, start, err)
+	client.recordSecondaryResult(ctx, s, err != nil, err)
+	return err
+}
+
+// timedPrimaryBatchCall sends a rotated batch call to the primary, recording
+// its response time
+func (client *client) timedPrimaryBatchCall(ctx context.Context, b []rpc.BatchElem) error {
+	start := time.Now()
+	err := client.BatchCallContext(ctx, b)
+	recordLatency(ctx, client.primary.latency, start, err)
+	return err
 }
 
 func (client *client) SuggestGasTipCap(ctx context.Context) (tipCap *big.Int, err error) {
@@ -8,10 +8,14 @@ import (
 	"net/http/httptest"
 	"net/url"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"math/big"
 
+	"github.com/gorilla/websocket"
 	"github.com/onsi/gomega"
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/core/services/eth"
@@ -22,9 +26,11 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
 )
 
 func TestEthClient_TransactionReceipt(t *testing.T) {
@@ -73,6 +79,34 @@ func TestEthClient_TransactionReceipt(t *testing.T) {
 	})
 }
 
+func TestEthClient_RateLimit(t *testing.T) {
+	t.Parallel()
+
+	address := cltest.NewAddress()
+
+	_, url, cleanup := cltest.NewWSServer(`{
+      "id": 1,
+      "jsonrpc": "2.0",
+      "result": "0x100"
+    }`, func(data []byte) {})
+	defer cleanup()
+
+	ethClient, err := eth.NewRateLimitedClient(nil, url, nil, nil, 1, 1)
+	require.NoError(t, err)
+	err = ethClient.Dial(context.Background())
+	require.NoError(t, err)
+
+	_, err = ethClient.PendingNonceAt(context.Background(), address)
+	require.NoError(t, err)
+
+	// The burst is exhausted so the next request must wait ~1s, which
+	// exceeds the deadline
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	_, err = ethClient.PendingNonceAt(ctx, address)
+	require.Error(t, err)
+}
+
 func TestEthClient_PendingNonceAt(t *testing.T) {
 	t.Parallel()
 
@@ -333,3 +367,411 @@ func TestEthClient_SendTransaction_WithSecondaryURLs(t *testing.T) {
 		return len(requests)
 	}).Should(gomega.Equal(2))
 }
+
+func TestEthClient_SendTransaction_SendOnlyNodeMinAccepts(t *testing.T) {
+	t.Parallel()
+
+	tx := types.NewTransaction(uint64(42), cltest.NewAddress(), big.NewInt(142), 242, big.NewInt(342), []byte{1, 2, 3})
+
+	_, wsUrl, cleanup := cltest.NewWSServer(`{"id": 1, "jsonrpc": "2.0", "result": "`+tx.Hash().Hex()+`"}`, nil)
+	defer cleanup()
+
+	newSecondary := func(result string) url.URL {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			req := cltest.ParseJSON(t, r.Body)
+			_, err := w.Write([]byte(`{"id": ` + req.Get("id").String() + `, "jsonrpc": "2.0", ` + result + `}`))
+			require.NoError(t, err)
+		}))
+		t.Cleanup(server.Close)
+		return *cltest.MustParseURL(server.URL)
+	}
+	accepting := newSecondary(`"result": "` + tx.Hash().Hex() + `"`)
+	failing := newSecondary(`"error": {"code": -32000, "message": "something went wrong"}`)
+
+	// The WS server always replies with request ID 1, so each send needs a
+	// fresh client
+	send := func(minAccepts uint32) error {
+		ethClient, err := eth.NewClient(wsUrl, nil, []url.URL{accepting, failing})
+		require.NoError(t, err)
+		require.NoError(t, ethClient.Dial(context.Background()))
+		defer ethClient.Close()
+		ethClient.SetSendOnlyNodeMinAccepts(minAccepts)
+		return ethClient.SendTransaction(context.Background(), tx)
+	}
+
+	// Best-effort by default
+	assert.NoError(t, send(0))
+	assert.NoError(t, send(1))
+
+	missed := eth.SendOnlyNodeMinAcceptsMissed()
+	err := send(2)
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, eth.ErrTooFewSendOnlyNodeAccepts))
+	assert.Contains(t, err.Error(), "1 of the 2 required secondary nodes accepted transaction")
+	assert.Equal(t, missed+1, eth.SendOnlyNodeMinAcceptsMissed())
+
+	t.Run("does not count secondaries whose circuit breaker is open", func(t *testing.T) {
+		ethClient, err := eth.NewClient(newEchoIDWSServer(t, `"`+tx.Hash().Hex()+`"`), nil, []url.URL{accepting, failing})
+		require.NoError(t, err)
+		require.NoError(t, ethClient.Dial(context.Background()))
+		defer ethClient.Close()
+		ethClient.SetNodeCircuitBreaker(1, time.Hour)
+		ethClient.SetSendOnlyNodeMinAccepts(2)
+
+		// Opens the failing node's circuit breaker
+		err = ethClient.SendTransaction(context.Background(), tx)
+		assert.True(t, errors.Is(err, eth.ErrTooFewSendOnlyNodeAccepts))
+
+		// Leaving one reachable secondary, which accepts
+		require.NoError(t, ethClient.SendTransaction(context.Background(), tx))
+	})
+}
+
+// newEchoIDWSServer returns the URL of a websocket RPC server that replies to
+// every request with result. Unlike cltest.NewWSServer it echoes the request
+// ID, so that a client can make more than one request.
+func newEchoIDWSServer(t *testing.T, result string) string {
+	upgrader := websocket.Upgrader{CheckOrigin: func(r *http.Request) bool { return true }}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		for {
+			_, data, err := conn.ReadMessage()
+			if err != nil {
+				return
+			}
+			id := cltest.ParseJSON(t, bytes.NewReader(data)).Get("id").String()
+			if err = conn.WriteMessage(websocket.TextMessage, []byte(`{"id": `+id+`, "jsonrpc": "2.0", "result": `+result+`}`)); err != nil {
+				return
+			}
+		}
+	}))
+	t.Cleanup(server.Close)
+	u := cltest.MustParseURL(server.URL)
+	u.Scheme = "ws"
+	return u.String()
+}
+
+func TestEthClient_ApplyNodeConfigs(t *testing.T) {
+	t.Parallel()
+
+	tx := types.NewTransaction(uint64(42), cltest.NewAddress(), big.NewInt(142), 242, big.NewInt(342), []byte{1, 2, 3})
+
+	_, wsUrl, cleanup := cltest.NewWSServer(`{"id": 1, "jsonrpc": "2.0", "result": "`+tx.Hash().Hex()+`"}`, nil)
+	defer cleanup()
+
+	apiKeys := make(chan string, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		apiKeys <- r.Header.Get("X-Api-Key")
+		req := cltest.ParseJSON(t, r.Body)
+		_, err := w.Write([]byte(`{"id": ` + req.Get("id").String() + `, "jsonrpc": "2.0", "result": "` + tx.Hash().Hex() + `"}`))
+		require.NoError(t, err)
+	}))
+	defer server.Close()
+	secondaryURL := *cltest.MustParseURL(server.URL)
+
+	ethClient, err := eth.NewClient(wsUrl, nil, []url.URL{secondaryURL})
+	require.NoError(t, err)
+
+	err = ethClient.ApplyNodeConfigs([]eth.NodeConfig{{
+		Name:     "bad-headers",
+		HTTPURL:  null.StringFrom(server.URL),
+		SendOnly: true,
+		Headers:  map[string]string{"X-Api Key": "secret"},
+	}})
+	require.EqualError(t, err, `invalid headers for node eth-secondary-0: "X-Api Key" is not a valid HTTP header name`)
+
+	require.NoError(t, ethClient.ApplyNodeConfigs([]eth.NodeConfig{
+		{Name: "send-only", HTTPURL: null.StringFrom(server.URL), SendOnly: true, Weight: 1, Headers: map[string]string{"X-Api-Key": "secret"}},
+		// Matches no configured node, so it is ignored
+		{Name: "unknown", HTTPURL: null.StringFrom("http://example.com"), SendOnly: true, Headers: map[string]string{"X-Api-Key": "other"}},
+	}))
+	require.NoError(t, ethClient.Dial(context.Background()))
+	defer ethClient.Close()
+
+	require.NoError(t, ethClient.SendTransaction(context.Background(), tx))
+	assert.Equal(t, "secret", <-apiKeys)
+}
+
+func TestEthClient_Dial_ExcludesSecondaryOnWrongChain(t *testing.T) {
+	t.Parallel()
+
+	_, wsUrl, cleanup := cltest.NewWSServer(`{"id": 1, "jsonrpc": "2.0", "result": "0x"}`, nil)
+	defer cleanup()
+
+	newSecondary := func(netVersion string) (url.URL, chan struct{}) {
+		sent := make(chan struct{}, 1)
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			req := cltest.ParseJSON(t, r.Body)
+			switch req.Get("method").String() {
+			case "net_version":
+				_, err := w.Write([]byte(`{"id": ` + req.Get("id").String() + `, "jsonrpc": "2.0", "result": "` + netVersion + `"}`))
+				require.NoError(t, err)
+			case "eth_sendRawTransaction":
+				_, err := w.Write([]byte(`{"id": ` + req.Get("id").String() + `, "jsonrpc": "2.0", "result": "0x"}`))
+				require.NoError(t, err)
+				sent <- struct{}{}
+			}
+		}))
+		t.Cleanup(server.Close)
+		return *cltest.MustParseURL(server.URL), sent
+	}
+	matching, matchingSent := newSecondary("42")
+	mismatched, mismatchedSent := newSecondary("1")
+
+	ethClient, err := eth.NewRateLimitedClient(big.NewInt(42), wsUrl, nil, []url.URL{matching, mismatched}, 0, 0)
+	require.NoError(t, err)
+	require.NoError(t, ethClient.Dial(context.Background()))
+
+	tx := types.NewTransaction(uint64(42), cltest.NewAddress(), big.NewInt(142), 242, big.NewInt(342), []byte{1, 2, 3})
+	require.NoError(t, ethClient.SendTransaction(context.Background(), tx))
+
+	select {
+	case <-matchingSent:
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected transaction to be sent to the secondary on the matching chain")
+	}
+	assert.Never(t, func() bool { return len(mismatchedSent) > 0 }, 500*time.Millisecond, 50*time.Millisecond)
+}
+
+func TestEthClient_PinNode(t *testing.T) {
+	t.Parallel()
+
+	tx := types.NewTransaction(uint64(42), cltest.NewAddress(), big.NewInt(142), 242, big.NewInt(342), []byte{1, 2, 3})
+	response := `"result": "` + tx.Hash().Hex() + `"`
+
+	var primaryRequests int32
+	_, wsUrl, cleanup := cltest.NewWSServer(`{"id": 1, "jsonrpc": "2.0", `+response+`}`, func([]byte) {
+		atomic.AddInt32(&primaryRequests, 1)
+	})
+	defer cleanup()
+
+	newSecondary := func() (url.URL, *int32) {
+		var requests int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&requests, 1)
+			req := cltest.ParseJSON(t, r.Body)
+			if req.IsArray() {
+				_, err := w.Write([]byte(`[{"id": ` + req.Get("0.id").String() + `, "jsonrpc": "2.0", ` + response + `}]`))
+				require.NoError(t, err)
+				return
+			}
+			_, err := w.Write([]byte(`{"id": ` + req.Get("id").String() + `, "jsonrpc": "2.0", ` + response + `}`))
+			require.NoError(t, err)
+		}))
+		t.Cleanup(server.Close)
+		return *cltest.MustParseURL(server.URL), &requests
+	}
+	secondary1, secondary1Requests := newSecondary()
+	secondary2, secondary2Requests := newSecondary()
+
+	ethClient, err := eth.NewClient(wsUrl, nil, []url.URL{secondary1, secondary2})
+	require.NoError(t, err)
+	require.NoError(t, ethClient.Dial(context.Background()))
+	defer ethClient.Close()
+
+	_, pinned := ethClient.PinnedNode()
+	assert.False(t, pinned)
+	assert.True(t, errors.Is(ethClient.PinNode(3), eth.ErrNodeNotFound))
+	assert.Error(t, ethClient.PinNode(-1))
+
+	require.NoError(t, ethClient.PinNode(2))
+	name, pinned := ethClient.PinnedNode()
+	assert.True(t, pinned)
+	assert.Equal(t, "eth-secondary-1", name)
+
+	require.NoError(t, ethClient.SendTransaction(context.Background(), tx))
+	for i := 0; i < 3; i++ {
+		var result string
+		require.NoError(t, ethClient.RoundRobinBatchCallContext(context.Background(), []rpc.BatchElem{
+			{Method: "eth_getTransactionByHash", Args: []interface{}{tx.Hash()}, Result: &result},
+		}))
+	}
+
+	assert.Equal(t, int32(4), atomic.LoadInt32(secondary2Requests))
+	assert.Equal(t, int32(0), atomic.LoadInt32(secondary1Requests))
+	assert.Equal(t, int32(0), atomic.LoadInt32(&primaryRequests))
+
+	ethClient.UnpinNode()
+	_, pinned = ethClient.PinnedNode()
+	assert.False(t, pinned)
+
+	// Pinning the primary stops broadcasts to the secondaries
+	require.NoError(t, ethClient.PinNode(0))
+	name, _ = ethClient.PinnedNode()
+	assert.Equal(t, "eth-primary-0", name)
+
+	require.NoError(t, ethClient.SendTransaction(context.Background(), tx))
+
+	assert.Equal(t, int32(1), atomic.LoadInt32(&primaryRequests))
+	assert.Equal(t, int32(4), atomic.LoadInt32(secondary2Requests))
+	assert.Equal(t, int32(0), atomic.LoadInt32(secondary1Requests))
+}
+
+func TestEthClient_NodeWeights(t *testing.T) {
+	t.Parallel()
+
+	_, wsUrl, cleanup := cltest.NewWSServer(`{"id": 1, "jsonrpc": "2.0", "result": null}`, nil)
+	defer cleanup()
+
+	newSecondary := func() (url.URL, *int32) {
+		var requests int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&requests, 1)
+			req := cltest.ParseJSON(t, r.Body)
+			_, err := w.Write([]byte(`[{"id": ` + req.Get("0.id").String() + `, "jsonrpc": "2.0", "result": null}]`))
+			require.NoError(t, err)
+		}))
+		t.Cleanup(server.Close)
+		return *cltest.MustParseURL(server.URL), &requests
+	}
+	light, lightRequests := newSecondary()
+	heavy, heavyRequests := newSecondary()
+
+	ethClient, err := eth.NewClient(wsUrl, nil, []url.URL{light, heavy})
+	require.NoError(t, err)
+	// The primary is taken out of rotation so that every call is answered
+	require.NoError(t, ethClient.ApplyNodeConfigs([]eth.NodeConfig{
+		{Name: "primary", WSURL: null.StringFrom(wsUrl), Weight: 0},
+		{Name: "light", HTTPURL: null.StringFrom(light.String()), SendOnly: true, Weight: 1},
+		{Name: "heavy", HTTPURL: null.StringFrom(heavy.String()), SendOnly: true, Weight: 3},
+	}))
+	require.NoError(t, ethClient.Dial(context.Background()))
+	defer ethClient.Close()
+
+	for i := 0; i < 100; i++ {
+		var result interface{}
+		require.NoError(t, ethClient.RoundRobinBatchCallContext(context.Background(), []rpc.BatchElem{
+			{Method: "eth_getTransactionByHash", Args: []interface{}{utils.NewHash()}, Result: &result},
+		}))
+	}
+
+	// Nodes are picked at random, so only check that both are used and the
+	// heavier one more often. The distribution is tested in
+	// Test_Client_WeightedRandomIndex.
+	nLight, nHeavy := atomic.LoadInt32(lightRequests), atomic.LoadInt32(heavyRequests)
+	assert.Equal(t, int32(100), nLight+nHeavy)
+	assert.Greater(t, nLight, int32(0))
+	assert.Greater(t, nHeavy, nLight)
+}
+
+func TestEthClient_NodeMaxBatchSize(t *testing.T) {
+	t.Parallel()
+
+	_, wsUrl, cleanup := cltest.NewWSServer(`{"id": 1, "jsonrpc": "2.0", "result": null}`, nil)
+	defer cleanup()
+
+	newBatchServer := func() (url.URL, func() []int) {
+		var mu sync.Mutex
+		var sizes []int
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var reqs []struct {
+				ID json.RawMessage `json:"id"`
+			}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&reqs))
+			mu.Lock()
+			sizes = append(sizes, len(reqs))
+			mu.Unlock()
+			resps := make([]map[string]interface{}, len(reqs))
+			for i, req := range reqs {
+				resps[i] = map[string]interface{}{"id": req.ID, "jsonrpc": "2.0", "result": "0x1"}
+			}
+			require.NoError(t, json.NewEncoder(w).Encode(resps))
+		}))
+		t.Cleanup(server.Close)
+		return *cltest.MustParseURL(server.URL), func() []int {
+			mu.Lock()
+			defer mu.Unlock()
+			return sizes
+		}
+	}
+	strict, strictSizes := newBatchServer()
+	generous, generousSizes := newBatchServer()
+	unlimited, unlimitedSizes := newBatchServer()
+
+	ethClient, err := eth.NewClient(wsUrl, nil, []url.URL{strict, generous, unlimited})
+	require.NoError(t, err)
+	require.NoError(t, ethClient.ApplyNodeConfigs([]eth.NodeConfig{
+		{Name: "strict", HTTPURL: null.StringFrom(strict.String()), SendOnly: true, Weight: 1, MaxBatchSize: null.IntFrom(2)},
+		{Name: "generous", HTTPURL: null.StringFrom(generous.String()), SendOnly: true, Weight: 1, MaxBatchSize: null.IntFrom(3)},
+		{Name: "unlimited", HTTPURL: null.StringFrom(unlimited.String()), SendOnly: true, Weight: 1},
+	}))
+	require.NoError(t, ethClient.Dial(context.Background()))
+	defer ethClient.Close()
+
+	// Each secondary is pinned in turn, so that it gets one call
+	for id := int32(1); id <= 3; id++ {
+		require.NoError(t, ethClient.PinNode(id))
+		b := make([]rpc.BatchElem, 5)
+		for j := range b {
+			b[j] = rpc.BatchElem{Method: "eth_chainId", Result: new(string)}
+		}
+		require.NoError(t, ethClient.RoundRobinBatchCallContext(context.Background(), b))
+		for _, elem := range b {
+			assert.NoError(t, elem.Error)
+			assert.Equal(t, "0x1", *elem.Result.(*string))
+		}
+	}
+
+	assert.Equal(t, []int{2, 2, 1}, strictSizes())
+	assert.Equal(t, []int{3, 2}, generousSizes())
+	assert.Equal(t, []int{5}, unlimitedSizes())
+}
+
+func TestEthClient_LowestLatencyRouting(t *testing.T) {
+	t.Parallel()
+
+	_, wsUrl, cleanup := cltest.NewWSServer(`{"id": 1, "jsonrpc": "2.0", "result": null}`, nil)
+	defer cleanup()
+
+	newSecondary := func(latency time.Duration) (url.URL, *int32) {
+		var requests int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&requests, 1)
+			time.Sleep(latency)
+			req := cltest.ParseJSON(t, r.Body)
+			_, err := w.Write([]byte(`[{"id": ` + req.Get("0.id").String() + `, "jsonrpc": "2.0", "result": null}]`))
+			require.NoError(t, err)
+		}))
+		t.Cleanup(server.Close)
+		return *cltest.MustParseURL(server.URL), &requests
+	}
+	slow, slowRequests := newSecondary(30 * time.Millisecond)
+	fast, fastRequests := newSecondary(0)
+
+	ethClient, err := eth.NewClient(wsUrl, nil, []url.URL{slow, fast})
+	require.NoError(t, err)
+	// The primary's canned response does not match batch calls, so it is
+	// taken out of rotation
+	require.NoError(t, ethClient.ApplyNodeConfigs([]eth.NodeConfig{
+		{Name: "primary", WSURL: null.StringFrom(wsUrl), Weight: 0},
+	}))
+	ethClient.SetLowestLatencyRouting(true)
+	require.NoError(t, ethClient.Dial(context.Background()))
+	defer ethClient.Close()
+
+	_, ok := ethClient.FastestNode()
+	assert.False(t, ok)
+
+	// Calls that explore are picked at random, so enough are made that both
+	// secondaries are almost certainly sampled
+	for i := 0; i < 200; i++ {
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		var result interface{}
+		_ = ethClient.RoundRobinBatchCallContext(ctx, []rpc.BatchElem{
+			{Method: "eth_getTransactionByHash", Args: []interface{}{utils.NewHash()}, Result: &result},
+		})
+		cancel()
+	}
+
+	name, ok := ethClient.FastestNode()
+	require.True(t, ok)
+	assert.Equal(t, "eth-secondary-1", name)
+	// Once both secondaries have been sampled, the fast one is preferred, with
+	// only one call in every ten picked at random
+	assert.Greater(t, atomic.LoadInt32(fastRequests), atomic.LoadInt32(slowRequests))
+	assert.GreaterOrEqual(t, atomic.LoadInt32(slowRequests), int32(1))
+}
@@ -216,6 +216,39 @@ func (err *JsonError) Error() string {
 	return err.Message
 }
 
+// geth and erigon report "execution reverted", parity and openethereum report
+// "VM execution error." or "Reverted"
+var revertedRegex = regexp.MustCompile(`(?i)(^|: )execution reverted|^VM execution error|^Reverted`)
+
+// IsExecutionReverted returns true if err is an eth_call error caused by the
+// EVM reverting, as opposed to a failure to reach the node
+func IsExecutionReverted(err error) bool {
+	if err == nil {
+		return false
+	}
+	if revertedRegex.MatchString(errors.Cause(err).Error()) {
+		return true
+	}
+	_, rerr := ExtractRevertReasonFromRPCError(err)
+	return rerr == nil
+}
+
+// geth reports EVM errors such as "out of gas" or "invalid opcode: opcode 0xfe
+// not defined" as is, parity and openethereum report them as e.g. "Out of gas"
+// or "Bad instruction fe"
+var executionErrorRegex = regexp.MustCompile(`(?i)^(out of gas|invalid opcode|invalid jump destination|stack underflow|stack limit reached|write protection|return data out of bounds|gas uint64 overflow|max code size exceeded|contract creation code storage out of gas|bad instruction|bad jump|mutable call in static context)`)
+
+// IsExecutionError returns true if err is an eth_call error caused by the EVM
+// failing to execute the call, whether by reverting or by an error such as
+// running out of gas. Running the same call against the same state fails the
+// same way, unlike a failure to reach the node.
+func IsExecutionError(err error) bool {
+	if err == nil {
+		return false
+	}
+	return IsExecutionReverted(err) || executionErrorRegex.MatchString(errors.Cause(err).Error())
+}
+
 // ExtractRevertReasonFromRPCError attempts to extract the revert reason from the response of
 // an RPC eth_call that reverted by parsing the message from the "data" field
 // ex:
@@ -308,3 +308,52 @@ func Test_ExtractRevertReasonFromRPCError(t *testing.T) {
 		require.Error(tt, err)
 	})
 }
+
+func Test_IsExecutionReverted(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		err      error
+		reverted bool
+	}{
+		{nil, false},
+		{errors.New("execution reverted"), true},
+		{errors.New("execution reverted: hello world"), true},
+		{errors.Wrap(errors.New("execution reverted: hello world"), "CallContract failed"), true},
+		{errors.New("VM execution error."), true},
+		{errors.New("Reverted"), true},
+		{&eth.JsonError{Code: 3, Data: "0x08c379a0", Message: "something different"}, true},
+		{errors.New("context deadline exceeded"), false},
+		{errors.New("connection refused"), false},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.reverted, eth.IsExecutionReverted(test.err), "%v", test.err)
+	}
+}
+
+func Test_IsExecutionError(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		err    error
+		failed bool
+	}{
+		{nil, false},
+		{errors.New("execution reverted: hello world"), true},
+		{errors.New("out of gas"), true},
+		{errors.Wrap(errors.New("out of gas"), "CallContract failed"), true},
+		{errors.New("invalid opcode: opcode 0xfe not defined"), true},
+		{errors.New("invalid jump destination"), true},
+		{errors.New("Out of gas"), true},
+		{errors.New("Bad instruction fe"), true},
+		{errors.New("context deadline exceeded"), false},
+		{errors.New("connection refused"), false},
+		{errors.New("header not found"), false},
+		{&eth.JsonError{Code: -32000, Message: "missing trie node"}, false},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.failed, eth.IsExecutionError(test.err), "%v", test.err)
+	}
+}
@@ -0,0 +1,9 @@
+package eth
+
+import "github.com/prometheus/client_golang/prometheus/testutil"
+
+// SendOnlyNodeMinAcceptsMissed returns the value of
+// eth_send_only_node_min_accepts_missed_total
+func SendOnlyNodeMinAcceptsMissed() float64 {
+	return testutil.ToFloat64(promSendOnlyNodeMinAcceptsMissed)
+}
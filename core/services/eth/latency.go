@@ -0,0 +1,56 @@
+package eth
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// latencyEWMAWeight is the weight given to each new sample, so that the
+// average follows a node whose response time changes within a few requests
+const latencyEWMAWeight = 0.2
+
+// latencyEWMA tracks an exponentially weighted moving average of a node's
+// response time. A nil latencyEWMA ignores samples.
+type latencyEWMA struct {
+	mu      sync.RWMutex
+	avg     time.Duration
+	sampled bool
+}
+
+// Record adds a sample to the average
+func (l *latencyEWMA) Record(d time.Duration) {
+	if l == nil {
+		return
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if !l.sampled {
+		l.avg = d
+		l.sampled = true
+		return
+	}
+	l.avg = time.Duration(latencyEWMAWeight*float64(d) + (1-latencyEWMAWeight)*float64(l.avg))
+}
+
+// Value returns the average, or false if nothing has been recorded yet
+func (l *latencyEWMA) Value() (time.Duration, bool) {
+	if l == nil {
+		return 0, false
+	}
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	return l.avg, l.sampled
+}
+
+// recordLatency records the response time of a request to a node that began
+// at start. A request that failed before ctx was done counts as taking
+// DefaultQueryTimeout, so that a node returning errors quickly does not look
+// fast.
+func recordLatency(ctx context.Context, l *latencyEWMA, start time.Time, err error) {
+	elapsed := time.Since(start)
+	if err != nil && ctx.Err() == nil && elapsed < DefaultQueryTimeout {
+		elapsed = DefaultQueryTimeout
+	}
+	l.Record(elapsed)
+}
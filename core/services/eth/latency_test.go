@@ -0,0 +1,79 @@
+package eth
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_LatencyEWMA(t *testing.T) {
+	var l *latencyEWMA
+	l.Record(time.Second)
+	_, ok := l.Value()
+	assert.False(t, ok)
+
+	l = new(latencyEWMA)
+	_, ok = l.Value()
+	assert.False(t, ok)
+
+	l.Record(100 * time.Millisecond)
+	avg, ok := l.Value()
+	require.True(t, ok)
+	assert.Equal(t, 100*time.Millisecond, avg)
+
+	l.Record(200 * time.Millisecond)
+	avg, _ = l.Value()
+	assert.Equal(t, 120*time.Millisecond, avg)
+}
+
+func Test_RecordLatency(t *testing.T) {
+	l := new(latencyEWMA)
+	recordLatency(context.Background(), l, time.Now(), errors.New("connection refused"))
+	avg, _ := l.Value()
+	assert.Equal(t, DefaultQueryTimeout, avg)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	l = new(latencyEWMA)
+	recordLatency(ctx, l, time.Now(), ctx.Err())
+	avg, _ = l.Value()
+	assert.Less(t, int64(avg), int64(DefaultQueryTimeout))
+}
+
+func Test_Client_FastestNode(t *testing.T) {
+	c := &client{
+		primary: &node{name: "primary", weight: 1, latency: new(latencyEWMA)},
+		secondaries: []*secondarynode{
+			{name: "slow", weight: 1, latency: new(latencyEWMA)},
+			{name: "fast", weight: 1, latency: new(latencyEWMA)},
+		},
+	}
+
+	_, ok := c.FastestNode()
+	assert.False(t, ok)
+
+	c.primary.latency.Record(50 * time.Millisecond)
+	name, ok := c.FastestNode()
+	require.True(t, ok)
+	assert.Equal(t, "primary", name)
+
+	c.secondaries[0].latency.Record(80 * time.Millisecond)
+	c.secondaries[1].latency.Record(10 * time.Millisecond)
+	name, _ = c.FastestNode()
+	assert.Equal(t, "fast", name)
+
+	// A node taken out of rotation by its circuit breaker is skipped
+	c.SetNodeCircuitBreaker(1, time.Minute)
+	c.secondaries[1].breaker.Record(true)
+	name, _ = c.FastestNode()
+	assert.Equal(t, "primary", name)
+
+	// As is a node with a weight of 0
+	c.primary.weight = 0
+	name, _ = c.FastestNode()
+	assert.Equal(t, "slow", name)
+}
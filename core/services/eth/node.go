@@ -4,7 +4,9 @@ import (
 	"context"
 	"fmt"
 	"math/big"
+	"net/http"
 	"net/url"
+	"strings"
 
 	ethereum "github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
@@ -12,7 +14,19 @@ import (
 	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
 	"github.com/smartcontractkit/chainlink/core/logger"
+	"golang.org/x/time/rate"
+)
+
+var (
+	promRateLimitedRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "eth_node_rate_limited_requests_total",
+		Help: "The total number of requests rejected by an eth node with HTTP 429 Too Many Requests",
+	},
+		[]string{"nodeName"},
+	)
 )
 
 type rawclient struct {
@@ -24,18 +38,40 @@ type rawclient struct {
 // node represents one ethereum node.
 // It must have a ws url and may have a http url
 type node struct {
-	ws     rawclient
-	http   *rawclient
-	log    *logger.Logger
-	dialed bool
+	ws      rawclient
+	http    *rawclient
+	log     *logger.Logger
+	name    string
+	limiter *rate.Limiter
+	dialed  bool
+	// weight is the node's share of rotated traffic relative to the other
+	// nodes. A weight of 0 takes it out of rotation, although it can still be
+	// pinned.
+	weight int
+	// tags are free-form labels such as provider=infura or tier=premium, for
+	// cost attribution. They are added to the node's logs and do not affect
+	// node selection.
+	tags map[string]string
+	// headers are added to every request sent over http, so that providers
+	// requiring an API key in a header don't need it in the URL
+	headers map[string]string
+	// latency is the average response time of round-robin batch calls
+	latency *latencyEWMA
+	// maxBatchSize is the most requests the node's provider accepts in one
+	// batch, or 0 if it accepts the chain's EvmRPCDefaultBatchSize
+	maxBatchSize uint32
 }
 
-func newNode(wsuri url.URL, httpuri *url.URL, name string) (n *node) {
+func newNode(wsuri url.URL, httpuri *url.URL, name string, limiter *rate.Limiter) (n *node) {
 	n = new(node)
 	n.log = logger.CreateLogger(logger.Default.With(
 		"nodeName", name,
 		"nodeTier", "primary",
 	))
+	n.name = name
+	n.limiter = limiter
+	n.weight = 1
+	n.latency = new(latencyEWMA)
 	n.ws.uri = wsuri
 	if httpuri != nil {
 		n.http = &rawclient{uri: *httpuri}
@@ -43,6 +79,90 @@ func newNode(wsuri url.URL, httpuri *url.URL, name string) (n *node) {
 	return
 }
 
+// setTags sets the node's tags and adds them to its log output so that
+// requests can be attributed to a provider
+func (n *node) setTags(tags map[string]string) {
+	n.tags = tags
+	if len(tags) > 0 {
+		n.log = logger.CreateLogger(n.log.With("nodeTags", tags))
+	}
+}
+
+// setHeaders sets the headers sent with the node's http requests. It must be
+// called before Dial.
+func (n *node) setHeaders(headers map[string]string) error {
+	if err := validateHeaders(headers); err != nil {
+		return errors.Wrapf(err, "invalid headers for node %s", n.name)
+	}
+	n.headers = headers
+	return nil
+}
+
+// applyConfig applies the settings stored for the node in the nodes table
+func (n *node) applyConfig(cfg NodeConfig) error {
+	n.weight = cfg.Weight
+	n.setTags(cfg.Tags)
+	n.setMaxBatchSize(uint32(cfg.MaxBatchSize.Int64))
+	return n.setHeaders(cfg.Headers)
+}
+
+// setMaxBatchSize caps the number of requests sent to the node in one batch.
+// Larger batches are split. 0 removes the cap.
+func (n *node) setMaxBatchSize(size uint32) {
+	n.maxBatchSize = size
+}
+
+// batchInChunks sends b in consecutive batches of at most size requests,
+// stopping at the first error. Results are written to b's elements as usual.
+// A size of 0 sends b as a single batch.
+func batchInChunks(b []rpc.BatchElem, size uint32, send func([]rpc.BatchElem) error) error {
+	if size == 0 || len(b) <= int(size) {
+		return send(b)
+	}
+	for i := 0; i < len(b); i += int(size) {
+		end := i + int(size)
+		if end > len(b) {
+			end = len(b)
+		}
+		if err := send(b[i:end]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateHeaders checks that every header name is a valid HTTP token
+func validateHeaders(headers map[string]string) error {
+	for name := range headers {
+		if !isHTTPToken(name) {
+			return errors.Errorf("%q is not a valid HTTP header name", name)
+		}
+	}
+	return nil
+}
+
+// isHTTPToken reports whether s is a token as defined by RFC 7230
+func isHTTPToken(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, r := range s {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+		case strings.ContainsRune("!#$%&'*+-.^_`|~", r):
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+func setRPCHeaders(c *rpc.Client, headers map[string]string) {
+	for name, value := range headers {
+		c.SetHeader(name, value)
+	}
+}
+
 func (n *node) Dial(ctx context.Context) error {
 	if n.dialed {
 		panic("eth.Client.Dial(...) should only be called once during the node's lifetime.")
@@ -73,6 +193,7 @@ func (n *node) Dial(ctx context.Context) error {
 		if err != nil {
 			return err
 		}
+		setRPCHeaders(rpc, n.headers)
 		n.http.rpc = rpc
 		n.http.geth = ethclient.NewClient(rpc)
 	}
@@ -88,6 +209,9 @@ func (n node) CallContext(ctx context.Context, result interface{}, method string
 		"args", args,
 		"mode", switching(n),
 	)
+	if err := n.wait(ctx); err != nil {
+		return err
+	}
 	if n.http != nil {
 		return n.wrapHTTP(n.http.rpc.CallContext(ctx, result, method, args...))
 	}
@@ -99,14 +223,22 @@ func (n node) BatchCallContext(ctx context.Context, b []rpc.BatchElem) error {
 		"nBatchElems", len(b),
 		"mode", switching(n),
 	)
-	if n.http != nil {
-		return n.wrapHTTP(n.http.rpc.BatchCallContext(ctx, b))
-	}
-	return n.wrapWS(n.ws.rpc.BatchCallContext(ctx, b))
+	return batchInChunks(b, n.maxBatchSize, func(chunk []rpc.BatchElem) error {
+		if err := n.wait(ctx); err != nil {
+			return err
+		}
+		if n.http != nil {
+			return n.wrapHTTP(n.http.rpc.BatchCallContext(ctx, chunk))
+		}
+		return n.wrapWS(n.ws.rpc.BatchCallContext(ctx, chunk))
+	})
 }
 
 func (n node) EthSubscribe(ctx context.Context, channel interface{}, args ...interface{}) (ethereum.Subscription, error) {
 	n.log.Debugw("eth.Client#EthSubscribe", "mode", "websocket")
+	if err := n.wait(ctx); err != nil {
+		return nil, err
+	}
 	return n.ws.rpc.EthSubscribe(ctx, channel, args...)
 }
 
@@ -121,6 +253,9 @@ func (n node) TransactionReceipt(ctx context.Context, txHash common.Hash) (recei
 		"txHash", txHash,
 		"mode", switching(n),
 	)
+	if err = n.wait(ctx); err != nil {
+		return
+	}
 
 	if n.http != nil {
 		receipt, err = n.http.geth.TransactionReceipt(ctx, txHash)
@@ -136,6 +271,9 @@ func (n node) TransactionReceipt(ctx context.Context, txHash common.Hash) (recei
 // NOTE: ChainID may need a bit of rethinking if we implement multiple clients since in theory they could have different ChainIDs
 func (n node) ChainID(ctx context.Context) (chainID *big.Int, err error) {
 	n.log.Debugw("eth.Client#ChainID(...)", "mode", "websocket")
+	if err = n.wait(ctx); err != nil {
+		return
+	}
 	chainID, err = n.ws.geth.ChainID(ctx)
 	err = n.wrapWS(err)
 	return
@@ -146,6 +284,9 @@ func (n node) HeaderByNumber(ctx context.Context, number *big.Int) (header *type
 		"number", n,
 		"mode", switching(n),
 	)
+	if err = n.wait(ctx); err != nil {
+		return
+	}
 	if n.http != nil {
 		header, err = n.http.geth.HeaderByNumber(ctx, number)
 		err = n.wrapHTTP(err)
@@ -161,6 +302,9 @@ func (n node) SendTransaction(ctx context.Context, tx *types.Transaction) error
 		"tx", tx,
 		"mode", switching(n),
 	)
+	if err := n.wait(ctx); err != nil {
+		return err
+	}
 	if n.http != nil {
 		return n.wrapHTTP(n.http.geth.SendTransaction(ctx, tx))
 	}
@@ -172,6 +316,9 @@ func (n node) PendingNonceAt(ctx context.Context, account common.Address) (nonce
 		"account", account,
 		"mode", switching(n),
 	)
+	if err = n.wait(ctx); err != nil {
+		return
+	}
 	if n.http != nil {
 		nonce, err = n.http.geth.PendingNonceAt(ctx, account)
 		err = n.wrapHTTP(err)
@@ -188,6 +335,9 @@ func (n node) NonceAt(ctx context.Context, account common.Address, blockNumber *
 		"blockNumber", blockNumber,
 		"mode", switching(n),
 	)
+	if err = n.wait(ctx); err != nil {
+		return
+	}
 	if n.http != nil {
 		nonce, err = n.http.geth.NonceAt(ctx, account, blockNumber)
 		err = n.wrapHTTP(err)
@@ -203,6 +353,9 @@ func (n node) PendingCodeAt(ctx context.Context, account common.Address) (code [
 		"account", account,
 		"mode", switching(n),
 	)
+	if err = n.wait(ctx); err != nil {
+		return
+	}
 	if n.http != nil {
 		code, err = n.http.geth.PendingCodeAt(ctx, account)
 		err = n.wrapHTTP(err)
@@ -219,6 +372,9 @@ func (n node) CodeAt(ctx context.Context, account common.Address, blockNumber *b
 		"blockNumber", blockNumber,
 		"mode", switching(n),
 	)
+	if err = n.wait(ctx); err != nil {
+		return
+	}
 	if n.http != nil {
 		code, err = n.http.geth.CodeAt(ctx, account, blockNumber)
 		err = n.wrapHTTP(err)
@@ -234,6 +390,9 @@ func (n node) EstimateGas(ctx context.Context, call ethereum.CallMsg) (gas uint6
 		"call", call,
 		"mode", switching(n),
 	)
+	if err = n.wait(ctx); err != nil {
+		return
+	}
 	if n.http != nil {
 		gas, err = n.http.geth.EstimateGas(ctx, call)
 		err = n.wrapHTTP(err)
@@ -246,6 +405,9 @@ func (n node) EstimateGas(ctx context.Context, call ethereum.CallMsg) (gas uint6
 
 func (n node) SuggestGasPrice(ctx context.Context) (price *big.Int, err error) {
 	n.log.Debugw("eth.Client#SuggestGasPrice()", "mode", "websocket")
+	if err = n.wait(ctx); err != nil {
+		return
+	}
 	price, err = n.ws.geth.SuggestGasPrice(ctx)
 	err = n.wrapWS(err)
 	return
@@ -255,6 +417,9 @@ func (n node) CallContract(ctx context.Context, msg ethereum.CallMsg, blockNumbe
 	n.log.Debugw("eth.Client#CallContract()",
 		"mode", switching(n),
 	)
+	if err = n.wait(ctx); err != nil {
+		return
+	}
 	if n.http != nil {
 		val, err = n.http.geth.CallContract(ctx, msg, blockNumber)
 		err = n.wrapHTTP(err)
@@ -271,6 +436,9 @@ func (n node) BlockByNumber(ctx context.Context, number *big.Int) (b *types.Bloc
 		"number", number,
 		"mode", switching(n),
 	)
+	if err = n.wait(ctx); err != nil {
+		return
+	}
 	if n.http != nil {
 		b, err = n.http.geth.BlockByNumber(ctx, number)
 		err = n.wrapHTTP(err)
@@ -287,6 +455,9 @@ func (n node) BalanceAt(ctx context.Context, account common.Address, blockNumber
 		"blockNumber", blockNumber,
 		"mode", switching(n),
 	)
+	if err = n.wait(ctx); err != nil {
+		return
+	}
 	if n.http != nil {
 		balance, err = n.http.geth.BalanceAt(ctx, account, blockNumber)
 		err = n.wrapHTTP(err)
@@ -302,6 +473,9 @@ func (n node) FilterLogs(ctx context.Context, q ethereum.FilterQuery) (l []types
 		"q", q,
 		"mode", switching(n),
 	)
+	if err = n.wait(ctx); err != nil {
+		return
+	}
 	if n.http != nil {
 		l, err = n.http.geth.FilterLogs(ctx, q)
 		err = n.wrapHTTP(err)
@@ -314,6 +488,9 @@ func (n node) FilterLogs(ctx context.Context, q ethereum.FilterQuery) (l []types
 
 func (n node) SubscribeFilterLogs(ctx context.Context, q ethereum.FilterQuery, ch chan<- types.Log) (sub ethereum.Subscription, err error) {
 	n.log.Debugw("eth.Client#SubscribeFilterLogs(...)", "q", q, "mode", "websocket")
+	if err = n.wait(ctx); err != nil {
+		return
+	}
 	sub, err = n.ws.geth.SubscribeFilterLogs(ctx, q, ch)
 	err = n.wrapWS(err)
 	return
@@ -323,6 +500,9 @@ func (n node) SuggestGasTipCap(ctx context.Context) (tipCap *big.Int, err error)
 	n.log.Debugw("eth.Client#SuggestGasTipCap(...)",
 		"mode", switching(n),
 	)
+	if err = n.wait(ctx); err != nil {
+		return
+	}
 	if n.http != nil {
 		tipCap, err = n.http.geth.SuggestGasTipCap(ctx)
 		err = n.wrapHTTP(err)
@@ -333,14 +513,41 @@ func (n node) SuggestGasTipCap(ctx context.Context) (tipCap *big.Int, err error)
 	return
 }
 
+// wait blocks until the node's rate limiter permits another request, if rate
+// limiting is enabled
+func (n node) wait(ctx context.Context) error {
+	if n.limiter == nil {
+		return nil
+	}
+	return n.limiter.Wait(ctx)
+}
+
 func (n node) wrapWS(err error) error {
+	countRateLimited(err, n.name)
 	return wrap(err, fmt.Sprintf("primary websocket (%s)", n.ws.uri.String()))
 }
 
 func (n node) wrapHTTP(err error) error {
+	countRateLimited(err, n.name)
 	return wrap(err, fmt.Sprintf("primary http (%s)", n.http.uri.String()))
 }
 
+// newLimiter returns a rate limiter allowing rps requests per second with the
+// given burst, or nil if rps is 0 i.e. unlimited
+func newLimiter(rps float64, burst int) *rate.Limiter {
+	if rps <= 0 {
+		return nil
+	}
+	return rate.NewLimiter(rate.Limit(rps), burst)
+}
+
+func countRateLimited(err error, nodeName string) {
+	var httpErr rpc.HTTPError
+	if errors.As(err, &httpErr) && httpErr.StatusCode == http.StatusTooManyRequests {
+		promRateLimitedRequests.WithLabelValues(nodeName).Inc()
+	}
+}
+
 func wrap(err error, tp string) error {
 	if err == nil {
 		return nil
@@ -2,9 +2,17 @@ package eth
 
 import (
 	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"testing"
+	"time"
 
+	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/time/rate"
+	"gopkg.in/guregu/null.v4"
 )
 
 func Test_NodeWrapError(t *testing.T) {
@@ -24,3 +32,125 @@ func Test_NodeWrapError(t *testing.T) {
 		assert.EqualError(t, err, "foo call failed: remote eth node timed out: context deadline exceeded")
 	})
 }
+
+func Test_Client_WeightedRandomIndex(t *testing.T) {
+	newClient := func(weights ...int) *client {
+		c := &client{primary: newNode(url.URL{}, nil, "primary", nil)}
+		c.primary.weight = weights[0]
+		for _, w := range weights[1:] {
+			s := newSecondaryNode(url.URL{}, "secondary", nil)
+			s.weight = w
+			c.secondaries = append(c.secondaries, s)
+		}
+		return c
+	}
+	countIndexes := func(c *client, n int) map[int]int {
+		counts := make(map[int]int)
+		for i := 0; i < n; i++ {
+			counts[c.weightedRandomIndex()]++
+		}
+		return counts
+	}
+
+	t.Run("picks nodes in proportion to their weight", func(t *testing.T) {
+		const n = 80000
+		c := newClient(1, 3, 0, 4)
+		counts := countIndexes(c, n)
+		require.Len(t, counts, 3)
+		assert.NotContains(t, counts, 2)
+		// With 80000 picks the standard deviation of each share is below
+		// 0.002, so these bounds are well beyond chance
+		assert.InDelta(t, 1.0/8, float64(counts[0])/n, 0.01)
+		assert.InDelta(t, 3.0/8, float64(counts[1])/n, 0.01)
+		assert.InDelta(t, 4.0/8, float64(counts[3])/n, 0.01)
+	})
+
+	t.Run("is close to uniform with default weights", func(t *testing.T) {
+		const n = 30000
+		counts := countIndexes(newClient(1, 1, 1), n)
+		for id := 0; id < 3; id++ {
+			assert.InDelta(t, 1.0/3, float64(counts[id])/n, 0.01)
+		}
+	})
+
+	t.Run("skips secondaries whose circuit breaker is open", func(t *testing.T) {
+		c := newClient(1, 1, 1)
+		c.SetNodeCircuitBreaker(1, time.Minute)
+		now := time.Unix(0, 0)
+		s := c.secondaries[0]
+		s.breaker.now = func() time.Time { return now }
+		require.True(t, s.breaker.Record(true))
+
+		counts := countIndexes(c, 1000)
+		assert.NotContains(t, counts, 1)
+		assert.Greater(t, counts[0], 0)
+		assert.Greater(t, counts[2], 0)
+
+		// Once the cooldown has passed it is picked again, to probe it
+		now = now.Add(time.Minute)
+		assert.Greater(t, countIndexes(c, 1000)[1], 0)
+	})
+
+	t.Run("uses the primary if every weight is 0", func(t *testing.T) {
+		c := newClient(0, 0)
+		assert.Equal(t, map[int]int{0: 10}, countIndexes(c, 10))
+	})
+}
+
+func Test_Client_ApplyNodeConfigs_Tags(t *testing.T) {
+	c, err := NewClient("ws://example.com", nil, []url.URL{{Scheme: "http", Host: "example.com", Path: "/a"}, {Scheme: "http", Host: "example.com", Path: "/b"}})
+	require.NoError(t, err)
+
+	require.NoError(t, c.ApplyNodeConfigs([]NodeConfig{
+		{Name: "primary", WSURL: null.StringFrom("ws://example.com"), Weight: 1, Tags: map[string]string{"provider": "infura", "tier": "premium"}},
+		{Name: "b", HTTPURL: null.StringFrom("http://example.com/b"), SendOnly: true, Weight: 1, Tags: map[string]string{"provider": "alchemy"}},
+	}))
+	assert.Equal(t, map[string]string{"provider": "infura", "tier": "premium"}, c.primary.tags)
+	assert.Empty(t, c.secondaries[0].tags)
+	assert.Equal(t, map[string]string{"provider": "alchemy"}, c.secondaries[1].tags)
+}
+
+func Test_Client_SetNodeRateLimit(t *testing.T) {
+	c := &client{
+		primary:     newNode(url.URL{}, nil, "primary", nil),
+		secondaries: []*secondarynode{newSecondaryNode(url.URL{}, "secondary", nil)},
+	}
+
+	c.SetNodeRateLimit(2, 3)
+	for _, l := range []*rate.Limiter{c.primary.limiter, c.secondaries[0].limiter} {
+		require.NotNil(t, l)
+		assert.Equal(t, rate.Limit(2), l.Limit())
+		assert.Equal(t, 3, l.Burst())
+	}
+
+	c.SetNodeRateLimit(0, 0)
+	assert.Nil(t, c.primary.limiter)
+	assert.Nil(t, c.secondaries[0].limiter)
+}
+
+func Test_NodeHeaders(t *testing.T) {
+	t.Run("rejects invalid header names", func(t *testing.T) {
+		n := newNode(url.URL{}, nil, "foo", nil)
+		assert.EqualError(t, n.setHeaders(map[string]string{"X-Api Key": "secret"}), `invalid headers for node foo: "X-Api Key" is not a valid HTTP header name`)
+		s := newSecondaryNode(url.URL{}, "bar", nil)
+		assert.EqualError(t, s.setHeaders(map[string]string{"": "secret"}), `invalid headers for node bar: "" is not a valid HTTP header name`)
+	})
+
+	t.Run("sends headers with http requests", func(t *testing.T) {
+		got := make(chan string, 1)
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			got <- r.Header.Get("X-Api-Key")
+			_, err := w.Write([]byte(`[{"id": 1, "jsonrpc": "2.0", "result": "0x1"}]`))
+			require.NoError(t, err)
+		}))
+		defer server.Close()
+		u, err := url.Parse(server.URL)
+		require.NoError(t, err)
+
+		s := newSecondaryNode(*u, "foo", nil)
+		require.NoError(t, s.setHeaders(map[string]string{"X-Api-Key": "secret"}))
+		require.NoError(t, s.Dial())
+		require.NoError(t, s.BatchCallContext(context.Background(), []rpc.BatchElem{{Method: "eth_chainId", Result: new(string)}}))
+		assert.Equal(t, "secret", <-got)
+	})
+}
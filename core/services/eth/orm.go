@@ -0,0 +1,75 @@
+package eth
+
+import (
+	"encoding/json"
+	"math/big"
+
+	"github.com/pkg/errors"
+	"github.com/smartcontractkit/chainlink/core/utils"
+	"gopkg.in/guregu/null.v4"
+	"gorm.io/gorm"
+)
+
+type ORM struct {
+	db *gorm.DB
+}
+
+func NewORM(db *gorm.DB) *ORM {
+	return &ORM{db}
+}
+
+// NodeConfig holds the settings stored for a node in the nodes table
+type NodeConfig struct {
+	Name     string
+	WSURL    null.String
+	HTTPURL  null.String
+	SendOnly bool
+	// Weight is the node's share of rotated traffic, see ApplyNodeConfigs
+	Weight int
+	// Tags label the node, e.g. provider=infura, and are added to its logs
+	Tags    map[string]string
+	Headers map[string]string
+	// MaxBatchSize caps the requests sent to the node in one batch, or is
+	// null to use the chain's EvmRPCDefaultBatchSize
+	MaxBatchSize null.Int
+}
+
+// nodeRow is a row of the nodes table as scanned from the DB
+type nodeRow struct {
+	Name         string
+	WSURL        null.String `gorm:"column:ws_url"`
+	HTTPURL      null.String `gorm:"column:http_url"`
+	SendOnly     bool
+	Weight       int
+	Tags         []byte
+	Headers      []byte
+	MaxBatchSize null.Int
+}
+
+// NodeConfigs returns the settings stored for each of the chain's nodes,
+// ordered by ID
+func (orm *ORM) NodeConfigs(chainID *big.Int) ([]NodeConfig, error) {
+	var rows []nodeRow
+	err := orm.db.Raw(`SELECT name, ws_url, http_url, send_only, weight, tags, headers, max_batch_size FROM nodes WHERE evm_chain_id = ? ORDER BY id ASC`, utils.NewBig(chainID)).Scan(&rows).Error
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to load nodes for chain %s", chainID)
+	}
+	configs := make([]NodeConfig, len(rows))
+	for i, row := range rows {
+		configs[i] = NodeConfig{
+			Name:         row.Name,
+			WSURL:        row.WSURL,
+			HTTPURL:      row.HTTPURL,
+			SendOnly:     row.SendOnly,
+			Weight:       row.Weight,
+			MaxBatchSize: row.MaxBatchSize,
+		}
+		if err := json.Unmarshal(row.Tags, &configs[i].Tags); err != nil {
+			return nil, errors.Wrapf(err, "invalid tags for node %s", row.Name)
+		}
+		if err := json.Unmarshal(row.Headers, &configs[i].Headers); err != nil {
+			return nil, errors.Wrapf(err, "invalid headers for node %s", row.Name)
+		}
+	}
+	return configs, nil
+}
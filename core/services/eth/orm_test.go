@@ -0,0 +1,52 @@
+package eth_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/core/services/eth"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+)
+
+func TestORM_NodeConfigs(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewGormDB(t)
+	orm := eth.NewORM(db)
+
+	require.NoError(t, db.Exec(`INSERT INTO evm_chains (id, created_at, updated_at) VALUES (4242, NOW(), NOW()), (4343, NOW(), NOW())`).Error)
+	require.NoError(t, db.Exec(`INSERT INTO nodes (name, evm_chain_id, ws_url, http_url, send_only, created_at, updated_at) VALUES
+	('primary', 4242, 'ws://example.com', 'http://example.com', false, NOW(), NOW()),
+	('other-chain', 4343, 'ws://example.org', NULL, false, NOW(), NOW())`).Error)
+	require.NoError(t, db.Exec(`INSERT INTO nodes (name, evm_chain_id, http_url, send_only, weight, tags, headers, max_batch_size, created_at, updated_at) VALUES
+	('send-only', 4242, 'http://example.net', true, 3, '{"provider": "infura"}', '{"X-Api-Key": "secret"}', 100, NOW(), NOW())`).Error)
+
+	configs, err := orm.NodeConfigs(big.NewInt(4242))
+	require.NoError(t, err)
+	assert.Equal(t, []eth.NodeConfig{
+		{
+			Name:    "primary",
+			WSURL:   null.StringFrom("ws://example.com"),
+			HTTPURL: null.StringFrom("http://example.com"),
+			Weight:  1,
+			Tags:    map[string]string{},
+			Headers: map[string]string{},
+		},
+		{
+			Name:         "send-only",
+			HTTPURL:      null.StringFrom("http://example.net"),
+			SendOnly:     true,
+			Weight:       3,
+			Tags:         map[string]string{"provider": "infura"},
+			Headers:      map[string]string{"X-Api-Key": "secret"},
+			MaxBatchSize: null.IntFrom(100),
+		},
+	}, configs)
+
+	configs, err = orm.NodeConfigs(big.NewInt(1337))
+	require.NoError(t, err)
+	assert.Empty(t, configs)
+}
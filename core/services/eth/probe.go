@@ -0,0 +1,45 @@
+package eth
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+)
+
+// NodeProbeResult describes an eth node as reported by the node itself
+type NodeProbeResult struct {
+	ChainID           *big.Int    `json:"chainID"`
+	ClientVersion     string      `json:"clientVersion"`
+	LatestBlockNumber int64       `json:"latestBlockNumber"`
+	LatestBlockHash   common.Hash `json:"latestBlockHash"`
+}
+
+// ProbeNode dials c and fetches its chain ID, client version and latest
+// block, so that a node can be checked before it is configured. The client
+// is closed before returning.
+func ProbeNode(ctx context.Context, c Client) (result NodeProbeResult, err error) {
+	if err = c.Dial(ctx); err != nil {
+		return result, errors.Wrap(err, "failed to dial node")
+	}
+	defer c.Close()
+
+	result.ChainID, err = c.ChainID(ctx)
+	if err != nil {
+		return result, errors.Wrap(err, "failed to fetch chain ID")
+	}
+	if err = c.CallContext(ctx, &result.ClientVersion, "web3_clientVersion"); err != nil {
+		return result, errors.Wrap(err, "failed to fetch client version")
+	}
+	head, err := c.HeadByNumber(ctx, nil)
+	if err != nil {
+		return result, errors.Wrap(err, "failed to fetch latest block")
+	}
+	if head == nil {
+		return result, errors.New("node returned no latest block")
+	}
+	result.LatestBlockNumber = head.Number
+	result.LatestBlockHash = head.Hash
+	return result, nil
+}
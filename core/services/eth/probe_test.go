@@ -0,0 +1,65 @@
+package eth_test
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/smartcontractkit/chainlink/core/services/eth"
+	"github.com/smartcontractkit/chainlink/core/services/eth/mocks"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProbeNode(t *testing.T) {
+	t.Parallel()
+
+	t.Run("returns what the node reports", func(t *testing.T) {
+		c := new(mocks.Client)
+		hash := common.HexToHash("0x1234")
+		c.On("Dial", mock.Anything).Return(nil)
+		c.On("ChainID", mock.Anything).Return(big.NewInt(137), nil)
+		c.On("CallContext", mock.Anything, mock.Anything, "web3_clientVersion").Run(func(args mock.Arguments) {
+			*args.Get(1).(*string) = "bor/v0.2.9"
+		}).Return(nil)
+		c.On("HeadByNumber", mock.Anything, (*big.Int)(nil)).Return(&models.Head{Number: 42, Hash: hash}, nil)
+		c.On("Close").Return()
+
+		result, err := eth.ProbeNode(context.Background(), c)
+		require.NoError(t, err)
+		assert.Equal(t, big.NewInt(137), result.ChainID)
+		assert.Equal(t, "bor/v0.2.9", result.ClientVersion)
+		assert.Equal(t, int64(42), result.LatestBlockNumber)
+		assert.Equal(t, hash, result.LatestBlockHash)
+
+		c.AssertExpectations(t)
+	})
+
+	t.Run("fails if the node cannot be dialed", func(t *testing.T) {
+		c := new(mocks.Client)
+		c.On("Dial", mock.Anything).Return(errors.New("connection refused"))
+
+		_, err := eth.ProbeNode(context.Background(), c)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to dial node: connection refused")
+
+		c.AssertExpectations(t)
+	})
+
+	t.Run("closes the client if a call fails", func(t *testing.T) {
+		c := new(mocks.Client)
+		c.On("Dial", mock.Anything).Return(nil)
+		c.On("ChainID", mock.Anything).Return(nil, errors.New("boom"))
+		c.On("Close").Return()
+
+		_, err := eth.ProbeNode(context.Background(), c)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to fetch chain ID: boom")
+
+		c.AssertExpectations(t)
+	})
+}
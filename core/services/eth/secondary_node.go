@@ -3,35 +3,99 @@ package eth
 import (
 	"context"
 	"fmt"
+	"math/big"
 	"net/url"
 
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
 	"github.com/smartcontractkit/chainlink/core/logger"
+	"golang.org/x/time/rate"
+)
+
+var (
+	promSecondaryNodeBroadcasts = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "eth_secondary_node_broadcasts_total",
+		Help: "The total number of transactions broadcast to a secondary (send-only) eth node, by whether the node accepted them",
+	},
+		[]string{"nodeName", "result"},
+	)
+	promSendOnlyNodeMinAcceptsMissed = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "eth_send_only_node_min_accepts_missed_total",
+		Help: "The total number of transactions accepted by the primary eth node but by fewer secondary (send-only) nodes than ETH_SEND_ONLY_NODE_MIN_ACCEPTS, or than were reachable if fewer",
+	})
 )
 
 // secondarynode represents one ethereum node used as a secondary
 // It only supports sending transactions
 // It must a http(s) url
 type secondarynode struct {
-	uri    url.URL
-	rpc    *rpc.Client
-	geth   *ethclient.Client
-	log    *logger.Logger
-	dialed bool
+	uri     url.URL
+	rpc     *rpc.Client
+	geth    *ethclient.Client
+	log     *logger.Logger
+	name    string
+	limiter *rate.Limiter
+	dialed  bool
+	breaker *circuitBreaker
+	headers map[string]string
+	latency *latencyEWMA
+	// weight and tags are as for node
+	weight int
+	tags   map[string]string
+	// maxBatchSize is as for node
+	maxBatchSize uint32
 }
 
-func newSecondaryNode(httpuri url.URL, name string) (s *secondarynode) {
+func newSecondaryNode(httpuri url.URL, name string, limiter *rate.Limiter) (s *secondarynode) {
 	s = new(secondarynode)
 	s.log = logger.CreateLogger(logger.Default.With(
 		"nodeName", name,
 		"nodeTier", "secondary",
 	))
+	s.name = name
+	s.limiter = limiter
+	s.weight = 1
+	s.latency = new(latencyEWMA)
 	s.uri = httpuri
 	return
 }
 
+// setTags sets the node's tags and adds them to its log output
+func (s *secondarynode) setTags(tags map[string]string) {
+	s.tags = tags
+	if len(tags) > 0 {
+		s.log = logger.CreateLogger(s.log.With("nodeTags", tags))
+	}
+}
+
+// setHeaders sets the headers sent with the node's requests. It must be called
+// before Dial.
+func (s *secondarynode) setHeaders(headers map[string]string) error {
+	if err := validateHeaders(headers); err != nil {
+		return errors.Wrapf(err, "invalid headers for node %s", s.name)
+	}
+	s.headers = headers
+	return nil
+}
+
+// applyConfig applies the settings stored for the node in the nodes table
+func (s *secondarynode) applyConfig(cfg NodeConfig) error {
+	s.weight = cfg.Weight
+	s.setTags(cfg.Tags)
+	s.setMaxBatchSize(uint32(cfg.MaxBatchSize.Int64))
+	return s.setHeaders(cfg.Headers)
+}
+
+// setMaxBatchSize caps the number of requests sent to the node in one batch.
+// Larger batches are split. 0 removes the cap.
+func (s *secondarynode) setMaxBatchSize(size uint32) {
+	s.maxBatchSize = size
+}
+
 func (s *secondarynode) Dial() error {
 	s.log.Debugw("eth.Client#Dial(...)")
 	if s.dialed {
@@ -43,6 +107,7 @@ func (s *secondarynode) Dial() error {
 	if err != nil {
 		return err
 	}
+	setRPCHeaders(rpc, s.headers)
 	s.dialed = true
 	s.rpc = rpc
 	s.geth = ethclient.NewClient(rpc)
@@ -53,6 +118,9 @@ func (s secondarynode) SendTransaction(ctx context.Context, tx *types.Transactio
 	s.log.Debugw("eth.Client#SendTransaction(...)",
 		"tx", tx,
 	)
+	if err := s.wait(ctx); err != nil {
+		return err
+	}
 	return s.wrap(s.geth.SendTransaction(ctx, tx))
 }
 
@@ -60,9 +128,42 @@ func (s secondarynode) BatchCallContext(ctx context.Context, b []rpc.BatchElem)
 	s.log.Debugw("eth.Client#BatchCall(...)",
 		"nBatchElems", len(b),
 	)
-	return s.wrap(s.rpc.BatchCallContext(ctx, b))
+	return batchInChunks(b, s.maxBatchSize, func(chunk []rpc.BatchElem) error {
+		if err := s.wait(ctx); err != nil {
+			return err
+		}
+		return s.wrap(s.rpc.BatchCallContext(ctx, chunk))
+	})
+}
+
+// NetVersion returns the network ID reported by the node's net_version
+// method, which for EVM chains is the chain ID
+func (s secondarynode) NetVersion(ctx context.Context) (*big.Int, error) {
+	s.log.Debugw("eth.Client#NetVersion(...)")
+	if err := s.wait(ctx); err != nil {
+		return nil, err
+	}
+	var version string
+	if err := s.rpc.CallContext(ctx, &version, "net_version"); err != nil {
+		return nil, s.wrap(err)
+	}
+	id, ok := new(big.Int).SetString(version, 10)
+	if !ok {
+		return nil, errors.Errorf("secondary http (%s) returned invalid net_version: %q", s.uri.String(), version)
+	}
+	return id, nil
+}
+
+// wait blocks until the node's rate limiter permits another request, if rate
+// limiting is enabled
+func (s secondarynode) wait(ctx context.Context) error {
+	if s.limiter == nil {
+		return nil
+	}
+	return s.limiter.Wait(ctx)
 }
 
 func (s secondarynode) wrap(err error) error {
+	countRateLimited(err, s.name)
 	return wrap(err, fmt.Sprintf("secondary http (%s)", s.uri.String()))
 }
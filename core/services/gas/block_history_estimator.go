@@ -6,11 +6,13 @@ import (
 	"math/big"
 	"sort"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/pkg/errors"
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/services/eth"
+	"github.com/smartcontractkit/chainlink/core/store/config"
 	"github.com/smartcontractkit/chainlink/core/store/models"
 	"github.com/smartcontractkit/chainlink/core/utils"
 
@@ -64,6 +66,8 @@ type (
 		gasPriceMu sync.RWMutex
 
 		logger *logger.Logger
+
+		warm int32
 	}
 )
 
@@ -84,6 +88,7 @@ func NewBlockHistoryEstimator(ethClient eth.Client, config Config) Estimator {
 		nil,
 		sync.RWMutex{},
 		logger.CreateLogger(logger.Default.With("id", "block_history_estimator")),
+		0,
 	}
 
 	return b
@@ -142,6 +147,12 @@ func (b *BlockHistoryEstimator) EstimateGas(_ []byte, gasLimit uint64, _ ...Opt)
 	return
 }
 
+// Warm returns true once the block history has been filled to
+// GAS_UPDATER_BLOCK_HISTORY_SIZE blocks. It stays true after that.
+func (b *BlockHistoryEstimator) Warm() bool {
+	return atomic.LoadInt32(&b.warm) == 1
+}
+
 func (b *BlockHistoryEstimator) BumpGas(originalGasPrice *big.Int, gasLimit uint64) (bumpedGasPrice *big.Int, chainSpecificGasLimit uint64, err error) {
 	return BumpGasPriceOnly(b.config, originalGasPrice, gasLimit)
 }
@@ -307,6 +318,9 @@ func (b *BlockHistoryEstimator) FetchBlocks(ctx context.Context, head models.Hea
 	}
 
 	b.rollingBlockHistory = newBlockHistory[start:]
+	if len(b.rollingBlockHistory) >= int(historySize) && atomic.CompareAndSwapInt32(&b.warm, 0, 1) {
+		b.logger.Debugw("BlockHistoryEstimator: block history is full, estimator is warm", "rollingBlockHistorySize", historySize, "headNum", head.Number)
+	}
 
 	return nil
 }
@@ -361,20 +375,16 @@ func (b *BlockHistoryEstimator) percentileGasPrice(percentile int) (*big.Int, er
 }
 
 func (b *BlockHistoryEstimator) setPercentileGasPrice(gasPrice *big.Int) {
-	max := b.config.EvmMaxGasPriceWei()
-	min := b.config.EvmMinGasPriceWei()
+	clamped := config.ClampGasPrice(b.config, gasPrice)
 
 	b.gasPriceMu.Lock()
 	defer b.gasPriceMu.Unlock()
-	if gasPrice.Cmp(max) > 0 {
-		b.logger.Warnw(fmt.Sprintf("Calculated gas price of %s Wei exceeds ETH_MAX_GAS_PRICE_WEI=%[2]s, setting gas price to the maximum allowed value of %[2]s Wei instead", gasPrice.String(), max.String()), "gasPriceWei", gasPrice, "maxGasPriceWei", max)
-		b.gasPrice = max
-	} else if gasPrice.Cmp(min) < 0 {
-		b.logger.Warnw(fmt.Sprintf("Calculated gas price of %s Wei falls below ETH_MIN_GAS_PRICE_WEI=%[2]s, setting gas price to the minimum allowed value of %[2]s Wei instead", gasPrice.String(), min.String()), "gasPriceWei", gasPrice, "maxGasPriceWei", min)
-		b.gasPrice = min
-	} else {
-		b.gasPrice = gasPrice
+	if cmp := gasPrice.Cmp(clamped); cmp > 0 {
+		b.logger.Warnw(fmt.Sprintf("Calculated gas price of %s Wei exceeds ETH_MAX_GAS_PRICE_WEI=%[2]s, setting gas price to the maximum allowed value of %[2]s Wei instead", gasPrice.String(), clamped.String()), "gasPriceWei", gasPrice, "maxGasPriceWei", clamped)
+	} else if cmp < 0 {
+		b.logger.Warnw(fmt.Sprintf("Calculated gas price of %s Wei falls below ETH_MIN_GAS_PRICE_WEI=%[2]s, setting gas price to the minimum allowed value of %[2]s Wei instead", gasPrice.String(), clamped.String()), "gasPriceWei", gasPrice, "maxGasPriceWei", clamped)
 	}
+	b.gasPrice = clamped
 }
 
 func (b *BlockHistoryEstimator) RollingBlockHistory() []Block {
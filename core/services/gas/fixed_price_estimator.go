@@ -4,6 +4,7 @@ import (
 	"context"
 	"math/big"
 
+	"github.com/smartcontractkit/chainlink/core/store/config"
 	"github.com/smartcontractkit/chainlink/core/store/models"
 )
 
@@ -20,9 +21,10 @@ func NewFixedPriceEstimator(config Config) Estimator {
 func (f *fixedPriceEstimator) Start() error                                       { return nil }
 func (f *fixedPriceEstimator) Close() error                                       { return nil }
 func (f *fixedPriceEstimator) OnNewLongestChain(_ context.Context, _ models.Head) {}
+func (f *fixedPriceEstimator) Warm() bool                                         { return true }
 
 func (f *fixedPriceEstimator) EstimateGas(_ []byte, gasLimit uint64, _ ...Opt) (gasPrice *big.Int, chainSpecificGasLimit uint64, err error) {
-	gasPrice = f.config.EvmGasPriceDefault()
+	gasPrice = config.ClampGasPrice(f.config, f.config.EvmGasPriceDefault())
 	chainSpecificGasLimit = applyMultiplier(gasLimit, f.config.EvmGasLimitMultiplier())
 	return
 }
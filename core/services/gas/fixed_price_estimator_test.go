@@ -20,6 +20,8 @@ func Test_FixedPriceEstimator(t *testing.T) {
 
 		config.On("EvmGasPriceDefault").Return(big.NewInt(42))
 		config.On("EvmGasLimitMultiplier").Return(float32(1.1))
+		config.On("EvmMaxGasPriceWei").Return(big.NewInt(1000000))
+		config.On("EvmMinGasPriceWei").Return(big.NewInt(1))
 
 		gasPrice, gasLimit, err := f.EstimateGas(nil, 100000)
 		require.NoError(t, err)
@@ -29,12 +31,29 @@ func Test_FixedPriceEstimator(t *testing.T) {
 		config.AssertExpectations(t)
 	})
 
+	t.Run("EstimateGas clamps EvmGasPriceDefault to EvmMaxGasPriceWei", func(t *testing.T) {
+		config := new(mocks.Config)
+		f := gas.NewFixedPriceEstimator(config)
+
+		config.On("EvmGasPriceDefault").Return(big.NewInt(42))
+		config.On("EvmGasLimitMultiplier").Return(float32(1))
+		config.On("EvmMaxGasPriceWei").Return(big.NewInt(40))
+		config.On("EvmMinGasPriceWei").Return(big.NewInt(1))
+
+		gasPrice, _, err := f.EstimateGas(nil, 100000)
+		require.NoError(t, err)
+		assert.Equal(t, big.NewInt(40), gasPrice)
+
+		config.AssertExpectations(t)
+	})
+
 	t.Run("BumpGas calls BumpGasPriceOnly", func(t *testing.T) {
 		config := new(mocks.Config)
 		f := gas.NewFixedPriceEstimator(config)
 
 		config.On("EvmGasPriceDefault").Return(big.NewInt(42))
 		config.On("EvmGasBumpPercent").Return(uint16(10))
+		config.On("EvmGasBumpStrategy").Return("max")
 		config.On("EvmGasBumpWei").Return(big.NewInt(150))
 		config.On("EvmMaxGasPriceWei").Return(big.NewInt(1000000))
 		config.On("EvmGasLimitMultiplier").Return(float32(1.1))
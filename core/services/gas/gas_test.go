@@ -8,6 +8,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/assets"
 	"github.com/smartcontractkit/chainlink/core/services/gas"
 	gasmocks "github.com/smartcontractkit/chainlink/core/services/gas/mocks"
+	"github.com/smartcontractkit/chainlink/core/store/config"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -91,6 +92,7 @@ func Test_BumpGasPriceOnly(t *testing.T) {
 		t.Run(test.name, func(t *testing.T) {
 			cfg := new(gasmocks.Config)
 			cfg.On("EvmGasBumpPercent").Return(test.bumpPercent)
+			cfg.On("EvmGasBumpStrategy").Return(config.GasBumpStrategyMax)
 			cfg.On("EvmGasPriceDefault").Return(test.priceDefault)
 			cfg.On("EvmGasBumpWei").Return(test.bumpWei)
 			cfg.On("EvmMaxGasPriceWei").Return(test.maxGasPriceWei)
@@ -105,10 +107,42 @@ func Test_BumpGasPriceOnly(t *testing.T) {
 	}
 }
 
+func Test_BumpGasPriceOnly_Strategy(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		strategy         string
+		bumpPercent      uint16
+		bumpWei          *big.Int
+		expectedGasPrice *big.Int
+	}{
+		{config.GasBumpStrategyMax, 10, assets.GWei(5), assets.GWei(25)},
+		{config.GasBumpStrategyMax, 50, assets.GWei(5), assets.GWei(30)},
+		{config.GasBumpStrategyPercent, 10, assets.GWei(5), assets.GWei(22)},
+		{config.GasBumpStrategyWei, 50, assets.GWei(5), assets.GWei(25)},
+	} {
+		test := test
+		t.Run(fmt.Sprintf("%s with %d%% and %s wei", test.strategy, test.bumpPercent, test.bumpWei), func(t *testing.T) {
+			cfg := new(gasmocks.Config)
+			cfg.On("EvmGasBumpPercent").Return(test.bumpPercent)
+			cfg.On("EvmGasBumpStrategy").Return(test.strategy)
+			cfg.On("EvmGasPriceDefault").Return(assets.GWei(20))
+			cfg.On("EvmGasBumpWei").Return(test.bumpWei)
+			cfg.On("EvmMaxGasPriceWei").Return(assets.GWei(100))
+			cfg.On("EvmGasLimitMultiplier").Return(float32(1))
+
+			actual, _, err := gas.BumpGasPriceOnly(cfg, assets.GWei(20), 100000)
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedGasPrice.String(), actual.String())
+		})
+	}
+}
+
 func Test_BumpGasPriceOnly_HitsMaxError(t *testing.T) {
 	t.Parallel()
 	cfg := new(gasmocks.Config)
 	cfg.On("EvmGasBumpPercent").Return(uint16(50))
+	cfg.On("EvmGasBumpStrategy").Return(config.GasBumpStrategyMax)
 	cfg.On("EvmGasPriceDefault").Return(assets.GWei(20))
 	cfg.On("EvmGasBumpWei").Return(assets.Wei(5000000000))
 	cfg.On("EvmMaxGasPriceWei").Return(assets.GWei(40))
@@ -123,6 +157,7 @@ func Test_BumpGasPriceOnly_NoBumpError(t *testing.T) {
 	t.Parallel()
 	cfg := new(gasmocks.Config)
 	cfg.On("EvmGasBumpPercent").Return(uint16(0))
+	cfg.On("EvmGasBumpStrategy").Return(config.GasBumpStrategyMax)
 	cfg.On("EvmGasBumpWei").Return(big.NewInt(0))
 	cfg.On("EvmMaxGasPriceWei").Return(assets.GWei(40))
 	cfg.On("EvmGasPriceDefault").Return(assets.GWei(20))
@@ -113,6 +113,20 @@ func (_m *Config) EvmGasBumpPercent() uint16 {
 	return r0
 }
 
+// EvmGasBumpStrategy provides a mock function with given fields:
+func (_m *Config) EvmGasBumpStrategy() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
 // EvmGasBumpWei provides a mock function with given fields:
 func (_m *Config) EvmGasBumpWei() *big.Int {
 	ret := _m.Called()
@@ -116,3 +116,17 @@ func (_m *Estimator) Start() error {
 
 	return r0
 }
+
+// Warm provides a mock function with given fields:
+func (_m *Estimator) Warm() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
@@ -12,10 +12,10 @@ import (
 	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
-	"github.com/shopspring/decimal"
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/services/eth"
 	"github.com/smartcontractkit/chainlink/core/static"
+	"github.com/smartcontractkit/chainlink/core/store/config"
 	"github.com/smartcontractkit/chainlink/core/store/models"
 )
 
@@ -54,6 +54,9 @@ type Estimator interface {
 	Close() error
 	EstimateGas(calldata []byte, gasLimit uint64, opts ...Opt) (gasPrice *big.Int, chainSpecificGasLimit uint64, err error)
 	BumpGas(originalGasPrice *big.Int, gasLimit uint64) (bumpedGasPrice *big.Int, chainSpecificGasLimit uint64, err error)
+	// Warm returns true once the estimator has collected enough data to
+	// price transactions itself
+	Warm() bool
 }
 
 // Opt is an option for a gas estimator
@@ -65,7 +68,7 @@ const (
 )
 
 func applyMultiplier(gasLimit uint64, multiplier float32) uint64 {
-	return uint64(decimal.NewFromBigInt(big.NewInt(0).SetUint64(gasLimit), 0).Mul(decimal.NewFromFloat32(multiplier)).IntPart())
+	return config.ApplyGasLimitMultiplier(gasLimit, multiplier)
 }
 
 // Config defines an interface for configuration in the gas package
@@ -78,6 +81,7 @@ type Config interface {
 	ChainID() *big.Int
 	EvmFinalityDepth() uint
 	EvmGasBumpPercent() uint16
+	EvmGasBumpStrategy() string
 	EvmGasBumpWei() *big.Int
 	EvmGasLimitMultiplier() float32
 	EvmGasPriceDefault() *big.Int
@@ -237,22 +241,31 @@ func BumpGasPriceOnly(config Config, originalGasPrice *big.Int, originalGasLimit
 // bumpGasPrice computes the next gas price to attempt as the largest of:
 // - A configured percentage bump (ETH_GAS_BUMP_PERCENT) on top of the baseline price.
 // - A configured fixed amount of Wei (ETH_GAS_PRICE_WEI) on top of the baseline price.
+// ETH_GAS_BUMP_STRATEGY may restrict this to only one of the two.
 // The baseline price is the maximum of the previous gas price attempt and the node's current gas price.
-func bumpGasPrice(config Config, originalGasPrice *big.Int) (*big.Int, error) {
-	baselinePrice := max(originalGasPrice, config.EvmGasPriceDefault())
+func bumpGasPrice(cfg Config, originalGasPrice *big.Int) (*big.Int, error) {
+	baselinePrice := max(originalGasPrice, cfg.EvmGasPriceDefault())
 
 	var priceByPercentage = new(big.Int)
-	priceByPercentage.Mul(baselinePrice, big.NewInt(int64(100+config.EvmGasBumpPercent())))
+	priceByPercentage.Mul(baselinePrice, big.NewInt(int64(100+cfg.EvmGasBumpPercent())))
 	priceByPercentage.Div(priceByPercentage, big.NewInt(100))
 
 	var priceByIncrement = new(big.Int)
-	priceByIncrement.Add(baselinePrice, config.EvmGasBumpWei())
+	priceByIncrement.Add(baselinePrice, cfg.EvmGasBumpWei())
 
-	bumpedGasPrice := max(priceByPercentage, priceByIncrement)
-	if bumpedGasPrice.Cmp(config.EvmMaxGasPriceWei()) > 0 {
+	var bumpedGasPrice *big.Int
+	switch cfg.EvmGasBumpStrategy() {
+	case config.GasBumpStrategyPercent:
+		bumpedGasPrice = priceByPercentage
+	case config.GasBumpStrategyWei:
+		bumpedGasPrice = priceByIncrement
+	default:
+		bumpedGasPrice = max(priceByPercentage, priceByIncrement)
+	}
+	if bumpedGasPrice.Cmp(cfg.EvmMaxGasPriceWei()) > 0 {
 		promGasBumpExceedsLimit.Inc()
-		return config.EvmMaxGasPriceWei(), errors.Errorf("bumped gas price of %s would exceed configured max gas price of %s (original price was %s). %s",
-			bumpedGasPrice.String(), config.EvmMaxGasPriceWei(), originalGasPrice.String(), static.EthNodeConnectivityProblemLabel)
+		return new(big.Int).Set(cfg.EvmMaxGasPriceWei()), errors.Errorf("bumped gas price of %s would exceed configured max gas price of %s (original price was %s). %s",
+			bumpedGasPrice.String(), cfg.EvmMaxGasPriceWei(), originalGasPrice.String(), static.EthNodeConnectivityProblemLabel)
 	} else if bumpedGasPrice.Cmp(originalGasPrice) == 0 {
 		// NOTE: This really shouldn't happen since we enforce minimums for
 		// ETH_GAS_BUMP_PERCENT and ETH_GAS_BUMP_WEI in the config validation,
@@ -132,6 +132,13 @@ func (o *optimismEstimator) refreshPrices() (t *time.Timer) {
 	return
 }
 
+// Warm returns true once prices have been fetched from the node
+func (o *optimismEstimator) Warm() bool {
+	o.gasPriceMu.RLock()
+	defer o.gasPriceMu.RUnlock()
+	return o.l1GasPrice != nil && o.l2GasPrice != nil
+}
+
 func (o *optimismEstimator) EstimateGas(calldata []byte, gasLimit uint64, opts ...Opt) (gasPrice *big.Int, chainSpecificGasLimit uint64, err error) {
 	ok := o.IfStarted(func() {
 		var forceRefetch bool
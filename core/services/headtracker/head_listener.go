@@ -27,16 +27,26 @@ var (
 		Name: "head_tracker_eth_connection_errors",
 		Help: "The total number of eth node connection errors",
 	})
+	promWSReconnectBackoff = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "head_tracker_ws_reconnect_backoff_seconds",
+		Help: "How long the head listener is waiting before its next attempt to subscribe to the eth node, 0 if connected",
+	})
 )
 
 type Config interface {
 	ChainID() *big.Int
+	EvmHeadTrackerBackfillDepth() uint
 	EvmHeadTrackerHistoryDepth() uint
 	EvmHeadTrackerMaxBufferSize() uint
+	EvmHeadTrackerMaxReorgDepth() uint
 	EvmHeadTrackerSamplingInterval() time.Duration
+	EvmHeadTrackerSamplingMode() string
 	BlockEmissionIdleWarningThreshold() time.Duration
 	EthereumURL() string
 	EvmFinalityDepth() uint
+	EvmFinalityViolationAction() string
+	NodeWSReconnectMaxBackoff() time.Duration
+	NodeWSReconnectMinBackoff() time.Duration
 }
 
 type HeadListener struct {
@@ -48,6 +58,8 @@ type HeadListener struct {
 	connected        bool
 	receivesHeads    int32
 	sleeper          utils.Sleeper
+	reconnectBackoff int64
+	chResubscribe    chan error
 
 	log      *logger.Logger
 	muLogger sync.RWMutex
@@ -67,15 +79,16 @@ func NewHeadListener(l *logger.Logger,
 	if len(sleepers) > 0 {
 		sleeper = sleepers[0]
 	} else {
-		sleeper = utils.NewBackoffSleeper()
+		sleeper = utils.NewBackoffSleeperWithBounds(config.NodeWSReconnectMinBackoff(), config.NodeWSReconnectMaxBackoff())
 	}
 	return &HeadListener{
-		config:    config,
-		ethClient: ethClient,
-		sleeper:   sleeper,
-		log:       l,
-		chStop:    chStop,
-		wgDone:    wgDone,
+		config:        config,
+		ethClient:     ethClient,
+		sleeper:       sleeper,
+		log:           l,
+		chStop:        chStop,
+		wgDone:        wgDone,
+		chResubscribe: make(chan error, 1),
 	}
 }
 
@@ -157,6 +170,9 @@ func (hl *HeadListener) receiveHeaders(ctx context.Context, handleNewHead func(c
 				return err
 			}
 
+		case err := <-hl.chResubscribe:
+			return err
+
 		case <-t.C:
 			// We haven't received a head on the channel for a long time, log a warning
 			logger.Warn(fmt.Sprintf("HeadTracker: have not received a head for %v", noHeadsAlarmDuration))
@@ -175,16 +191,19 @@ func (hl *HeadListener) subscribe() bool {
 			return false
 		}
 
-		hl.logger().Info("HeadListener: Connecting to ethereum node ", hl.config.EthereumURL(), " in ", hl.sleeper.Duration())
+		wait := hl.sleeper.After()
+		hl.setReconnectBackoff(wait)
+		hl.logger().Info("HeadListener: Connecting to ethereum node ", hl.config.EthereumURL(), " in ", wait)
 		select {
 		case <-hl.chStop:
 			return false
-		case <-time.After(hl.sleeper.After()):
+		case <-time.After(wait):
 			err := hl.subscribeToHead()
 			if err != nil {
 				promEthConnectionErrors.Inc()
 				hl.logger().Warnw(fmt.Sprintf("HeadListener: Failed to connect to ethereum node %v", hl.config.EthereumURL()), "err", err)
 			} else {
+				hl.setReconnectBackoff(0)
 				hl.logger().Info("HeadListener: Connected to ethereum node ", hl.config.EthereumURL())
 				return true
 			}
@@ -232,6 +251,28 @@ func (hl *HeadListener) unsubscribeFromHead() error {
 	return nil
 }
 
+// Resubscribe drops the current subscription and subscribes again, e.g.
+// because err means a head received from it could not be handled. It does not
+// block; if a resubscribe is already pending, err is only logged.
+func (hl *HeadListener) Resubscribe(err error) {
+	select {
+	case hl.chResubscribe <- err:
+	default:
+		hl.logger().Errorw("HeadListener: resubscribe already pending", "err", err)
+	}
+}
+
+func (hl *HeadListener) setReconnectBackoff(d time.Duration) {
+	atomic.StoreInt64(&hl.reconnectBackoff, int64(d))
+	promWSReconnectBackoff.Set(d.Seconds())
+}
+
+// ReconnectBackoff returns how long the HeadListener is waiting before its
+// current attempt to subscribe to the eth node, or 0 if it is connected.
+func (hl *HeadListener) ReconnectBackoff() time.Duration {
+	return time.Duration(atomic.LoadInt64(&hl.reconnectBackoff))
+}
+
 // Connected returns whether or not this HeadTracker is connected.
 func (hl *HeadListener) Connected() bool {
 	hl.connectedMutex.RLock()
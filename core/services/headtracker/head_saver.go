@@ -40,7 +40,18 @@ func (ht *HeadSaver) Save(ctx context.Context, h models.Head) error {
 	} else if err != nil {
 		return err
 	}
-	return ht.orm.TrimOldHeads(ctx, ht.config.EvmHeadTrackerHistoryDepth())
+	return ht.orm.TrimOldHeads(ctx, retainedHeads(ht.config))
+}
+
+// retainedHeads is ETH_HEAD_TRACKER_HISTORY_DEPTH, raised to
+// ETH_HEAD_TRACKER_MAX_REORG_DEPTH if that is larger so that deep re-orgs can
+// still be followed
+func retainedHeads(config Config) uint {
+	depth := config.EvmHeadTrackerHistoryDepth()
+	if maxReorgDepth := config.EvmHeadTrackerMaxReorgDepth(); maxReorgDepth > depth {
+		return maxReorgDepth
+	}
+	return depth
 }
 
 // HighestSeenHead returns the block header with the highest number that has been seen, or nil
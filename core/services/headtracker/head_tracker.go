@@ -14,6 +14,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/services/eth"
 	httypes "github.com/smartcontractkit/chainlink/core/services/headtracker/types"
+	"github.com/smartcontractkit/chainlink/core/store/config"
 	"github.com/smartcontractkit/chainlink/core/store/models"
 	"github.com/smartcontractkit/chainlink/core/store/presenters"
 	"github.com/smartcontractkit/chainlink/core/utils"
@@ -29,8 +30,47 @@ var (
 		Name: "head_tracker_very_old_head",
 		Help: "Counter is incremented every time we get a head that is much lower than the highest seen head ('much lower' is defined as a block that is ETH_FINALITY_DEPTH or greater below the highest seen head)",
 	})
+
+	promFinalityViolations = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "head_tracker_finality_violations_total",
+		Help: "The total number of re-orgs deeper than ETH_FINALITY_DEPTH seen when ETH_FINALITY_VIOLATION_ACTION is alert or halt",
+	},
+		[]string{"evmChainID"},
+	)
+
+	promHeadsSampled = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "head_tracker_heads_sampled_total",
+		Help: "The total number of heads passed on to sampled head subscribers",
+	},
+		[]string{"evmChainID"},
+	)
+
+	promHeadsDropped = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "head_tracker_heads_dropped_total",
+		Help: "The total number of heads superseded by a newer head before the sampling interval elapsed",
+	},
+		[]string{"evmChainID"},
+	)
+
+	promHeadBufferOverflows = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "head_tracker_head_buffer_overflows_total",
+		Help: "The total number of heads dropped because more than ETH_HEAD_TRACKER_MAX_BUFFER_SIZE heads were waiting to be processed",
+	},
+		[]string{"evmChainID"},
+	)
+
+	promSamplingInterval = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "head_tracker_sampling_interval_seconds",
+		Help: "The effective ETH_HEAD_TRACKER_SAMPLING_INTERVAL in seconds",
+	},
+		[]string{"evmChainID"},
+	)
 )
 
+// headDropWarningQuietPeriod is how long heads must go without being dropped
+// from the buffer before the next drop is logged as a warning again
+const headDropWarningQuietPeriod = time.Minute
+
 // HeadTracker holds and stores the latest block number experienced by this particular node
 // in a thread safe manner. Reconstitutes the last block number from the data
 // store on reboot.
@@ -40,14 +80,26 @@ type HeadTracker struct {
 	ethClient       eth.Client
 	config          Config
 
+	headsMB      utils.Mailbox
 	backfillMB   utils.Mailbox
 	samplingMB   utils.Mailbox
+	lastHeadDrop time.Time
 	muLogger     sync.RWMutex
 	headListener *HeadListener
 	headSaver    *HeadSaver
 	chStop       chan struct{}
 	wgDone       *sync.WaitGroup
 	utils.StartStopOnce
+
+	finalityViolationHandler FinalityViolationHandler
+	halted                   int32
+}
+
+// FinalityViolationHandler is told to stop broadcasting when the head tracker
+// sees a re-org deeper than ETH_FINALITY_DEPTH and
+// ETH_FINALITY_VIOLATION_ACTION is "halt"
+type FinalityViolationHandler interface {
+	HaltBroadcasting(reason error)
 }
 
 // NewHeadTracker instantiates a new HeadTracker using the orm to persist new block numbers.
@@ -69,6 +121,7 @@ func NewHeadTracker(
 		ethClient:       ethClient,
 		config:          config,
 		log:             l,
+		headsMB:         *utils.NewMailbox(uint64(config.EvmHeadTrackerMaxBufferSize())),
 		backfillMB:      *utils.NewMailbox(1),
 		samplingMB:      *utils.NewMailbox(1),
 		chStop:          chStop,
@@ -86,6 +139,12 @@ func (ht *HeadTracker) SetLogger(logger *logger.Logger) {
 	ht.headListener.SetLogger(logger)
 }
 
+// SetFinalityViolationHandler sets the handler to halt when a finality
+// violation is observed. It must be called before Start.
+func (ht *HeadTracker) SetFinalityViolationHandler(h FinalityViolationHandler) {
+	ht.finalityViolationHandler = h
+}
+
 func (ht *HeadTracker) logger() *logger.Logger {
 	ht.muLogger.RLock()
 	defer ht.muLogger.RUnlock()
@@ -128,8 +187,9 @@ func (ht *HeadTracker) Start() error {
 			logger.Debug("HeadTracker: got nil initial head")
 		}
 
-		ht.wgDone.Add(3)
-		go ht.headListener.ListenForNewHeads(ht.handleNewHead)
+		ht.wgDone.Add(4)
+		go ht.headListener.ListenForNewHeads(ht.bufferNewHead)
+		go ht.headProcessor()
 		go ht.backfiller()
 		go ht.headSampler()
 
@@ -158,6 +218,12 @@ func (ht *HeadTracker) Stop() error {
 		ht.logger().Info(fmt.Sprintf("HeadTracker: Stopping - disconnecting from %v", ht.config.EthereumURL()))
 		close(ht.chStop)
 		ht.wgDone.Wait()
+
+		chainID := ht.config.ChainID().String()
+		promHeadsSampled.DeleteLabelValues(chainID)
+		promHeadsDropped.DeleteLabelValues(chainID)
+		promHeadBufferOverflows.DeleteLabelValues(chainID)
+		promSamplingInterval.DeleteLabelValues(chainID)
 		return nil
 	})
 }
@@ -179,10 +245,65 @@ func (ht *HeadTracker) Connected() bool {
 	return ht.headListener.Connected()
 }
 
+// bufferNewHead queues a head received from the subscription for
+// headProcessor. If more than ETH_HEAD_TRACKER_MAX_BUFFER_SIZE heads are
+// waiting, the oldest is dropped so that the head tracker can keep up.
+func (ht *HeadTracker) bufferNewHead(_ context.Context, head models.Head) error {
+	if wasOverCapacity := ht.headsMB.Deliver(head); !wasOverCapacity {
+		return nil
+	}
+	promHeadBufferOverflows.WithLabelValues(ht.config.ChainID().String()).Inc()
+	// Only bufferNewHead touches lastHeadDrop, and it is only called from the
+	// head listener's goroutine
+	now := time.Now()
+	if now.Sub(ht.lastHeadDrop) > headDropWarningQuietPeriod {
+		ht.logger().Warnw(fmt.Sprintf("HeadTracker: head buffer is full, dropping the oldest head. Heads are arriving faster than they can be processed; consider increasing ETH_HEAD_TRACKER_MAX_BUFFER_SIZE (currently %d)", ht.config.EvmHeadTrackerMaxBufferSize()),
+			"blockNum", head.Number, "maxBufferSize", ht.config.EvmHeadTrackerMaxBufferSize())
+	}
+	ht.lastHeadDrop = now
+	return nil
+}
+
+// headProcessor handles buffered heads in the order they were received
+func (ht *HeadTracker) headProcessor() {
+	defer ht.wgDone.Done()
+
+	ctx, cancel := utils.ContextFromChan(ht.chStop)
+	defer cancel()
+
+	for {
+		select {
+		case <-ht.chStop:
+			return
+		case <-ht.headsMB.Notify():
+			for {
+				item, exists := ht.headsMB.Retrieve()
+				if !exists {
+					break
+				}
+				head, ok := item.(models.Head)
+				if !ok {
+					panic(fmt.Sprintf("expected `models.Head`, got %T", item))
+				}
+				if err := ht.handleNewHead(ctx, head); ctx.Err() != nil {
+					return
+				} else if err != nil {
+					ht.headListener.Resubscribe(errors.Wrapf(err, "failed to handle head %v", head.Number))
+				}
+			}
+		}
+	}
+}
+
 func (ht *HeadTracker) headSampler() {
 	defer ht.wgDone.Done()
 
-	debounceHead := time.NewTicker(ht.config.EvmHeadTrackerSamplingInterval())
+	samplingInterval := ht.config.EvmHeadTrackerSamplingInterval()
+	adaptive := ht.config.EvmHeadTrackerSamplingMode() == config.HeadTrackerSamplingModeAdaptive
+	chainID := ht.config.ChainID().String()
+	promSamplingInterval.WithLabelValues(chainID).Set(samplingInterval.Seconds())
+
+	debounceHead := time.NewTicker(samplingInterval)
 	defer debounceHead.Stop()
 
 	ctx, cancel := utils.ContextFromChan(ht.chStop)
@@ -202,13 +323,43 @@ func (ht *HeadTracker) headSampler() {
 				panic(fmt.Sprintf("expected `models.Head`, got %T", item))
 			}
 
+			promHeadsSampled.WithLabelValues(chainID).Inc()
 			ht.headBroadcaster.OnNewLongestChain(ctx, head)
+
+			if adaptive {
+				if blockTime, ok := averageBlockTime(head); ok && blockTime != samplingInterval {
+					samplingInterval = blockTime
+					debounceHead.Reset(samplingInterval)
+					promSamplingInterval.WithLabelValues(chainID).Set(samplingInterval.Seconds())
+				}
+			}
 		}
 	}
 }
 
+// averageBlockTime returns the average time between blocks in head's chain.
+// Block timestamps only have second resolution, so it is measured across the
+// whole chain rather than between adjacent heads. It returns false if the
+// chain is too short, or its timestamps too coarse, to measure.
+func averageBlockTime(head models.Head) (time.Duration, bool) {
+	earliest := head.EarliestInChain()
+	blocks := head.Number - earliest.Number
+	if blocks <= 0 {
+		return 0, false
+	}
+	elapsed := head.Timestamp.Sub(earliest.Timestamp)
+	if elapsed <= 0 {
+		return 0, false
+	}
+	return elapsed / time.Duration(blocks), true
+}
+
 func (ht *HeadTracker) backfiller() {
 	defer ht.wgDone.Done()
+	// The first head after startup may be far ahead of the last one we saw
+	// before going down, so it is backfilled further to catch up. Every head
+	// after that only needs to be backfilled to finality depth.
+	startup := true
 	for {
 		select {
 		case <-ht.chStop:
@@ -225,7 +376,14 @@ func (ht *HeadTracker) backfiller() {
 				}
 				{
 					ctx, cancel := utils.ContextFromChan(ht.chStop)
-					err := ht.Backfill(ctx, h, ht.config.EvmFinalityDepth())
+					depth := ht.config.EvmFinalityDepth()
+					if startup {
+						if backfillDepth := ht.config.EvmHeadTrackerBackfillDepth(); backfillDepth > depth {
+							depth = backfillDepth
+						}
+						startup = false
+					}
+					err := ht.Backfill(ctx, h, depth)
 					if err != nil {
 						ht.logger().Warnw("HeadTracker: unexpected error while backfilling heads", "err", err)
 					} else if ctx.Err() != nil {
@@ -351,7 +509,9 @@ func (ht *HeadTracker) handleNewHead(ctx context.Context, head models.Head) erro
 		}
 
 		ht.backfillMB.Deliver(headWithChain)
-		ht.samplingMB.Deliver(headWithChain)
+		if wasOverCapacity := ht.samplingMB.Deliver(headWithChain); wasOverCapacity {
+			promHeadsDropped.WithLabelValues(ht.config.ChainID().String()).Inc()
+		}
 		return nil
 	}
 	if head.Number == prevHead.Number {
@@ -363,14 +523,43 @@ func (ht *HeadTracker) handleNewHead(ctx context.Context, head models.Head) erro
 	} else {
 		ht.logger().Debugw("HeadTracker: got out of order head", "blockNum", head.Number, "gotHead", head.Hash.Hex(), "highestSeenHead", prevHead.Number)
 		if head.Number < prevHead.Number-int64(ht.config.EvmFinalityDepth()) {
+			if maxReorgDepth := ht.config.EvmHeadTrackerMaxReorgDepth(); head.Number >= prevHead.Number-int64(maxReorgDepth) {
+				ht.logger().Warnw(fmt.Sprintf("HeadTracker: got re-org to block %d which is deeper than finality depth but within ETH_HEAD_TRACKER_MAX_REORG_DEPTH of %d (highest seen was %d)", head.Number, maxReorgDepth, prevHead.Number),
+					"blockNum", head.Number, "highestSeenHead", prevHead.Number, "maxReorgDepth", maxReorgDepth)
+				return nil
+			}
 			promOldHead.Inc()
 			ht.logger().Errorf("HeadTracker: got very old block with number %d (highest seen was %d). This is a problem and either means a very deep re-org occurred, or the chain went backwards in block numbers. This node will not function correctly without manual intervention.", head.Number, prevHead.Number)
+			ht.handleFinalityViolation(head.Number, prevHead.Number)
 		}
 	}
 	return nil
 }
 
+// handleFinalityViolation applies ETH_FINALITY_VIOLATION_ACTION. "log" is
+// already covered by the error logged by the caller.
+func (ht *HeadTracker) handleFinalityViolation(blockNum, highestSeen int64) {
+	action := ht.config.EvmFinalityViolationAction()
+	if action != config.FinalityViolationActionAlert && action != config.FinalityViolationActionHalt {
+		return
+	}
+	promFinalityViolations.WithLabelValues(ht.config.ChainID().String()).Inc()
+	if action != config.FinalityViolationActionHalt {
+		return
+	}
+	if !atomic.CompareAndSwapInt32(&ht.halted, 0, 1) {
+		return
+	}
+	ht.logger().Errorw("HeadTracker: halting transaction broadcasting after finality violation, manual intervention is required", "evmChainID", ht.config.ChainID(), "blockNum", blockNum, "highestSeenHead", highestSeen)
+	if ht.finalityViolationHandler != nil {
+		ht.finalityViolationHandler.HaltBroadcasting(errors.Errorf("re-org to block %d is deeper than finality depth %d below highest seen head %d", blockNum, ht.config.EvmFinalityDepth(), highestSeen))
+	}
+}
+
 func (ht *HeadTracker) Healthy() error {
+	if atomic.LoadInt32(&ht.halted) == 1 {
+		return errors.New("Halted after a re-org deeper than finality depth")
+	}
 	if atomic.LoadInt32(&ht.headListener.receivesHeads) != 1 {
 		return errors.New("Heads are not being received")
 	}
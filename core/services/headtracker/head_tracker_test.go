@@ -285,6 +285,45 @@ func TestHeadTracker_ResubscribeOnSubscriptionError(t *testing.T) {
 	assert.NoError(t, ht.Stop())
 }
 
+func TestHeadTracker_ResubscribeOnHeadHandlingError(t *testing.T) {
+	t.Parallel()
+	g := gomega.NewGomegaWithT(t)
+
+	db := pgtest.NewGormDB(t)
+	config := cltest.NewTestEVMConfig(t)
+	orm := headtracker.NewORM(db)
+
+	ethClient, sub := cltest.NewEthClientAndSubMock(t)
+
+	chchHeaders := make(chan chan<- *models.Head, 2)
+	ethClient.On("ChainID", mock.Anything).Maybe().Return(config.ChainID(), nil)
+	ethClient.On("SubscribeNewHead", mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) { chchHeaders <- args.Get(1).(chan<- *models.Head) }).
+		Twice().
+		Return(sub, nil)
+	ethClient.On("HeadByNumber", mock.Anything, (*big.Int)(nil)).Return(cltest.Head(0), nil)
+
+	sub.On("Unsubscribe").Return()
+	sub.On("Err").Return(nil)
+
+	ht := createHeadTracker(ethClient, config, orm)
+
+	assert.Nil(t, ht.Start())
+	<-chchHeaders
+	g.Eventually(func() bool { return ht.headTracker.Connected() }, 5*time.Second, 5*time.Millisecond).Should(gomega.Equal(true))
+
+	// As when the head processor fails to handle a head
+	ht.headTracker.ExportedResubscribe(errors.New("failed to handle head"))
+
+	select {
+	case <-chchHeaders:
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting to resubscribe")
+	}
+
+	assert.NoError(t, ht.Stop())
+}
+
 func TestHeadTracker_Start_LoadsLatestChain(t *testing.T) {
 	t.Parallel()
 
@@ -334,6 +373,53 @@ func TestHeadTracker_Start_LoadsLatestChain(t *testing.T) {
 	assert.Equal(t, h.Number, int64(3))
 }
 
+func TestHeadTracker_Start_BackfillsToBackfillDepth(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewGormDB(t)
+	config := cltest.NewTestEVMConfig(t)
+	config.Overrides.EvmFinalityDepth = null.IntFrom(2)
+	config.Overrides.EvmHeadTrackerBackfillDepth = null.IntFrom(5)
+	ethClient, sub := cltest.NewEthClientAndSubMock(t)
+
+	ethClient.On("ChainID", mock.Anything).Return(config.ChainID(), nil)
+	ethClient.On("SubscribeNewHead", mock.Anything, mock.Anything).Return(sub, nil)
+	sub.On("Unsubscribe").Return()
+	sub.On("Err").Return(nil)
+
+	// The node was last running at block 10 and has come back up at block 20
+	heads := make([]*models.Head, 21)
+	for i := range heads {
+		heads[i] = cltest.Head(i)
+		if i > 0 {
+			heads[i].ParentHash = heads[i-1].Hash
+		}
+	}
+	ethClient.On("HeadByNumber", mock.Anything, (*big.Int)(nil)).Return(heads[20], nil)
+	// Backfill depth of 5 means blocks 16-19 are fetched, and no further
+	for i := 16; i < 20; i++ {
+		ethClient.On("HeadByNumber", mock.Anything, big.NewInt(int64(i))).Return(heads[i], nil).Once()
+	}
+
+	orm := headtracker.NewORM(db)
+	require.NoError(t, orm.IdempotentInsertHead(context.Background(), *heads[10]))
+
+	ht := createHeadTrackerWithNeverSleeper(ethClient, config, orm)
+	require.NoError(t, ht.Start())
+	defer ht.Stop()
+
+	gomega.NewGomegaWithT(t).Eventually(func() bool {
+		h, err := orm.HeadByHash(context.Background(), heads[16].Hash)
+		require.NoError(t, err)
+		return h != nil
+	}).Should(gomega.BeTrue())
+
+	h, err := orm.HeadByHash(context.Background(), heads[15].Hash)
+	require.NoError(t, err)
+	assert.Nil(t, h)
+	ethClient.AssertExpectations(t)
+}
+
 func TestHeadTracker_SwitchesToLongestChain(t *testing.T) {
 	t.Parallel()
 
@@ -477,6 +563,157 @@ func TestHeadTracker_SwitchesToLongestChain(t *testing.T) {
 	checker.AssertExpectations(t)
 }
 
+type finalityViolationRecorder struct {
+	mu      sync.Mutex
+	reasons []error
+}
+
+func (r *finalityViolationRecorder) HaltBroadcasting(reason error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.reasons = append(r.reasons, reason)
+}
+
+func TestHeadTracker_FinalityViolationAction(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		action    string
+		halts     bool
+		unhealthy bool
+	}{
+		{"log", false, false},
+		{"alert", false, false},
+		{"halt", true, true},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.action, func(t *testing.T) {
+			t.Parallel()
+
+			db := pgtest.NewGormDB(t)
+			config := cltest.NewTestEVMConfig(t)
+			config.Overrides.EvmFinalityDepth = null.IntFrom(15)
+			config.Overrides.EvmFinalityViolationAction = null.StringFrom(test.action)
+			ethClient, _ := cltest.NewEthClientAndSubMock(t)
+			orm := headtracker.NewORM(db)
+
+			ht := createHeadTracker(ethClient, config, orm)
+			recorder := &finalityViolationRecorder{}
+			ht.headTracker.SetFinalityViolationHandler(recorder)
+
+			ctx := context.Background()
+			require.NoError(t, ht.headTracker.ExportedHandleNewHead(ctx, *cltest.Head(100)))
+			// Within finality depth, not a violation
+			require.NoError(t, ht.headTracker.ExportedHandleNewHead(ctx, *cltest.Head(90)))
+			assert.Empty(t, recorder.reasons)
+
+			require.NoError(t, ht.headTracker.ExportedHandleNewHead(ctx, *cltest.Head(10)))
+			require.NoError(t, ht.headTracker.ExportedHandleNewHead(ctx, *cltest.Head(5)))
+			if test.halts {
+				require.Len(t, recorder.reasons, 1)
+				assert.Contains(t, recorder.reasons[0].Error(), "re-org to block 10 is deeper than finality depth 15")
+			} else {
+				assert.Empty(t, recorder.reasons)
+			}
+			if test.halts {
+				assert.EqualError(t, ht.headTracker.Healthy(), "Halted after a re-org deeper than finality depth")
+			}
+		})
+	}
+}
+
+func TestHeadTracker_MaxReorgDepth(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewGormDB(t)
+	config := cltest.NewTestEVMConfig(t)
+	config.Overrides.EvmFinalityDepth = null.IntFrom(15)
+	config.Overrides.EvmHeadTrackerHistoryDepth = null.IntFrom(20)
+	config.Overrides.EvmHeadTrackerMaxReorgDepth = null.IntFrom(50)
+	config.Overrides.EvmFinalityViolationAction = null.StringFrom("halt")
+	ethClient, _ := cltest.NewEthClientAndSubMock(t)
+	orm := headtracker.NewORM(db)
+
+	ht := createHeadTracker(ethClient, config, orm)
+	recorder := &finalityViolationRecorder{}
+	ht.headTracker.SetFinalityViolationHandler(recorder)
+
+	ctx := context.Background()
+	for i := 40; i <= 100; i++ {
+		require.NoError(t, ht.headTracker.ExportedHandleNewHead(ctx, *cltest.Head(i)))
+	}
+	// Heads up to ETH_HEAD_TRACKER_MAX_REORG_DEPTH deep are retained even
+	// though it exceeds ETH_HEAD_TRACKER_HISTORY_DEPTH
+	var count int64
+	require.NoError(t, db.Model(&models.Head{}).Count(&count).Error)
+	assert.Equal(t, int64(50), count)
+
+	// Deeper than finality, but within max re-org depth
+	require.NoError(t, ht.headTracker.ExportedHandleNewHead(ctx, *cltest.Head(60)))
+	assert.Empty(t, recorder.reasons)
+	healthErr := ht.headTracker.Healthy()
+	require.Error(t, healthErr)
+	assert.NotContains(t, healthErr.Error(), "Halted")
+
+	// Deeper than max re-org depth is still a finality violation
+	require.NoError(t, ht.headTracker.ExportedHandleNewHead(ctx, *cltest.Head(45)))
+	require.Len(t, recorder.reasons, 1)
+}
+
+func TestHeadTracker_BufferOverflowDropsHeads(t *testing.T) {
+	t.Parallel()
+
+	config := cltest.NewTestEVMConfig(t)
+	config.GeneralConfig.Overrides.SetChainID(4242167)
+	config.Overrides.EvmHeadTrackerMaxBufferSize = null.IntFrom(3)
+	ethClient, _ := cltest.NewEthClientAndSubMock(t)
+
+	// Not started, so nothing drains the buffer
+	ht := createHeadTracker(ethClient, config, headtracker.NewORM(nil))
+	chainID := config.ChainID().String()
+
+	ctx := context.Background()
+	for i := 0; i < 3; i++ {
+		require.NoError(t, ht.headTracker.ExportedBufferNewHead(ctx, *cltest.Head(i)))
+	}
+	assert.Equal(t, float64(0), headtracker.HeadsDroppedFromBuffer(chainID))
+
+	for i := 3; i < 5; i++ {
+		require.NoError(t, ht.headTracker.ExportedBufferNewHead(ctx, *cltest.Head(i)))
+	}
+	assert.Equal(t, float64(2), headtracker.HeadsDroppedFromBuffer(chainID))
+}
+
+func TestHeadTracker_AverageBlockTime(t *testing.T) {
+	t.Parallel()
+
+	newChain := func(timestamps ...int64) models.Head {
+		var head *models.Head
+		for i, ts := range timestamps {
+			h := models.Head{Number: int64(100 + i), Timestamp: time.Unix(ts, 0), Parent: head}
+			head = &h
+		}
+		return *head
+	}
+
+	_, ok := headtracker.AverageBlockTime(newChain(1000))
+	assert.False(t, ok, "single head")
+
+	_, ok = headtracker.AverageBlockTime(newChain(1000, 1000, 1000))
+	assert.False(t, ok, "sub-second blocks with identical timestamps")
+
+	blockTime, ok := headtracker.AverageBlockTime(newChain(1000, 1012, 1026, 1036))
+	require.True(t, ok)
+	assert.Equal(t, 12*time.Second, blockTime)
+
+	// Second resolution timestamps still average out over the chain
+	blockTime, ok = headtracker.AverageBlockTime(newChain(1000, 1000, 1001, 1001, 1002))
+	require.True(t, ok)
+	assert.Equal(t, 500*time.Millisecond, blockTime)
+}
+
 func TestHeadTracker_Backfill(t *testing.T) {
 	t.Parallel()
 
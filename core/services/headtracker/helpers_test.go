@@ -1,7 +1,33 @@
 package headtracker
 
-import "sync"
+import (
+	"context"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+)
 
 func GetHeadListenerConnectedMutex(hl *HeadListener) *sync.RWMutex {
 	return &hl.connectedMutex
 }
+
+func (ht *HeadTracker) ExportedHandleNewHead(ctx context.Context, head models.Head) error {
+	return ht.handleNewHead(ctx, head)
+}
+
+func (ht *HeadTracker) ExportedResubscribe(err error) {
+	ht.headListener.Resubscribe(err)
+}
+
+func (ht *HeadTracker) ExportedBufferNewHead(ctx context.Context, head models.Head) error {
+	return ht.bufferNewHead(ctx, head)
+}
+
+var AverageBlockTime = averageBlockTime
+
+// HeadsDroppedFromBuffer returns the value of
+// head_tracker_head_buffer_overflows_total for the given chain
+func HeadsDroppedFromBuffer(chainID string) float64 {
+	return testutil.ToFloat64(promHeadBufferOverflows.WithLabelValues(chainID))
+}
@@ -1,6 +1,8 @@
 package health
 
 import (
+	"context"
+	"sort"
 	"sync"
 	"time"
 
@@ -8,6 +10,7 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
 	"github.com/smartcontractkit/chainlink/core/utils"
+	"go.uber.org/multierr"
 )
 
 // Types requiring health checks should implement the Checkable interface.
@@ -217,3 +220,38 @@ func (c *checker) IsHealthy() (healthy bool, errors map[string]error) {
 
 	return
 }
+
+// readyPollInterval is how often WaitForReady re-checks readiness
+const readyPollInterval = 100 * time.Millisecond
+
+// WaitForReady blocks until every checkable's Ready() passes, or the context
+// expires. On expiry it returns the last readiness error of each checkable
+// that was still not ready, combined.
+func WaitForReady(ctx context.Context, checkables map[string]Checkable) error {
+	names := make([]string, 0, len(checkables))
+	for name := range checkables {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	ticker := time.NewTicker(readyPollInterval)
+	defer ticker.Stop()
+
+	for {
+		var merr error
+		for _, name := range names {
+			if err := checkables[name].Ready(); err != nil {
+				merr = multierr.Append(merr, errors.Wrapf(err, "%s is not ready", name))
+			}
+		}
+		if merr == nil {
+			return nil
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return errors.Wrap(merr, "timed out waiting for readiness")
+		}
+	}
+}
@@ -1,12 +1,15 @@
 package health_test
 
 import (
+	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/smartcontractkit/chainlink/core/services/health"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 var ErrUnhealthy = errors.New("Unhealthy")
@@ -60,3 +63,47 @@ func TestCheck(t *testing.T) {
 		assert.Equal(t, test.expected, results, "case %d", i)
 	}
 }
+
+type delayedCheck struct {
+	readyAt time.Time
+}
+
+func (d delayedCheck) Ready() error {
+	if time.Now().Before(d.readyAt) {
+		return errors.New("Not ready")
+	}
+	return nil
+}
+
+func (d delayedCheck) Healthy() error { return nil }
+
+func TestWaitForReady(t *testing.T) {
+	t.Parallel()
+
+	t.Run("returns once all checks are ready", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		err := health.WaitForReady(ctx, map[string]health.Checkable{
+			"ready":   boolCheck(true),
+			"delayed": delayedCheck{time.Now().Add(300 * time.Millisecond)},
+		})
+		assert.NoError(t, err)
+	})
+
+	t.Run("returns the last errors on timeout", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 300*time.Millisecond)
+		defer cancel()
+
+		err := health.WaitForReady(ctx, map[string]health.Checkable{
+			"ready":   boolCheck(true),
+			"never":   boolCheck(false),
+			"tooSlow": delayedCheck{time.Now().Add(time.Hour)},
+		})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "timed out waiting for readiness")
+		assert.Contains(t, err.Error(), "never is not ready: Not ready")
+		assert.Contains(t, err.Error(), "tooSlow is not ready: Not ready")
+		assert.NotContains(t, err.Error(), "ready is not ready")
+	})
+}
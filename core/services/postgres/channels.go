@@ -8,4 +8,8 @@ const (
 
 	// Postgres channel to listen for new eth_txes
 	ChannelInsertOnEthTx = "insert_on_eth_txes"
+
+	// Prefix of the per-chain Postgres channels on which chain state changes
+	// are published
+	ChannelChainEvents = "evm_chain_events"
 )
@@ -43,7 +43,7 @@ type GethKeyStore interface {
 }
 
 type Config interface {
-	MinIncomingConfirmations() uint32
+	EffectiveIncomingConfirmations() uint32
 	EvmGasLimitDefault() uint64
 }
 
@@ -109,8 +109,8 @@ func (lsn *listenerV1) Start() error {
 		// Take the larger of the global vs specific
 		// Note that runtime changes to incoming confirmations require a job delete/add
 		// because we need to resubscribe to the lb with the new min.
-		minConfs := lsn.cfg.MinIncomingConfirmations()
-		if lsn.job.VRFSpec.Confirmations > lsn.cfg.MinIncomingConfirmations() {
+		minConfs := lsn.cfg.EffectiveIncomingConfirmations()
+		if lsn.job.VRFSpec.Confirmations > minConfs {
 			minConfs = lsn.job.VRFSpec.Confirmations
 		}
 		unsubscribeLogs := lsn.logBroadcaster.Register(lsn, log.ListenerOpts{
@@ -81,8 +81,8 @@ func (lsn *listenerV2) Start() error {
 		// Take the larger of the global vs specific.
 		// Note that the v2 vrf requests specify their own confirmation requirements.
 		// We wait for max(minConfs, request required confs) to be safe.
-		minConfs := lsn.cfg.MinIncomingConfirmations()
-		if lsn.job.VRFSpec.Confirmations > lsn.cfg.MinIncomingConfirmations() {
+		minConfs := lsn.cfg.EffectiveIncomingConfirmations()
+		if lsn.job.VRFSpec.Confirmations > minConfs {
 			minConfs = lsn.job.VRFSpec.Confirmations
 		}
 		unsubscribeLogs := lsn.logBroadcaster.Register(lsn, log.ListenerOpts{
@@ -1,13 +1,20 @@
 package config_test
 
 import (
+	"context"
+	"fmt"
 	"math/big"
+	"sync"
 	"testing"
 
+	"github.com/smartcontractkit/chainlink/core/internal/mocks"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
 	"github.com/smartcontractkit/chainlink/core/store/config"
 
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
 )
 
 func TestEVMConfig_EvmGasPriceDefault(t *testing.T) {
@@ -43,3 +50,195 @@ func TestEVMConfig_EvmGasPriceDefault(t *testing.T) {
 	// Value changes
 	require.Equal(t, newerValue, cfg.EvmGasPriceDefault())
 }
+
+type blockWriteKey struct{}
+
+func TestEVMConfig_SetEvmGasPriceDefaultCtx(t *testing.T) {
+	cfg := config.NewEVMConfig(config.NewGeneralConfig())
+	db := pgtest.NewGormDB(t)
+	cfg.SetDB(db)
+
+	def := cfg.EvmGasPriceDefault()
+	newValue := new(big.Int).Add(def, big.NewInt(1))
+
+	t.Run("returns when cancelled mid-write without writing", func(t *testing.T) {
+		// Hold the write's first query until ctx is cancelled, as a slow DB would
+		started := make(chan struct{})
+		var once sync.Once
+		require.NoError(t, db.Callback().Row().Before("gorm:row").Register("test:block_write", func(tx *gorm.DB) {
+			if tx.Statement.Context.Value(blockWriteKey{}) == nil {
+				return
+			}
+			once.Do(func() { close(started) })
+			<-tx.Statement.Context.Done()
+		}))
+
+		ctx, cancel := context.WithCancel(context.WithValue(context.Background(), blockWriteKey{}, true))
+		defer cancel()
+		go func() {
+			<-started
+			cancel()
+		}()
+
+		err := cfg.SetEvmGasPriceDefaultCtx(ctx, newValue)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), context.Canceled.Error())
+
+		require.Equal(t, def, cfg.EvmGasPriceDefault())
+	})
+
+	t.Run("writes with a live context", func(t *testing.T) {
+		err := cfg.SetEvmGasPriceDefaultCtx(context.Background(), newValue)
+		require.NoError(t, err)
+
+		require.Equal(t, newValue, cfg.EvmGasPriceDefault())
+	})
+}
+
+func TestEVMConfig_SeedEvmGasPriceDefault(t *testing.T) {
+	cfg := config.NewEVMConfig(config.NewGeneralConfig())
+	db := pgtest.NewGormDB(t)
+	cfg.SetDB(db)
+
+	require.False(t, cfg.EvmGasPriceDefaultSeedFromNetwork())
+
+	t.Run("clamps the network price to the maximum", func(t *testing.T) {
+		ethClient := new(mocks.Client)
+		defer ethClient.AssertExpectations(t)
+		tooHigh := new(big.Int).Add(cfg.EvmMaxGasPriceWei(), big.NewInt(1))
+		ethClient.On("SuggestGasPrice", mock.Anything).Return(tooHigh, nil).Once()
+
+		require.NoError(t, cfg.SeedEvmGasPriceDefault(context.Background(), ethClient))
+		require.Equal(t, cfg.EvmMaxGasPriceWei(), cfg.EvmGasPriceDefault())
+	})
+
+	t.Run("does nothing once a default is persisted", func(t *testing.T) {
+		ethClient := new(mocks.Client)
+		defer ethClient.AssertExpectations(t)
+
+		require.NoError(t, cfg.SeedEvmGasPriceDefault(context.Background(), ethClient))
+		require.Equal(t, cfg.EvmMaxGasPriceWei(), cfg.EvmGasPriceDefault())
+	})
+}
+
+func TestEVMConfig_SetEvmMaxGasPriceWei(t *testing.T) {
+	cfg := config.NewEVMConfig(config.NewGeneralConfig())
+
+	// No orm installed
+	require.Error(t, cfg.SetEvmMaxGasPriceWei(context.Background(), cfg.EvmMaxGasPriceWei()))
+
+	db := pgtest.NewGormDB(t)
+	cfg.SetDB(db)
+	def := cfg.EvmMaxGasPriceWei()
+
+	t.Run("rejects values below the default gas price", func(t *testing.T) {
+		tooLow := new(big.Int).Sub(cfg.EvmGasPriceDefault(), big.NewInt(1))
+		err := cfg.SetEvmMaxGasPriceWei(context.Background(), tooLow)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "below the default gas price")
+		require.Equal(t, def, cfg.EvmMaxGasPriceWei())
+	})
+
+	t.Run("persists the new maximum", func(t *testing.T) {
+		newValue := new(big.Int).Add(def, big.NewInt(1))
+		require.NoError(t, cfg.SetEvmMaxGasPriceWei(context.Background(), newValue))
+		require.Equal(t, newValue, cfg.EvmMaxGasPriceWei())
+	})
+}
+
+func TestEVMConfig_ReloadPersistedConfig(t *testing.T) {
+	db := pgtest.NewGormDB(t)
+	require.NoError(t, db.Exec(`INSERT INTO evm_chains (id, cfg, created_at, updated_at) VALUES (1337001, '{}', NOW(), NOW()), (1337002, '{}', NOW(), NOW())`).Error)
+
+	target := config.NewEVMConfig(config.NewGeneralConfigWithChainID("1337001"))
+	target.SetDB(db)
+	other := config.NewEVMConfig(config.NewGeneralConfigWithChainID("1337002"))
+	other.SetDB(db)
+	require.NoError(t, target.ReloadPersistedConfig())
+	require.NoError(t, other.ReloadPersistedConfig())
+	def := target.EvmGasPriceDefault()
+	require.Equal(t, def, other.EvmGasPriceDefault())
+
+	newValue := new(big.Int).Add(def, big.NewInt(1))
+	require.NoError(t, db.Exec(`UPDATE evm_chains SET cfg = ? WHERE id IN (1337001, 1337002)`, fmt.Sprintf(`{"EvmGasPriceDefault": "%s"}`, newValue)).Error)
+
+	// Nothing changes until reloaded
+	require.Equal(t, def, target.EvmGasPriceDefault())
+
+	require.NoError(t, target.ReloadPersistedConfig())
+	require.Equal(t, newValue, target.EvmGasPriceDefault())
+	require.Equal(t, def, other.EvmGasPriceDefault())
+
+	missing := config.NewEVMConfig(config.NewGeneralConfigWithChainID("1337003"))
+	missing.SetDB(db)
+	require.True(t, errors.Is(missing.ReloadPersistedConfig(), config.ErrChainNotFound))
+}
+
+func TestEVMConfig_ReloadPersistedConfig_DisabledChain(t *testing.T) {
+	db := pgtest.NewGormDB(t)
+	require.NoError(t, db.Exec(`INSERT INTO evm_chains (id, cfg, enabled, created_at, updated_at) VALUES (1337001, '{}', false, NOW(), NOW())`).Error)
+
+	cfg := config.NewEVMConfig(config.NewGeneralConfigWithChainID("1337001"))
+	require.True(t, cfg.EvmChainEnabled())
+	require.False(t, cfg.EthereumDisabled())
+
+	cfg.SetDB(db)
+	require.NoError(t, cfg.ReloadPersistedConfig())
+	require.False(t, cfg.EvmChainEnabled())
+	require.True(t, cfg.EthereumDisabled())
+	require.False(t, cfg.BalanceMonitorEnabled())
+
+	require.NoError(t, db.Exec(`UPDATE evm_chains SET enabled = true WHERE id = 1337001`).Error)
+	require.NoError(t, cfg.ReloadPersistedConfig())
+	require.True(t, cfg.EvmChainEnabled())
+	require.False(t, cfg.EthereumDisabled())
+
+	// A chain with no row is enabled
+	missing := config.NewEVMConfig(config.NewGeneralConfigWithChainID("1337002"))
+	missing.SetDB(db)
+	require.True(t, errors.Is(missing.ReloadPersistedConfig(), config.ErrChainNotFound))
+	require.True(t, missing.EvmChainEnabled())
+}
+
+func TestEVMConfig_SetEvmGasPriceDefault_ChainCfgHoldsKey(t *testing.T) {
+	db := pgtest.NewGormDB(t)
+	require.NoError(t, db.Exec(`INSERT INTO evm_chains (id, cfg, created_at, updated_at) VALUES (1337001, '{"EvmGasPriceDefault": "20000000000"}', NOW(), NOW())`).Error)
+
+	cfg := config.NewEVMConfig(config.NewGeneralConfigWithChainID("1337001"))
+	cfg.SetDB(db)
+	require.NoError(t, cfg.ReloadPersistedConfig())
+	require.Equal(t, big.NewInt(20000000000), cfg.EvmGasPriceDefault())
+
+	newValue := big.NewInt(30000000000)
+	require.NoError(t, cfg.SetEvmGasPriceDefault(newValue))
+	require.Equal(t, newValue, cfg.EvmGasPriceDefault())
+
+	// The value was written to the chain's cfg, so it survives a reload
+	require.NoError(t, cfg.ReloadPersistedConfig())
+	require.Equal(t, newValue, cfg.EvmGasPriceDefault())
+}
+
+func TestEVMConfig_SetEvmGasPriceDefault_V1ChainCfg(t *testing.T) {
+	db := pgtest.NewGormDB(t)
+	// Stored before EthGasPriceDefault was renamed to EvmGasPriceDefault
+	require.NoError(t, db.Exec(`INSERT INTO evm_chains (id, cfg, created_at, updated_at) VALUES (1337001, '{"cfgVersion": 1, "EthGasPriceDefault": "20000000000"}', NOW(), NOW())`).Error)
+
+	cfg := config.NewEVMConfig(config.NewGeneralConfigWithChainID("1337001"))
+	cfg.SetDB(db)
+	require.NoError(t, cfg.ReloadPersistedConfig())
+	require.Equal(t, big.NewInt(20000000000), cfg.EvmGasPriceDefault())
+
+	newValue := big.NewInt(30000000000)
+	require.NoError(t, cfg.SetEvmGasPriceDefault(newValue))
+
+	// A restarted node sees the new value rather than the stale one
+	restarted := config.NewEVMConfig(config.NewGeneralConfigWithChainID("1337001"))
+	restarted.SetDB(db)
+	require.NoError(t, restarted.ReloadPersistedConfig())
+	require.Equal(t, newValue, restarted.EvmGasPriceDefault())
+
+	history, err := config.NewORM(db).ConfigHistory(big.NewInt(1337001), "EvmGasPriceDefault")
+	require.NoError(t, err)
+	require.Len(t, history, 1)
+	require.Equal(t, "20000000000", history[0].OldValue.String)
+}
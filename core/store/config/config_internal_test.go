@@ -1,14 +1,23 @@
 package config
 
 import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
 	"math/big"
 	"net/url"
+	"os"
+	"reflect"
 	"testing"
 	"time"
 
+	"github.com/smartcontractkit/chainlink/core/assets"
 	"github.com/smartcontractkit/chainlink/core/chains"
+	"github.com/smartcontractkit/chainlink/core/services/eth"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
 	"github.com/spf13/viper"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -20,8 +29,10 @@ func TestGeneralConfig_Defaults(t *testing.T) {
 	assert.Equal(t, uint64(10), config.BlockBackfillDepth())
 	assert.Equal(t, new(url.URL), config.BridgeResponseURL())
 	assert.Equal(t, big.NewInt(1), config.ChainID())
+	assert.Equal(t, time.Duration(0), config.ConfigRevalidationInterval())
 	assert.Equal(t, false, config.EthereumDisabled())
 	assert.Equal(t, false, config.FeatureExternalInitiators())
+	assert.Equal(t, uint32(0), config.GlobalMaxInFlightTransactions())
 	assert.Equal(t, 15*time.Minute, config.SessionTimeout().Duration())
 }
 
@@ -57,6 +68,47 @@ func newEVMConfig(f func(c *generalConfig)) *evmConfig {
 	return config
 }
 
+func TestEVMConfig_EnvOnly(t *testing.T) {
+	t.Parallel()
+
+	config := NewEVMConfigEnvOnly(NewGeneralConfig(), big.NewInt(137)).(*evmConfig)
+	assert.Equal(t, big.NewInt(137), config.ChainID())
+	assert.Equal(t, chains.ChainFromID(big.NewInt(137)), config.Chain())
+
+	// Persisted values are ignored, and setters do nothing
+	config.chainCfg = map[string]json.RawMessage{
+		"EvmGasPriceDefault": json.RawMessage(`"1"`),
+		"EvmCallTimeout":     json.RawMessage(`"1m"`),
+	}
+	config.persisted = map[string]string{"EvmMaxGasPriceWei": "1"}
+	require.NoError(t, config.SetEvmGasPriceDefault(assets.GWei(42)))
+	require.NoError(t, config.SetEvmMaxGasPriceWei(context.Background(), assets.GWei(4200)))
+	require.NoError(t, config.ApplyTOML([]byte(`EvmConfirmerConcurrency = 7`)))
+	require.NoError(t, config.ReloadPersistedConfig())
+	assert.NoError(t, config.ValidatePersisted())
+
+	// So every getter matches a config for the same chain with nothing persisted
+	reference := newEVMConfigWithChainID("137")
+	iface := reflect.TypeOf((*EVMOnlyConfig)(nil)).Elem()
+	for i := 0; i < iface.NumMethod(); i++ {
+		method := iface.Method(i)
+		switch method.Name {
+		case "Close", "ReloadPersistedConfig", "Validate", "ValidatePersisted":
+			continue
+		}
+		if method.Type.NumIn() != 0 {
+			continue
+		}
+		t.Run(method.Name, func(t *testing.T) {
+			want := reflect.ValueOf(reference).MethodByName(method.Name).Call(nil)
+			got := reflect.ValueOf(config).MethodByName(method.Name).Call(nil)
+			for j := range want {
+				assert.Equal(t, want[j].Interface(), got[j].Interface())
+			}
+		})
+	}
+}
+
 func TestEVMConfig_ChainSpecificConfig(t *testing.T) {
 	t.Parallel()
 
@@ -95,6 +147,671 @@ func TestEVMConfig_ChainSpecificConfig(t *testing.T) {
 	})
 }
 
+type mapConfigSource map[string]string
+
+func (m mapConfigSource) Lookup(key string) (string, bool) {
+	v, ok := m[key]
+	return v, ok
+}
+
+func TestEVMConfig_ChainID(t *testing.T) {
+	config := newEVMConfigWithChainID("42")
+
+	id := config.ChainID()
+	assert.Equal(t, big.NewInt(42), id)
+
+	// Callers get a copy, so mutating it does not change the scoped chain
+	id.SetInt64(1)
+	assert.Equal(t, big.NewInt(42), config.ChainID())
+}
+
+func TestEVMConfig_ConfigSource(t *testing.T) {
+	t.Parallel()
+
+	gcfg := NewGeneralConfig()
+	source := mapConfigSource{
+		"ETH_GAS_BUMP_PERCENT":  "25",
+		"ETH_MAX_GAS_PRICE_WEI": "not a number",
+	}
+	config := NewEVMConfigWithSource(gcfg, source)
+
+	assert.Equal(t, uint16(25), config.EvmGasBumpPercent())
+	// Invalid values fall back to the chain default
+	assert.Equal(t, gcfg.Chain().Config().MaxGasPriceWei, *config.EvmMaxGasPriceWei())
+	// Keys missing from the source use the chain default
+	assert.Equal(t, gcfg.Chain().Config().GasBumpWei, *config.EvmGasBumpWei())
+}
+
+func TestEVMConfig_ClampGasPrice(t *testing.T) {
+	t.Parallel()
+
+	config := NewEVMConfigWithSource(NewGeneralConfig(), mapConfigSource{
+		"ETH_MIN_GAS_PRICE_WEI": "100",
+		"ETH_MAX_GAS_PRICE_WEI": "200",
+	})
+
+	assert.Equal(t, big.NewInt(100), config.ClampGasPrice(big.NewInt(99)))
+	assert.Equal(t, big.NewInt(200), config.ClampGasPrice(big.NewInt(201)))
+	assert.Equal(t, big.NewInt(100), config.ClampGasPrice(big.NewInt(100)))
+	assert.Equal(t, big.NewInt(150), config.ClampGasPrice(big.NewInt(150)))
+	assert.Equal(t, big.NewInt(200), config.ClampGasPrice(big.NewInt(200)))
+}
+
+func TestEVMConfig_CachedGasPriceDefaults(t *testing.T) {
+	t.Parallel()
+
+	config := newEVMConfigWithChainID("0")
+
+	// Chain defaults are allocated once and shared between calls
+	assert.Same(t, config.EvmMaxGasPriceWei(), config.EvmMaxGasPriceWei())
+	assert.Same(t, config.EvmMinGasPriceWei(), config.EvmMinGasPriceWei())
+	assert.Same(t, config.EvmGasBumpWei(), config.EvmGasBumpWei())
+	assert.Same(t, config.EvmGasPriceDefault(), config.EvmGasPriceDefault())
+
+	// Prices derived from them are copies, so callers may mutate them freely
+	clamped := config.ClampGasPrice(new(big.Int).Add(config.EvmMaxGasPriceWei(), big.NewInt(1)))
+	clamped.SetInt64(1)
+	assert.Equal(t, chains.FallbackConfig.MaxGasPriceWei, *config.EvmMaxGasPriceWei())
+}
+
+func BenchmarkEVMConfig_EvmMaxGasPriceWei(b *testing.B) {
+	config := newEVMConfigWithChainID("0")
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		config.EvmMaxGasPriceWei()
+	}
+}
+
+func TestEVMConfig_EvmDisabledServices(t *testing.T) {
+	t.Parallel()
+
+	config := newEVMConfigWithChainID("0")
+	assert.Empty(t, config.EvmDisabledServices())
+	assert.True(t, config.BalanceMonitorEnabled())
+
+	config = NewEVMConfigWithSource(NewGeneralConfig(), mapConfigSource{
+		"ETH_DISABLED_SERVICES": " balance_monitor, log_poller,,not_a_service ",
+	}).(*evmConfig)
+
+	assert.Equal(t, []string{"balance_monitor", "log_poller", "not_a_service"}, config.EvmDisabledServices())
+	assert.True(t, config.EvmServiceDisabled(EvmServiceBalanceMonitor))
+	assert.True(t, config.EvmServiceDisabled(EvmServiceLogPoller))
+	assert.False(t, config.EvmServiceDisabled(EvmServiceHeadTracker))
+	assert.False(t, config.EvmServiceDisabled(EvmServiceTxBroadcaster))
+	assert.False(t, config.BalanceMonitorEnabled())
+	// Unknown names only produce a warning
+	assert.NoError(t, config.validate())
+}
+
+func TestApplyGasLimitMultiplier(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		gasLimit   uint64
+		multiplier float32
+		expected   uint64
+	}{
+		{"multiplier of 1", 100000, 1.0, 100000},
+		{"exact product", 100000, 1.1, 110000},
+		{"fractional product rounds up", 10, 1.05, 11},
+		{"multiplier below 1 rounds up", 3, 0.5, 2},
+		{"L2 default gas limit of 1", 1, 1.5, 2},
+		{"zero gas limit", 0, 2, 0},
+		{"zero multiplier", 100, 0, 0},
+		{"negative multiplier", 100, -1, 0},
+		{"NaN multiplier", 100, float32(math.NaN()), 100},
+		{"infinite multiplier", 100, float32(math.Inf(1)), math.MaxUint64},
+		{"max gas limit", math.MaxUint64, 1, math.MaxUint64},
+		{"overflow saturates", math.MaxUint64, 2, math.MaxUint64},
+		{"beyond int64", math.MaxInt64, 1.5, 13835058055282163711},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, ApplyGasLimitMultiplier(test.gasLimit, test.multiplier))
+		})
+	}
+
+	config := NewEVMConfigWithSource(NewGeneralConfig(), mapConfigSource{"ETH_GAS_LIMIT_MULTIPLIER": "1.05"})
+	assert.Equal(t, uint64(11), config.ApplyGasLimitMultiplier(10))
+}
+
+func TestEVMConfig_EvmGasLimitMultiplier_Validation(t *testing.T) {
+	t.Parallel()
+
+	for _, m := range []string{"0", "-1", "NaN", "Inf"} {
+		config := NewEVMConfigWithSource(NewGeneralConfig(), mapConfigSource{"ETH_GAS_LIMIT_MULTIPLIER": m}).(*evmConfig)
+		err := config.validate()
+		require.Error(t, err, m)
+		assert.Contains(t, err.Error(), "ETH_GAS_LIMIT_MULTIPLIER must be a positive number")
+	}
+}
+
+func TestEVMConfig_SetEvmMaxGasPriceWei(t *testing.T) {
+	t.Parallel()
+
+	config := newEVMConfigWithChainID("0")
+
+	err := config.SetEvmMaxGasPriceWei(context.Background(), big.NewInt(1))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "below the minimum gas price")
+}
+
+func TestEVMConfig_ValidatePersisted_NoORM(t *testing.T) {
+	t.Parallel()
+
+	assert.NoError(t, newEVMConfigWithChainID("0").ValidatePersisted())
+}
+
+func TestEVMConfig_dropConflictingPersisted(t *testing.T) {
+	t.Parallel()
+
+	t.Run("keeps values that validate", func(t *testing.T) {
+		config := newEVMConfigWithChainID("1337")
+		persisted := map[string]string{"EvmMaxGasPriceWei": "10000000000000", "OCRContractConfirmations": "1"}
+
+		p, cc, err := config.dropConflictingPersisted(persisted, nil)
+		require.NoError(t, err)
+		assert.Equal(t, persisted, p)
+		assert.Empty(t, cc)
+	})
+
+	t.Run("drops a persisted max gas price below the default", func(t *testing.T) {
+		config := newEVMConfigWithChainID("1337")
+		persisted := map[string]string{"EvmMaxGasPriceWei": "1", "EvmGasLimitTransfer": "30000"}
+
+		p, _, err := config.dropConflictingPersisted(persisted, nil)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "ETH_MAX_GAS_PRICE_WEI must be greater than or equal to ETH_GAS_PRICE_DEFAULT")
+		assert.Contains(t, err.Error(), "persisted values for ETH_MAX_GAS_PRICE_WEI conflict")
+		assert.Equal(t, map[string]string{"EvmGasLimitTransfer": "30000"}, p)
+	})
+
+	t.Run("drops conflicting values from the chain cfg", func(t *testing.T) {
+		config := newEVMConfigWithChainID("1337")
+		chainCfg := map[string]json.RawMessage{"OCRContractConfirmations": json.RawMessage(`"1000"`)}
+
+		_, cc, err := config.dropConflictingPersisted(nil, chainCfg)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "OCR_CONTRACT_CONFIRMATIONS must be between 1 and ETH_FINALITY_DEPTH")
+		assert.Empty(t, cc)
+	})
+
+	t.Run("drops a burst of 0 when rate limited", func(t *testing.T) {
+		config := newEVMConfigWithChainID("1337")
+		persisted := map[string]string{"NodeRateLimitRPS": "10", "NodeRateLimitBurst": "0"}
+
+		p, _, err := config.dropConflictingPersisted(persisted, nil)
+		require.Error(t, err)
+		assert.Equal(t, map[string]string{"NodeRateLimitRPS": "10"}, p)
+	})
+
+	t.Run("ignores failures that env and chain defaults already have", func(t *testing.T) {
+		config := newEVMConfigWithChainID("0")
+		config.GeneralConfig.(*generalConfig).viper.Set("ETH_URL", "")
+		persisted := map[string]string{"EvmGasLimitTransfer": "30000"}
+
+		p, _, err := config.dropConflictingPersisted(persisted, nil)
+		require.NoError(t, err)
+		assert.Equal(t, persisted, p)
+	})
+
+	t.Run("reported by ValidatePersisted", func(t *testing.T) {
+		config := newEVMConfigWithChainID("1337")
+		_, _, config.persistedConflicts = config.dropConflictingPersisted(map[string]string{"EvmMaxGasPriceWei": "1"}, nil)
+
+		err := config.ValidatePersisted()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "ETH_MAX_GAS_PRICE_WEI")
+	})
+}
+
+func TestEVMConfig_NodeRateLimit(t *testing.T) {
+	t.Run("defaults to unlimited", func(t *testing.T) {
+		config := newEVMConfigWithChainID("1337")
+
+		rps, burst := config.NodeRateLimit()
+		assert.Equal(t, float64(0), rps)
+		assert.Equal(t, 1, burst)
+		assert.NoError(t, config.validate())
+	})
+
+	t.Run("reads from env", func(t *testing.T) {
+		os.Setenv("ETH_NODE_RATE_LIMIT_RPS", "2.5")
+		defer os.Unsetenv("ETH_NODE_RATE_LIMIT_RPS")
+		os.Setenv("ETH_NODE_RATE_LIMIT_BURST", "10")
+		defer os.Unsetenv("ETH_NODE_RATE_LIMIT_BURST")
+		config := newEVMConfigWithChainID("1337")
+
+		rps, burst := config.NodeRateLimit()
+		assert.Equal(t, 2.5, rps)
+		assert.Equal(t, 10, burst)
+		assert.NoError(t, config.validate())
+	})
+
+	t.Run("falls back to the default burst when a persisted burst of 0 is rate limited", func(t *testing.T) {
+		config := newEVMConfigWithChainID("1337")
+		config.persisted = map[string]string{"NodeRateLimitRPS": "10", "NodeRateLimitBurst": "0"}
+
+		rps, burst := config.NodeRateLimit()
+		assert.Equal(t, float64(10), rps)
+		assert.Equal(t, 1, burst)
+	})
+
+	t.Run("requires a burst of at least 1 when rate limited", func(t *testing.T) {
+		os.Setenv("ETH_NODE_RATE_LIMIT_RPS", "2.5")
+		defer os.Unsetenv("ETH_NODE_RATE_LIMIT_RPS")
+		os.Setenv("ETH_NODE_RATE_LIMIT_BURST", "0")
+		defer os.Unsetenv("ETH_NODE_RATE_LIMIT_BURST")
+		config := newEVMConfigWithChainID("0")
+
+		assert.Contains(t, config.validate().Error(), "ETH_NODE_RATE_LIMIT_BURST must be greater than or equal to 1")
+	})
+}
+
+func TestEVMConfig_EvmHeadTrackerMaxBufferSize(t *testing.T) {
+	config := newEVMConfigWithChainID("1337")
+	assert.Equal(t, uint(3), config.EvmHeadTrackerMaxBufferSize())
+	assert.NoError(t, config.validate())
+
+	os.Setenv("ETH_HEAD_TRACKER_MAX_BUFFER_SIZE", "0")
+	defer os.Unsetenv("ETH_HEAD_TRACKER_MAX_BUFFER_SIZE")
+
+	assert.Equal(t, uint(0), config.EvmHeadTrackerMaxBufferSize())
+	assert.Contains(t, config.validate().Error(), "ETH_HEAD_TRACKER_MAX_BUFFER_SIZE must be greater than or equal to 1")
+}
+
+func TestEVMConfig_EvmGasBumpStrategy(t *testing.T) {
+	t.Parallel()
+
+	config := newEVMConfigWithChainID("1")
+	assert.Equal(t, GasBumpStrategyMax, config.EvmGasBumpStrategy())
+
+	for _, strategy := range []string{GasBumpStrategyMax, GasBumpStrategyPercent, GasBumpStrategyWei} {
+		config = NewEVMConfigWithSource(NewGeneralConfig(), mapConfigSource{"ETH_GAS_BUMP_STRATEGY": strategy}).(*evmConfig)
+		assert.Equal(t, strategy, config.EvmGasBumpStrategy())
+		assert.NoError(t, config.validate())
+	}
+
+	// ETH_GAS_BUMP_PERCENT is not used by the wei strategy
+	config = NewEVMConfigWithSource(NewGeneralConfig(), mapConfigSource{
+		"ETH_GAS_BUMP_STRATEGY": "wei",
+		"ETH_GAS_BUMP_PERCENT":  "1",
+	}).(*evmConfig)
+	assert.NoError(t, config.validate())
+
+	config = NewEVMConfigWithSource(NewGeneralConfig(), mapConfigSource{"ETH_GAS_BUMP_STRATEGY": "exponential"}).(*evmConfig)
+	err := config.validate()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `ETH_GAS_BUMP_STRATEGY must be one of "max", "percent" or "wei", got: "exponential"`)
+}
+
+func TestEVMConfig_EvmHeadTrackerBackfillDepth(t *testing.T) {
+	t.Parallel()
+
+	config := newEVMConfigWithChainID("1")
+	assert.Equal(t, config.EvmHeadTrackerHistoryDepth(), config.EvmHeadTrackerBackfillDepth())
+
+	config = NewEVMConfigWithSource(NewGeneralConfig(), mapConfigSource{
+		"ETH_HEAD_TRACKER_HISTORY_DEPTH":  "200",
+		"ETH_HEAD_TRACKER_BACKFILL_DEPTH": "50",
+	}).(*evmConfig)
+	assert.Equal(t, uint(200), config.EvmHeadTrackerHistoryDepth())
+	assert.Equal(t, uint(50), config.EvmHeadTrackerBackfillDepth())
+	assert.NoError(t, config.validate())
+
+	config = NewEVMConfigWithSource(NewGeneralConfig(), mapConfigSource{
+		"ETH_HEAD_TRACKER_HISTORY_DEPTH":  "200",
+		"ETH_HEAD_TRACKER_BACKFILL_DEPTH": "201",
+	}).(*evmConfig)
+	err := config.validate()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "ETH_HEAD_TRACKER_BACKFILL_DEPTH must be less than or equal to ETH_HEAD_TRACKER_HISTORY_DEPTH")
+}
+
+func TestEVMConfig_EvmHeadTrackerMaxReorgDepth(t *testing.T) {
+	t.Parallel()
+
+	config := newEVMConfigWithChainID("1")
+	assert.Equal(t, uint(0), config.EvmHeadTrackerMaxReorgDepth())
+	assert.NoError(t, config.validate())
+
+	config = NewEVMConfigWithSource(NewGeneralConfig(), mapConfigSource{
+		"ETH_FINALITY_DEPTH":               "50",
+		"ETH_HEAD_TRACKER_MAX_REORG_DEPTH": "500",
+	}).(*evmConfig)
+	assert.Equal(t, uint(500), config.EvmHeadTrackerMaxReorgDepth())
+	// Greater than history depth only warns, since the tracker retains enough heads regardless
+	assert.NoError(t, config.validate())
+
+	config = NewEVMConfigWithSource(NewGeneralConfig(), mapConfigSource{
+		"ETH_FINALITY_DEPTH":               "50",
+		"ETH_HEAD_TRACKER_MAX_REORG_DEPTH": "50",
+	}).(*evmConfig)
+	err := config.validate()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "ETH_HEAD_TRACKER_MAX_REORG_DEPTH must be 0 or greater than ETH_FINALITY_DEPTH")
+}
+
+func TestEVMConfig_NodeCircuitBreaker(t *testing.T) {
+	t.Parallel()
+
+	config := newEVMConfigWithChainID("1")
+	assert.Equal(t, uint32(0), config.NodeCircuitBreakerThreshold())
+	assert.Equal(t, time.Minute, config.NodeCircuitBreakerCooldown())
+	assert.NoError(t, config.validate())
+
+	config = NewEVMConfigWithSource(NewGeneralConfig(), mapConfigSource{
+		"ETH_NODE_CIRCUIT_BREAKER_THRESHOLD": "5",
+		"ETH_NODE_CIRCUIT_BREAKER_COOLDOWN":  "30s",
+	}).(*evmConfig)
+	assert.Equal(t, uint32(5), config.NodeCircuitBreakerThreshold())
+	assert.Equal(t, 30*time.Second, config.NodeCircuitBreakerCooldown())
+	assert.NoError(t, config.validate())
+
+	config = NewEVMConfigWithSource(NewGeneralConfig(), mapConfigSource{
+		"ETH_NODE_CIRCUIT_BREAKER_THRESHOLD": "5",
+		"ETH_NODE_CIRCUIT_BREAKER_COOLDOWN":  "0s",
+	}).(*evmConfig)
+	err := config.validate()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "ETH_NODE_CIRCUIT_BREAKER_COOLDOWN must be greater than 0 if ETH_NODE_CIRCUIT_BREAKER_THRESHOLD is set")
+}
+
+func TestEVMConfig_SendOnlyNodeMinAccepts(t *testing.T) {
+	t.Parallel()
+
+	config := newEVMConfigWithChainID("1")
+	assert.Equal(t, uint32(0), config.SendOnlyNodeMinAccepts())
+	assert.NoError(t, config.validate())
+
+	newConfig := func(minAccepts string) *evmConfig {
+		gcfg := NewGeneralConfig()
+		gcfg.(*generalConfig).viper.Set("ETH_SECONDARY_URLS", "http://localhost:8545,http://localhost:8546")
+		return NewEVMConfigWithSource(gcfg, mapConfigSource{"ETH_SEND_ONLY_NODE_MIN_ACCEPTS": minAccepts}).(*evmConfig)
+	}
+
+	config = newConfig("2")
+	assert.Equal(t, uint32(2), config.SendOnlyNodeMinAccepts())
+	assert.NoError(t, config.validate())
+
+	config = newConfig("3")
+	err := config.validate()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "ETH_SEND_ONLY_NODE_MIN_ACCEPTS must not be greater than the number of ETH_SECONDARY_URLS (2), got: 3")
+}
+
+func TestEVMConfig_EvmHeadTrackerSamplingMode(t *testing.T) {
+	t.Parallel()
+
+	config := newEVMConfigWithChainID("1")
+	assert.Equal(t, HeadTrackerSamplingModeFixed, config.EvmHeadTrackerSamplingMode())
+
+	config = NewEVMConfigWithSource(NewGeneralConfig(), mapConfigSource{"ETH_HEAD_TRACKER_SAMPLING_MODE": "adaptive"}).(*evmConfig)
+	assert.Equal(t, HeadTrackerSamplingModeAdaptive, config.EvmHeadTrackerSamplingMode())
+	assert.NoError(t, config.validate())
+
+	config = NewEVMConfigWithSource(NewGeneralConfig(), mapConfigSource{"ETH_HEAD_TRACKER_SAMPLING_MODE": "dynamic"}).(*evmConfig)
+	err := config.validate()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `ETH_HEAD_TRACKER_SAMPLING_MODE must be "fixed" or "adaptive", got: "dynamic"`)
+}
+
+func TestEVMConfig_EvmConfirmerConcurrency(t *testing.T) {
+	t.Parallel()
+
+	config := newEVMConfigWithChainID("0")
+	assert.Equal(t, uint32(1), config.EvmConfirmerConcurrency())
+
+	config = NewEVMConfigWithSource(NewGeneralConfig(), mapConfigSource{"ETH_CONFIRMER_CONCURRENCY": "4"}).(*evmConfig)
+	assert.Equal(t, uint32(4), config.EvmConfirmerConcurrency())
+	assert.NoError(t, config.validate())
+
+	config = NewEVMConfigWithSource(NewGeneralConfig(), mapConfigSource{"ETH_CONFIRMER_CONCURRENCY": "0"}).(*evmConfig)
+	err := config.validate()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "ETH_CONFIRMER_CONCURRENCY must be greater than or equal to 1")
+}
+
+func TestEVMConfig_EvmBalanceMonitorBlockDelay(t *testing.T) {
+	t.Parallel()
+
+	config := newEVMConfigWithChainID("1")
+	assert.Equal(t, uint16(1), config.EvmBalanceMonitorBlockDelay())
+	assert.NoError(t, config.validate())
+
+	config = NewEVMConfigWithSource(NewGeneralConfig(), mapConfigSource{"ETH_BALANCE_MONITOR_BLOCK_DELAY": "5"}).(*evmConfig)
+	assert.Equal(t, uint16(5), config.EvmBalanceMonitorBlockDelay())
+	assert.NoError(t, config.validate())
+
+	config.chainCfg = map[string]json.RawMessage{"EvmBalanceMonitorBlockDelay": json.RawMessage(`"7"`)}
+	assert.Equal(t, uint16(7), config.EvmBalanceMonitorBlockDelay())
+	assert.NoError(t, config.ValidatePersisted())
+
+	config = NewEVMConfigWithSource(NewGeneralConfig(), mapConfigSource{
+		"ETH_BALANCE_MONITOR_BLOCK_DELAY": "11",
+		"ETH_HEAD_TRACKER_HISTORY_DEPTH":  "10",
+	}).(*evmConfig)
+	err := config.validate()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "ETH_BALANCE_MONITOR_BLOCK_DELAY must be less than or equal to ETH_HEAD_TRACKER_HISTORY_DEPTH")
+}
+
+func TestEVMConfig_EvmCallTimeout(t *testing.T) {
+	t.Parallel()
+
+	config := newEVMConfigWithChainID("1")
+	assert.Equal(t, eth.DefaultQueryTimeout, config.EvmCallTimeout())
+	assert.NoError(t, config.validate())
+
+	config = NewEVMConfigWithSource(NewGeneralConfig(), mapConfigSource{"ETH_CALL_TIMEOUT": "1m"}).(*evmConfig)
+	assert.Equal(t, time.Minute, config.EvmCallTimeout())
+	assert.NoError(t, config.validate())
+
+	config.chainCfg = map[string]json.RawMessage{"EvmCallTimeout": json.RawMessage(`"2m"`)}
+	assert.Equal(t, 2*time.Minute, config.EvmCallTimeout())
+
+	config.chainCfg = map[string]json.RawMessage{"EvmCallTimeout": json.RawMessage(`"0s"`)}
+	assert.Error(t, config.ValidatePersisted())
+
+	// Durations may also be persisted as a number of nanoseconds
+	config.chainCfg = map[string]json.RawMessage{"EvmCallTimeout": json.RawMessage(`180000000000`)}
+	assert.Equal(t, 3*time.Minute, config.EvmCallTimeout())
+	assert.NoError(t, config.ValidatePersisted())
+
+	config = NewEVMConfigWithSource(NewGeneralConfig(), mapConfigSource{"ETH_CALL_TIMEOUT": "0s"}).(*evmConfig)
+	err := config.validate()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "ETH_CALL_TIMEOUT must be greater than 0")
+}
+
+func TestEVMConfig_EvmUseFinalityTag(t *testing.T) {
+	t.Parallel()
+
+	config := newEVMConfigWithChainID("1")
+	assert.False(t, config.EvmUseFinalityTag())
+	assert.True(t, config.chainSpecificConfig.FinalityTagSupported)
+
+	gcfg := NewGeneralConfig()
+	gcfg.(*generalConfig).viper.Set("ETH_CHAIN_ID", "1")
+	config = NewEVMConfigWithSource(gcfg, mapConfigSource{"ETH_USE_FINALITY_TAG": "true"}).(*evmConfig)
+	assert.True(t, config.EvmUseFinalityTag())
+	assert.NoError(t, config.validate())
+
+	// Chains not known to support the tag only produce a warning
+	gcfg = NewGeneralConfig()
+	gcfg.(*generalConfig).viper.Set("ETH_CHAIN_ID", "1337")
+	config = NewEVMConfigWithSource(gcfg, mapConfigSource{"ETH_USE_FINALITY_TAG": "true"}).(*evmConfig)
+	assert.False(t, config.chainSpecificConfig.FinalityTagSupported)
+	assert.True(t, config.EvmUseFinalityTag())
+	assert.NoError(t, config.validate())
+}
+
+func TestEVMConfig_EvmMaxInFlightTransactions_Validation(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		maxInFlight string
+		bumpDepth   string
+		valid       bool
+	}{
+		{"an unlimited in-flight limit accepts any bump depth", "0", "5", true},
+		{"bump depth above the in-flight limit is rejected", "3", "5", false},
+		{"bump depth within the in-flight limit is accepted", "10", "5", true},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			config := NewEVMConfigWithSource(NewGeneralConfig(), mapConfigSource{
+				"ETH_MAX_IN_FLIGHT_TRANSACTIONS": test.maxInFlight,
+				"ETH_GAS_BUMP_TX_DEPTH":          test.bumpDepth,
+			}).(*evmConfig)
+			err := config.validate()
+			if test.valid {
+				assert.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "ETH_GAS_BUMP_TX_DEPTH must be less than or equal to ETH_MAX_IN_FLIGHT_TRANSACTIONS")
+			}
+		})
+	}
+}
+
+func TestEVMConfig_RequiresPrimaryNode(t *testing.T) {
+	t.Parallel()
+
+	t.Run("primary present", func(t *testing.T) {
+		config := newEVMConfig(func(c *generalConfig) {
+			c.viper.Set("ETH_URL", "ws://localhost:8546")
+		})
+		assert.NoError(t, config.validate())
+	})
+
+	t.Run("only send-only nodes", func(t *testing.T) {
+		config := newEVMConfig(func(c *generalConfig) {
+			c.viper.Set("ETH_CHAIN_ID", "42")
+			c.viper.Set("ETH_URL", "")
+			c.viper.Set("ETH_SECONDARY_URLS", "http://localhost:8545")
+		})
+		err := config.validate()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "chain 42 is enabled but has no primary node")
+	})
+
+	t.Run("disabled chain is exempt", func(t *testing.T) {
+		config := newEVMConfig(func(c *generalConfig) {
+			c.viper.Set("ETH_URL", "")
+			c.viper.Set("ETH_DISABLED", true)
+		})
+		assert.NoError(t, config.validate())
+	})
+}
+
+func TestEVMConfig_IsTxFinal(t *testing.T) {
+	t.Parallel()
+
+	t.Run("arbitrum requires finality on both L2 and L1", func(t *testing.T) {
+		config := newEVMConfigWithChainID("42161")
+
+		require.Equal(t, uint(50), config.EvmFinalityDepth())
+		require.Equal(t, uint(50), config.L1FinalityDepth())
+		assert.False(t, config.IsTxFinal(49, 50))
+		assert.False(t, config.IsTxFinal(50, 49))
+		assert.False(t, config.IsTxFinal(50, 0))
+		assert.True(t, config.IsTxFinal(50, 50))
+		assert.True(t, config.IsTxFinal(100, 100))
+	})
+
+	t.Run("optimism is final on L2 after one block but still waits for L1", func(t *testing.T) {
+		config := newEVMConfigWithChainID("10")
+
+		require.Equal(t, uint(1), config.EvmFinalityDepth())
+		require.Equal(t, uint(50), config.L1FinalityDepth())
+		assert.False(t, config.IsTxFinal(0, 50))
+		assert.False(t, config.IsTxFinal(1, 49))
+		assert.True(t, config.IsTxFinal(1, 50))
+	})
+
+	t.Run("non-L2 chains ignore L1 depth", func(t *testing.T) {
+		config := newEVMConfigWithChainID("1")
+
+		assert.Equal(t, uint(0), config.L1FinalityDepth())
+		assert.False(t, config.IsTxFinal(49, 100))
+		assert.True(t, config.IsTxFinal(50, 0))
+	})
+}
+
+func TestEVMConfig_NextBumpedGasPrice(t *testing.T) {
+	gwei := func(n int64) *big.Int { return new(big.Int).Mul(big.NewInt(n), big.NewInt(1000000000)) }
+
+	t.Run("with overflow protection", func(t *testing.T) {
+		// Defaults: 20 gwei default price, 20% or 5 gwei bump, 5000 gwei max
+		config := newEVMConfigWithChainID("0")
+		require.True(t, config.EvmGasBumpOverflowProtection())
+		require.Equal(t, gwei(5000), config.EvmMaxGasPriceWei())
+
+		tests := []struct {
+			name       string
+			current    *big.Int
+			expected   *big.Int
+			maxReached bool
+		}{
+			{"below default uses default as baseline", gwei(1), gwei(25), false},
+			{"fixed increment wins for small prices", gwei(20), gwei(25), false},
+			{"percentage wins for large prices", gwei(100), gwei(120), false},
+			{"one wei below the boundary", big.NewInt(4166666666666), big.NewInt(4999999999999), false},
+			{"exactly at the boundary", big.NewInt(4166666666667), gwei(5000), true},
+			{"just above the boundary is clamped", big.NewInt(4166666666668), gwei(5000), true},
+			{"already at max stays at max", gwei(5000), gwei(5000), true},
+			{"above max is clamped to max", gwei(6000), gwei(5000), true},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				bumped, maxReached := config.NextBumpedGasPrice(tt.current)
+				assert.Equal(t, tt.expected.String(), bumped.String())
+				assert.Equal(t, tt.maxReached, maxReached)
+			})
+		}
+	})
+
+	t.Run("without overflow protection", func(t *testing.T) {
+		os.Setenv("ETH_GAS_BUMP_OVERFLOW_PROTECTION", "false")
+		defer os.Unsetenv("ETH_GAS_BUMP_OVERFLOW_PROTECTION")
+		config := newEVMConfigWithChainID("0")
+		require.False(t, config.EvmGasBumpOverflowProtection())
+
+		bumped, maxReached := config.NextBumpedGasPrice(big.NewInt(4166666666666))
+		assert.Equal(t, "4999999999999", bumped.String())
+		assert.False(t, maxReached)
+
+		bumped, maxReached = config.NextBumpedGasPrice(gwei(4500))
+		assert.Equal(t, gwei(5400).String(), bumped.String())
+		assert.True(t, maxReached)
+	})
+
+	t.Run("with a gas bump strategy", func(t *testing.T) {
+		config := NewEVMConfigWithSource(NewGeneralConfig(), mapConfigSource{"ETH_GAS_BUMP_STRATEGY": "percent"}).(*evmConfig)
+		bumped, _ := config.NextBumpedGasPrice(gwei(20))
+		assert.Equal(t, gwei(24).String(), bumped.String())
+
+		config = NewEVMConfigWithSource(NewGeneralConfig(), mapConfigSource{"ETH_GAS_BUMP_STRATEGY": "wei"}).(*evmConfig)
+		bumped, _ = config.NextBumpedGasPrice(gwei(100))
+		assert.Equal(t, gwei(105).String(), bumped.String())
+	})
+
+	t.Run("does not modify the current price", func(t *testing.T) {
+		config := newEVMConfigWithChainID("0")
+		current := gwei(100)
+
+		config.NextBumpedGasPrice(current)
+		assert.Equal(t, gwei(100), current)
+	})
+}
+
 func TestConfig_readFromFile(t *testing.T) {
 	v := viper.New()
 	v.Set("ROOT", "../../../tools/clroot/")
@@ -209,3 +926,917 @@ func TestStore_boolParser(t *testing.T) {
 	_, err = parseBool("")
 	assert.Error(t, err)
 }
+
+func TestEVMConfig_EvmFinalityViolationAction(t *testing.T) {
+	t.Parallel()
+
+	config := newEVMConfigWithChainID("1")
+	assert.Equal(t, FinalityViolationActionLog, config.EvmFinalityViolationAction())
+
+	config = NewEVMConfigWithSource(NewGeneralConfig(), mapConfigSource{"ETH_FINALITY_VIOLATION_ACTION": "halt"}).(*evmConfig)
+	assert.Equal(t, FinalityViolationActionHalt, config.EvmFinalityViolationAction())
+	assert.NoError(t, config.validate())
+
+	config = NewEVMConfigWithSource(NewGeneralConfig(), mapConfigSource{"ETH_FINALITY_VIOLATION_ACTION": "panic"}).(*evmConfig)
+	assert.EqualError(t, config.validate(), `ETH_FINALITY_VIOLATION_ACTION must be one of "log", "alert" or "halt", got: "panic"`)
+}
+
+func TestEVMConfig_EvmForceTxType(t *testing.T) {
+	t.Parallel()
+
+	config := newEVMConfigWithChainID("1")
+	assert.Equal(t, TxTypeAuto, config.EvmForceTxType())
+	assert.NoError(t, config.validate())
+	assert.Equal(t, TxTypeLegacy, newEVMConfigWithChainID("56").EvmForceTxType())
+
+	config = NewEVMConfigWithSource(NewGeneralConfig(), mapConfigSource{"ETH_FORCE_TX_TYPE": "0"}).(*evmConfig)
+	assert.Equal(t, TxTypeLegacy, config.EvmForceTxType())
+	assert.NoError(t, config.validate())
+
+	// Invalid persisted values are ignored in favour of the env
+	config.chainCfg = map[string]json.RawMessage{"EvmForceTxType": json.RawMessage(`1`)}
+	assert.Equal(t, TxTypeLegacy, config.EvmForceTxType())
+	// Including dynamic fee transactions, which cannot be built yet
+	config.chainCfg = map[string]json.RawMessage{"EvmForceTxType": json.RawMessage(`2`)}
+	assert.Equal(t, TxTypeLegacy, config.EvmForceTxType())
+
+	config = NewEVMConfigWithSource(NewGeneralConfig(), mapConfigSource{"ETH_FORCE_TX_TYPE": "2"}).(*evmConfig)
+	err := config.validate()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "ETH_FORCE_TX_TYPE of 2 requires EIP-1559 dynamic fees, which are not supported on chain")
+
+	config = NewEVMConfigWithSource(NewGeneralConfig(), mapConfigSource{"ETH_FORCE_TX_TYPE": "1"}).(*evmConfig)
+	err = config.validate()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "ETH_FORCE_TX_TYPE must be one of -1 (auto), 0 (legacy) or 2 (dynamic fee), got: 1")
+}
+
+func TestEVMConfig_EvmInsufficientFundsAction(t *testing.T) {
+	t.Parallel()
+
+	config := newEVMConfigWithChainID("1")
+	assert.Equal(t, InsufficientFundsActionRetry, config.EvmInsufficientFundsAction())
+
+	config = NewEVMConfigWithSource(NewGeneralConfig(), mapConfigSource{"ETH_INSUFFICIENT_FUNDS_ACTION": "pause"}).(*evmConfig)
+	assert.Equal(t, InsufficientFundsActionPause, config.EvmInsufficientFundsAction())
+	assert.NoError(t, config.validate())
+
+	config = NewEVMConfigWithSource(NewGeneralConfig(), mapConfigSource{"ETH_INSUFFICIENT_FUNDS_ACTION": "halt"}).(*evmConfig)
+	assert.EqualError(t, config.validate(), `ETH_INSUFFICIENT_FUNDS_ACTION must be "retry" or "pause", got: "halt"`)
+}
+
+func TestEVMConfig_NodeSelectionMode(t *testing.T) {
+	t.Parallel()
+
+	config := newEVMConfigWithChainID("1")
+	assert.Equal(t, NodeSelectionModeRoundRobin, config.NodeSelectionMode())
+
+	config = NewEVMConfigWithSource(NewGeneralConfig(), mapConfigSource{"ETH_NODE_SELECTION_MODE": "LowestLatency"}).(*evmConfig)
+	assert.Equal(t, NodeSelectionModeLowestLatency, config.NodeSelectionMode())
+	assert.NoError(t, config.validate())
+
+	config = NewEVMConfigWithSource(NewGeneralConfig(), mapConfigSource{"ETH_NODE_SELECTION_MODE": "Random"}).(*evmConfig)
+	assert.EqualError(t, config.validate(), `ETH_NODE_SELECTION_MODE must be "RoundRobin" or "LowestLatency", got: "Random"`)
+}
+
+func TestEVMConfig_EvmMaxNonceGap(t *testing.T) {
+	t.Parallel()
+
+	config := newEVMConfigWithChainID("1")
+	assert.Equal(t, uint64(0), config.EvmMaxNonceGap())
+	assert.Equal(t, NonceGapActionAlert, config.EvmNonceGapAction())
+
+	config = NewEVMConfigWithSource(NewGeneralConfig(), mapConfigSource{
+		"ETH_MAX_NONCE_GAP":    "5",
+		"ETH_NONCE_GAP_ACTION": "halt",
+	}).(*evmConfig)
+	assert.Equal(t, uint64(5), config.EvmMaxNonceGap())
+	assert.Equal(t, NonceGapActionHalt, config.EvmNonceGapAction())
+	assert.NoError(t, config.validate())
+
+	config = NewEVMConfigWithSource(NewGeneralConfig(), mapConfigSource{"ETH_NONCE_GAP_ACTION": "log"}).(*evmConfig)
+	assert.EqualError(t, config.validate(), `ETH_NONCE_GAP_ACTION must be "alert" or "halt", got: "log"`)
+}
+
+func TestEVMConfig_SetEvmGasPriceDefault_NoDB(t *testing.T) {
+	t.Parallel()
+
+	gcfg := NewGeneralConfig()
+	gcfg.SetDB(nil)
+	config := NewEVMConfig(gcfg).(*evmConfig)
+	original := config.EvmGasPriceDefault()
+	newValue := new(big.Int).Add(original, big.NewInt(1))
+
+	err := config.SetEvmGasPriceDefault(newValue)
+	require.True(t, errors.Is(err, ErrPersistenceDisabled))
+	assert.Equal(t, newValue, config.EvmGasPriceDefault())
+
+	// In-memory values are scoped to the config they were set on
+	assert.Equal(t, original, NewEVMConfig(gcfg).EvmGasPriceDefault())
+}
+
+func TestEVMConfig_SetEvmGasPriceDefault_Debounced(t *testing.T) {
+	t.Parallel()
+
+	gcfg := NewGeneralConfig()
+	gcfg.SetDB(nil)
+	config := NewEVMConfigWithSource(gcfg, mapConfigSource{"ETH_GAS_PRICE_DEFAULT_UPDATE_INTERVAL": "500ms"}).(*evmConfig)
+	assert.Equal(t, 500*time.Millisecond, config.EvmGasPriceDefaultUpdateInterval())
+
+	persisted := func() string {
+		config.persistedMu.RLock()
+		defer config.persistedMu.RUnlock()
+		return config.persisted["EvmGasPriceDefault"]
+	}
+
+	// The first update is persisted straight away
+	require.True(t, errors.Is(config.SetEvmGasPriceDefault(big.NewInt(2000000000)), ErrPersistenceDisabled))
+	assert.Equal(t, "2000000000", persisted())
+
+	// Later updates within the interval are visible immediately but not
+	// persisted yet
+	require.NoError(t, config.SetEvmGasPriceDefault(big.NewInt(3000000000)))
+	require.NoError(t, config.SetEvmGasPriceDefault(big.NewInt(4000000000)))
+	assert.Equal(t, big.NewInt(4000000000), config.EvmGasPriceDefault())
+	assert.Equal(t, "2000000000", persisted())
+
+	// Only the latest value is written once the interval has passed
+	assert.Eventually(t, func() bool { return persisted() == "4000000000" }, 5*time.Second, 10*time.Millisecond)
+	assert.Equal(t, big.NewInt(4000000000), config.EvmGasPriceDefault())
+}
+
+func TestEVMConfig_SetEvmGasPriceDefault_DebouncedClose(t *testing.T) {
+	t.Parallel()
+
+	gcfg := NewGeneralConfig()
+	gcfg.SetDB(nil)
+	config := NewEVMConfigWithSource(gcfg, mapConfigSource{"ETH_GAS_PRICE_DEFAULT_UPDATE_INTERVAL": "1h"}).(*evmConfig)
+
+	persisted := func() string {
+		config.persistedMu.RLock()
+		defer config.persistedMu.RUnlock()
+		return config.persisted["EvmGasPriceDefault"]
+	}
+
+	require.True(t, errors.Is(config.SetEvmGasPriceDefault(big.NewInt(2000000000)), ErrPersistenceDisabled))
+	require.NoError(t, config.SetEvmGasPriceDefault(big.NewInt(3000000000)))
+	assert.Equal(t, "2000000000", persisted())
+
+	// Close writes the deferred value and stops the timer
+	require.NoError(t, config.Close())
+	assert.Equal(t, "3000000000", persisted())
+	config.gasPriceDefaultMu.Lock()
+	assert.Nil(t, config.gasPriceDefaultTimer)
+	config.gasPriceDefaultMu.Unlock()
+
+	// Later values are written straight away
+	require.True(t, errors.Is(config.SetEvmGasPriceDefault(big.NewInt(4000000000)), ErrPersistenceDisabled))
+	assert.Equal(t, "4000000000", persisted())
+}
+
+// unpersistableConfig is a GeneralConfig that setPersisted cannot write to
+type unpersistableConfig struct {
+	GeneralConfig
+}
+
+func TestEVMConfig_SetEvmGasPriceDefault_DebouncedWriteFails(t *testing.T) {
+	t.Parallel()
+
+	gcfg := NewGeneralConfig()
+	gcfg.SetDB(nil)
+	config := NewEVMConfigWithSource(unpersistableConfig{gcfg}, mapConfigSource{"ETH_GAS_PRICE_DEFAULT_UPDATE_INTERVAL": "100ms"}).(*evmConfig)
+	original := config.EvmGasPriceDefault()
+
+	// A write that fails straight away is reported, and the value dropped
+	require.Error(t, config.SetEvmGasPriceDefault(big.NewInt(2000000000)))
+	assert.Equal(t, original, config.EvmGasPriceDefault())
+
+	// A deferred write that fails keeps the value and is retried
+	require.NoError(t, config.SetEvmGasPriceDefault(big.NewInt(3000000000)))
+	var timer *time.Timer
+	assert.Eventually(t, func() bool {
+		config.gasPriceDefaultMu.Lock()
+		defer config.gasPriceDefaultMu.Unlock()
+		if timer == nil {
+			timer = config.gasPriceDefaultTimer
+			return false
+		}
+		return config.gasPriceDefaultTimer != nil && config.gasPriceDefaultTimer != timer
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.Equal(t, big.NewInt(3000000000), config.EvmGasPriceDefault())
+
+	require.Error(t, config.Close())
+	assert.Equal(t, original, config.EvmGasPriceDefault())
+}
+
+func TestEVMConfig_NoDB(t *testing.T) {
+	t.Parallel()
+
+	gcfg := NewGeneralConfig()
+	gcfg.SetDB(nil)
+	config := NewEVMConfigWithSource(gcfg, mapConfigSource{"ETH_GAS_LIMIT_DEFAULT": "1000000"}).(*evmConfig)
+
+	// Env and chain defaults still apply
+	assert.Equal(t, uint64(1000000), config.EvmGasLimitDefault())
+	assert.Equal(t, config.chainSpecificConfig.FinalityDepth, config.EvmFinalityDepth())
+	assert.NoError(t, config.validate())
+	assert.NoError(t, config.ValidatePersisted())
+
+	err := config.ReloadPersistedConfig()
+	assert.True(t, errors.Is(err, ErrPersistenceDisabled))
+
+	err = config.SetEvmMaxGasPriceWei(context.Background(), new(big.Int).Add(config.EvmMaxGasPriceWei(), big.NewInt(1)))
+	assert.True(t, errors.Is(err, ErrPersistenceDisabled))
+}
+
+func TestEVMConfig_SetEvmGasPriceDefault_AutoWidenMax(t *testing.T) {
+	t.Parallel()
+
+	newConfig := func(src mapConfigSource) *evmConfig {
+		gcfg := NewGeneralConfig()
+		gcfg.SetDB(nil)
+		src["ETH_MIN_GAS_PRICE_WEI"] = "0"
+		src["ETH_GAS_PRICE_DEFAULT"] = "1000"
+		src["ETH_MAX_GAS_PRICE_WEI"] = "5000"
+		return NewEVMConfigWithSource(gcfg, src).(*evmConfig)
+	}
+
+	t.Run("disabled by default", func(t *testing.T) {
+		config := newConfig(mapConfigSource{})
+		assert.False(t, config.EvmGasPriceDefaultAutoWidenMax())
+		assert.EqualError(t, config.SetEvmGasPriceDefault(big.NewInt(6000)), "cannot set default gas price to 6000, it is above the maximum allowed value of 5000")
+		assert.Equal(t, big.NewInt(5000), config.EvmMaxGasPriceWei())
+		assert.NoError(t, config.validate())
+	})
+
+	t.Run("raises max up to the ceiling", func(t *testing.T) {
+		config := newConfig(mapConfigSource{
+			"ETH_GAS_PRICE_DEFAULT_AUTO_WIDEN_MAX": "true",
+			"ETH_MAX_GAS_PRICE_WEI_CEILING":        "10000",
+		})
+		require.NoError(t, config.validate())
+
+		require.True(t, errors.Is(config.SetEvmGasPriceDefault(big.NewInt(6000)), ErrPersistenceDisabled))
+		assert.Equal(t, big.NewInt(6000), config.EvmGasPriceDefault())
+		assert.Equal(t, big.NewInt(6000), config.EvmMaxGasPriceWei())
+
+		require.True(t, errors.Is(config.SetEvmGasPriceDefault(big.NewInt(10000)), ErrPersistenceDisabled))
+		assert.Equal(t, big.NewInt(10000), config.EvmMaxGasPriceWei())
+		// A max widened to the ceiling is still valid
+		assert.NoError(t, config.validate())
+
+		err := config.SetEvmGasPriceDefault(big.NewInt(10001))
+		assert.EqualError(t, err, "cannot set default gas price to 10001, it is above the maximum allowed value of 10000 and cannot be widened past the ceiling of 10000")
+		assert.Equal(t, big.NewInt(10000), config.EvmGasPriceDefault())
+	})
+
+	t.Run("ceiling must exceed the configured max", func(t *testing.T) {
+		config := newConfig(mapConfigSource{"ETH_GAS_PRICE_DEFAULT_AUTO_WIDEN_MAX": "true"})
+		err := config.validate()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "ETH_MAX_GAS_PRICE_WEI_CEILING must be greater than ETH_MAX_GAS_PRICE_WEI of 5000 if ETH_GAS_PRICE_DEFAULT_AUTO_WIDEN_MAX is enabled, got: 0")
+
+		config = newConfig(mapConfigSource{
+			"ETH_GAS_PRICE_DEFAULT_AUTO_WIDEN_MAX": "true",
+			"ETH_MAX_GAS_PRICE_WEI_CEILING":        "5000",
+		})
+		require.Error(t, config.validate())
+	})
+}
+
+func TestEVMConfig_ConfigOverrideConflicts(t *testing.T) {
+	t.Parallel()
+
+	gcfg := NewGeneralConfig()
+	gcfg.SetDB(nil)
+	config := NewEVMConfigWithSource(gcfg, mapConfigSource{
+		"ETH_MIN_GAS_PRICE_WEI":     "0",
+		"ETH_GAS_PRICE_DEFAULT":     "1000",
+		"ETH_MAX_GAS_PRICE_WEI":     "5000",
+		"ETH_CONFIRMER_CONCURRENCY": "2",
+	}).(*evmConfig)
+	assert.Empty(t, config.ConfigOverrideConflicts())
+
+	require.True(t, errors.Is(config.SetEvmGasPriceDefault(big.NewInt(2000)), ErrPersistenceDisabled))
+	require.True(t, errors.Is(config.SetEvmMaxGasPriceWei(context.Background(), big.NewInt(5000)), ErrPersistenceDisabled))
+	config.chainCfg = map[string]json.RawMessage{"EvmConfirmerConcurrency": json.RawMessage(`4`)}
+
+	// ETH_MAX_GAS_PRICE_WEI is persisted with the same value, so is not a conflict
+	assert.Equal(t, []ConflictReport{
+		{Field: "EvmConfirmerConcurrency", EnvVar: "ETH_CONFIRMER_CONCURRENCY", EnvValue: "2", PersistedValue: "4"},
+		{Field: "EvmGasPriceDefault", EnvVar: "ETH_GAS_PRICE_DEFAULT", EnvValue: "1000", PersistedValue: "2000"},
+	}, config.ConfigOverrideConflicts())
+}
+
+func TestChainCfgFromEnv(t *testing.T) {
+	t.Parallel()
+
+	cfg := chainCfgFromSource(mapConfigSource{
+		"ETH_GAS_PRICE_DEFAULT":         "1000",
+		"ETH_CONFIRMER_CONCURRENCY":     "4",
+		"ETH_USE_FINALITY_TAG":          "true",
+		"ETH_FINALITY_VIOLATION_ACTION": "alert",
+		"OCR_CONTRACT_POLL_INTERVAL":    "30s",
+		// Invalid values are left out
+		"ETH_MAX_GAS_PRICE_WEI": "-5",
+		"ETH_FORCE_TX_TYPE":     "1",
+		// Not persisted fields are ignored
+		"ETH_FINALITY_DEPTH": "10",
+	})
+	assert.Equal(t, chains.ChainCfgVersion, cfg.Version)
+	assert.Equal(t, map[string]json.RawMessage{
+		"EvmGasPriceDefault":         json.RawMessage(`"1000"`),
+		"EvmConfirmerConcurrency":    json.RawMessage(`"4"`),
+		"EvmUseFinalityTag":          json.RawMessage(`"true"`),
+		"EvmFinalityViolationAction": json.RawMessage(`"alert"`),
+		"OCRContractPollInterval":    json.RawMessage(`"30s"`),
+	}, cfg.Fields)
+
+	// The produced cfg is read back as the same values
+	config := newEVMConfigWithChainID("1")
+	config.chainCfg = cfg.Fields
+	assert.Equal(t, big.NewInt(1000), config.EvmGasPriceDefault())
+	assert.Equal(t, uint32(4), config.EvmConfirmerConcurrency())
+	assert.True(t, config.EvmUseFinalityTag())
+	assert.Equal(t, FinalityViolationActionAlert, config.EvmFinalityViolationAction())
+
+	assert.Empty(t, chainCfgFromSource(mapConfigSource{}).Fields)
+}
+
+func TestEVMConfig_OCRContractConfirmations(t *testing.T) {
+	t.Parallel()
+
+	newConfig := func(confs, finalityDepth string) *evmConfig {
+		gcfg := NewGeneralConfig()
+		gcfg.(*generalConfig).viper.Set("ETH_CHAIN_ID", "1")
+		return NewEVMConfigWithSource(gcfg, mapConfigSource{
+			"OCR_CONTRACT_CONFIRMATIONS": confs,
+			"ETH_FINALITY_DEPTH":         finalityDepth,
+		}).(*evmConfig)
+	}
+
+	t.Run("persisted value takes precedence over env", func(t *testing.T) {
+		config := newConfig("3", "10")
+		assert.Equal(t, uint16(3), config.OCRContractConfirmations(0))
+		config.chainCfg = map[string]json.RawMessage{"OCRContractConfirmations": json.RawMessage(`"7"`)}
+		assert.Equal(t, uint16(7), config.OCRContractConfirmations(0))
+		assert.Equal(t, uint16(2), config.OCRContractConfirmations(2))
+
+		// Invalid persisted values are ignored
+		config.chainCfg = map[string]json.RawMessage{"OCRContractConfirmations": json.RawMessage(`"0"`)}
+		assert.Equal(t, uint16(3), config.OCRContractConfirmations(0))
+	})
+
+	t.Run("must be between 1 and the finality depth", func(t *testing.T) {
+		assert.NoError(t, newConfig("1", "10").validate())
+		assert.NoError(t, newConfig("10", "10").validate())
+
+		err := newConfig("11", "10").validate()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "OCR_CONTRACT_CONFIRMATIONS must be between 1 and ETH_FINALITY_DEPTH (10) for chain 1, got: 11")
+
+		err = newConfig("0", "10").validate()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "OCR_CONTRACT_CONFIRMATIONS must be between 1 and ETH_FINALITY_DEPTH (10) for chain 1, got: 0")
+
+		// A persisted value is checked against the finality depth too
+		config := newConfig("1", "10")
+		config.chainCfg = map[string]json.RawMessage{"OCRContractConfirmations": json.RawMessage(`"20"`)}
+		err = config.validate()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "got: 20")
+	})
+}
+
+func TestEVMConfig_EvmSkipEstimationForSimpleTransfers(t *testing.T) {
+	t.Parallel()
+
+	config := newEVMConfigWithChainID("1")
+	assert.False(t, config.EvmSkipEstimationForSimpleTransfers())
+
+	config = NewEVMConfigWithSource(NewGeneralConfig(), mapConfigSource{"ETH_SKIP_ESTIMATION_FOR_SIMPLE_TRANSFERS": "true"}).(*evmConfig)
+	assert.True(t, config.EvmSkipEstimationForSimpleTransfers())
+
+	// A persisted value takes precedence over env
+	config.chainCfg = map[string]json.RawMessage{"EvmSkipEstimationForSimpleTransfers": json.RawMessage(`"false"`)}
+	assert.False(t, config.EvmSkipEstimationForSimpleTransfers())
+}
+
+func TestEVMConfig_EvmSimulateTransactionsBeforeSend(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, newEVMConfigWithChainID("1").EvmSimulateTransactionsBeforeSend())
+	assert.False(t, newEVMConfigWithChainID("137").EvmSimulateTransactionsBeforeSend())
+
+	gcfg := NewGeneralConfig()
+	gcfg.(*generalConfig).viper.Set("ETH_CHAIN_ID", "1")
+	config := NewEVMConfigWithSource(gcfg, mapConfigSource{"ETH_SIMULATE_TRANSACTIONS_BEFORE_SEND": "false"}).(*evmConfig)
+	assert.False(t, config.EvmSimulateTransactionsBeforeSend())
+}
+
+func TestEVMConfig_ChainCfgTakesPrecedence(t *testing.T) {
+	t.Parallel()
+
+	config := newEVMConfigWithChainID("0")
+	config.chainCfg = map[string]json.RawMessage{
+		"EvmGasPriceDefault":      json.RawMessage(`"12345"`),
+		"EvmConfirmerConcurrency": json.RawMessage(`4`),
+		"EvmUseFinalityTag":       json.RawMessage(`null`),
+	}
+	assert.Equal(t, big.NewInt(12345), config.EvmGasPriceDefault())
+	assert.Equal(t, uint32(4), config.EvmConfirmerConcurrency())
+	assert.False(t, config.EvmUseFinalityTag())
+}
+
+func TestEVMConfig_SetPersisted_UpdatesChainCfg(t *testing.T) {
+	t.Parallel()
+
+	config := newEVMConfigWithChainID("0")
+	config.chainCfg = map[string]json.RawMessage{
+		"EvmGasPriceDefault":      json.RawMessage(`"12345"`),
+		"EvmConfirmerConcurrency": json.RawMessage(`4`),
+	}
+	newValue := big.NewInt(20000000000)
+	require.True(t, errors.Is(config.SetEvmGasPriceDefault(newValue), ErrPersistenceDisabled))
+	assert.Equal(t, newValue, config.EvmGasPriceDefault())
+	assert.Equal(t, json.RawMessage(`"20000000000"`), config.chainCfg["EvmGasPriceDefault"])
+
+	// Fields the chain cfg does not hold are left out of it
+	require.True(t, errors.Is(config.SetEvmMaxGasPriceWei(context.Background(), big.NewInt(30000000000)), ErrPersistenceDisabled))
+	assert.Equal(t, big.NewInt(30000000000), config.EvmMaxGasPriceWei())
+	assert.NotContains(t, config.chainCfg, "EvmMaxGasPriceWei")
+}
+
+func TestEVMConfig_GasPriceEnvelope(t *testing.T) {
+	t.Parallel()
+
+	t.Run("defaults are consistent", func(t *testing.T) {
+		config := newEVMConfigWithChainID("1")
+		min, def, max := config.GasPriceEnvelope()
+		assert.Equal(t, config.EvmMinGasPriceWei(), min)
+		assert.Equal(t, config.EvmGasPriceDefault(), def)
+		assert.Equal(t, config.EvmMaxGasPriceWei(), max)
+		assert.True(t, min.Cmp(def) <= 0)
+		assert.True(t, def.Cmp(max) <= 0)
+		assert.NoError(t, config.validate())
+	})
+
+	t.Run("inconsistent values fail validation", func(t *testing.T) {
+		config := NewEVMConfigWithSource(NewGeneralConfig(), mapConfigSource{
+			"ETH_MIN_GAS_PRICE_WEI": "100",
+			"ETH_GAS_PRICE_DEFAULT": "50",
+			"ETH_MAX_GAS_PRICE_WEI": "40",
+		}).(*evmConfig)
+		min, def, max := config.GasPriceEnvelope()
+		assert.Equal(t, big.NewInt(100), min)
+		assert.Equal(t, big.NewInt(50), def)
+		assert.Equal(t, big.NewInt(40), max)
+		err := config.validate()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "ETH_MIN_GAS_PRICE_WEI must be less than or equal to ETH_GAS_PRICE_DEFAULT")
+		assert.Contains(t, err.Error(), "ETH_MAX_GAS_PRICE_WEI must be greater than or equal to ETH_GAS_PRICE_DEFAULT")
+	})
+
+	t.Run("never mixes values across a reload", func(t *testing.T) {
+		config := NewEVMConfigWithSource(NewGeneralConfig(), mapConfigSource{"ETH_MIN_GAS_PRICE_WEI": "1"}).(*evmConfig)
+		low := map[string]json.RawMessage{
+			"EvmGasPriceDefault": json.RawMessage(`"10"`),
+			"EvmMaxGasPriceWei":  json.RawMessage(`"20"`),
+		}
+		high := map[string]json.RawMessage{
+			"EvmGasPriceDefault": json.RawMessage(`"100"`),
+			"EvmMaxGasPriceWei":  json.RawMessage(`"200"`),
+		}
+		config.chainCfg = low
+
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			for i := 0; i < 1000; i++ {
+				config.persistedMu.Lock()
+				if i%2 == 0 {
+					config.chainCfg = high
+				} else {
+					config.chainCfg = low
+				}
+				config.persistedMu.Unlock()
+			}
+		}()
+
+		for {
+			select {
+			case <-done:
+				return
+			default:
+			}
+			_, def, max := config.GasPriceEnvelope()
+			require.Equal(t, 0, new(big.Int).Mul(def, big.NewInt(2)).Cmp(max), "got default %s with max %s", def, max)
+		}
+	})
+}
+
+func TestEVMConfig_RequireEIP155(t *testing.T) {
+	t.Parallel()
+
+	config := newEVMConfigWithChainID("1")
+	assert.True(t, config.RequireEIP155())
+	assert.Equal(t, big.NewInt(1), config.SignerChainID())
+
+	config = NewEVMConfigWithSource(NewGeneralConfig(), mapConfigSource{"ETH_REQUIRE_EIP155": "false"}).(*evmConfig)
+	assert.False(t, config.RequireEIP155())
+	assert.Nil(t, config.SignerChainID())
+
+	t.Run("chain ID 0 is rejected", func(t *testing.T) {
+		config := newEVMConfigWithChainID("0")
+		assert.EqualError(t, config.validate(), "ETH_CHAIN_ID must be greater than 0 for EIP-155 replay-protected signing, got: 0. Set ETH_REQUIRE_EIP155=false only if this chain pre-dates EIP-155")
+	})
+
+	t.Run("chain ID 0 only warns in dev mode", func(t *testing.T) {
+		config := newEVMConfig(func(c *generalConfig) {
+			c.viper.Set("ETH_CHAIN_ID", "0")
+			c.viper.Set("CHAINLINK_DEV", true)
+		})
+		assert.NoError(t, config.validate())
+	})
+
+	t.Run("chain ID 0 is allowed on pre-EIP-155 chains", func(t *testing.T) {
+		gcfg := NewGeneralConfig()
+		gcfg.(*generalConfig).viper.Set("ETH_CHAIN_ID", "0")
+		config := NewEVMConfigWithSource(gcfg, mapConfigSource{"ETH_REQUIRE_EIP155": "false"}).(*evmConfig)
+		assert.NoError(t, config.validate())
+	})
+}
+
+func TestEVMConfig_OCRTimeouts(t *testing.T) {
+	t.Parallel()
+
+	config := newEVMConfig(func(c *generalConfig) {
+		c.viper.Set("OCR_BLOCKCHAIN_TIMEOUT", "3s")
+		c.viper.Set("OCR_DATABASE_TIMEOUT", "4s")
+		c.viper.Set("OCR_OBSERVATION_TIMEOUT", "5s")
+		c.viper.Set("OCR_CONTRACT_TRANSMITTER_TRANSMIT_TIMEOUT", "6s")
+	})
+	assert.Equal(t, OCRTimeoutSet{
+		Blockchain:       3 * time.Second,
+		Database:         4 * time.Second,
+		Observation:      5 * time.Second,
+		ContractTransmit: 6 * time.Second,
+	}, config.OCRTimeouts())
+}
+
+func TestEVMConfig_EvmReceiptFetchDepth(t *testing.T) {
+	t.Parallel()
+
+	config := newEVMConfigWithChainID("1")
+	assert.Equal(t, config.EvmFinalityDepth(), config.EvmReceiptFetchDepth())
+	assert.NoError(t, config.validate())
+
+	config = NewEVMConfigWithSource(NewGeneralConfig(), mapConfigSource{"ETH_RECEIPT_FETCH_DEPTH": "200"}).(*evmConfig)
+	assert.Equal(t, uint(200), config.EvmReceiptFetchDepth())
+	// Greater than the head tracker history depth only warns
+	assert.NoError(t, config.validate())
+
+	config = NewEVMConfigWithSource(NewGeneralConfig(), mapConfigSource{"ETH_RECEIPT_FETCH_DEPTH": "0"}).(*evmConfig)
+	assert.EqualError(t, config.validate(), "ETH_RECEIPT_FETCH_DEPTH must be greater than or equal to 1")
+}
+
+func TestEVMConfig_SimulatedChain(t *testing.T) {
+	t.Parallel()
+
+	for _, id := range []string{"1337", "31337"} {
+		config := newEVMConfigWithChainID(id)
+		assert.Equal(t, uint(1), config.EvmFinalityDepth(), "chain %s", id)
+		assert.Equal(t, uint32(1), config.MinIncomingConfirmations(), "chain %s", id)
+		assert.Equal(t, "FixedPrice", config.GasEstimatorMode(), "chain %s", id)
+		assert.Equal(t, big.NewInt(0), config.EvmMinGasPriceWei(), "chain %s", id)
+		assert.NoError(t, config.validate(), "chain %s", id)
+	}
+}
+
+func TestEVMConfig_EvmReceiptFetchMaxBlocks(t *testing.T) {
+	t.Parallel()
+
+	config := newEVMConfigWithChainID("1")
+	assert.Equal(t, uint64(0), config.EvmReceiptFetchMaxBlocks())
+
+	config = NewEVMConfigWithSource(NewGeneralConfig(), mapConfigSource{"ETH_RECEIPT_FETCH_MAX_BLOCKS": "50"}).(*evmConfig)
+	assert.Equal(t, uint64(50), config.EvmReceiptFetchMaxBlocks())
+	assert.NoError(t, config.validate())
+}
+
+func TestEVMConfig_logBackfillHeadDepth(t *testing.T) {
+	t.Parallel()
+
+	// Defaults are deep enough on every chain
+	for _, id := range []string{"1", "10", "56", "137", "43114"} {
+		config := newEVMConfigWithChainID(id)
+		assert.LessOrEqual(t, config.logBackfillHeadDepth(), config.EvmHeadTrackerHistoryDepth(), "chain %s", id)
+	}
+
+	tests := []struct {
+		name     string
+		src      mapConfigSource
+		expected uint
+	}{
+		{"finality depth is deepest", mapConfigSource{"ETH_FINALITY_DEPTH": "50"}, 50},
+		{"limited by block backfill depth", mapConfigSource{"ETH_FINALITY_DEPTH": "1"}, 10},
+		{"limited by batch size", mapConfigSource{"ETH_FINALITY_DEPTH": "1", "ETH_LOG_BACKFILL_BATCH_SIZE": "4"}, 4},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			config := NewEVMConfigWithSource(NewGeneralConfig(), tt.src).(*evmConfig)
+			assert.Equal(t, tt.expected, config.logBackfillHeadDepth())
+		})
+	}
+
+	// Too shallow a history only warns
+	config := NewEVMConfigWithSource(NewGeneralConfig(), mapConfigSource{"ETH_FINALITY_DEPTH": "1", "ETH_HEAD_TRACKER_HISTORY_DEPTH": "5", "OCR_CONTRACT_CONFIRMATIONS": "1"}).(*evmConfig)
+	assert.Greater(t, config.logBackfillHeadDepth(), config.EvmHeadTrackerHistoryDepth())
+	assert.NoError(t, config.validate())
+}
+
+func TestEVMConfig_EffectiveOutgoingConfirmations(t *testing.T) {
+	t.Parallel()
+
+	config := NewEVMConfigWithSource(NewGeneralConfig(), mapConfigSource{"MIN_REQUIRED_OUTGOING_CONFIRMATIONS": "7"}).(*evmConfig)
+
+	t.Run("override of 0 uses the configured value", func(t *testing.T) {
+		assert.Equal(t, uint64(7), config.EffectiveOutgoingConfirmations(0))
+	})
+
+	t.Run("non-zero override wins", func(t *testing.T) {
+		assert.Equal(t, uint64(3), config.EffectiveOutgoingConfirmations(3))
+	})
+}
+
+func TestEVMConfig_EffectiveIncomingConfirmations(t *testing.T) {
+	t.Parallel()
+
+	// MIN_INCOMING_CONFIRMATIONS is 3 and ETH_FINALITY_DEPTH is 50 by default
+	config := newEVMConfigWithChainID("1")
+	assert.Equal(t, float64(0), config.EvmIncomingConfirmationsFinalityFraction())
+	assert.Equal(t, uint32(3), config.EffectiveIncomingConfirmations())
+
+	tests := []struct {
+		fraction string
+		want     uint32
+	}{
+		{"0", 3},
+		{"0.05", 3},
+		{"0.1", 5},
+		{"0.15", 8},
+		{"0.5", 25},
+		{"1", 50},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.fraction, func(t *testing.T) {
+			config := NewEVMConfigWithSource(NewGeneralConfig(), mapConfigSource{
+				"ETH_INCOMING_CONFIRMATIONS_FINALITY_FRACTION": tt.fraction,
+				"MIN_INCOMING_CONFIRMATIONS":                   "3",
+				"ETH_FINALITY_DEPTH":                           "50",
+			}).(*evmConfig)
+			assert.Equal(t, tt.want, config.EffectiveIncomingConfirmations())
+			assert.NoError(t, config.validate())
+		})
+	}
+
+	t.Run("persisted fraction takes precedence", func(t *testing.T) {
+		config := NewEVMConfigWithSource(NewGeneralConfig(), mapConfigSource{
+			"ETH_INCOMING_CONFIRMATIONS_FINALITY_FRACTION": "0.1",
+			"MIN_INCOMING_CONFIRMATIONS":                   "3",
+			"ETH_FINALITY_DEPTH":                           "50",
+		}).(*evmConfig)
+		config.chainCfg = map[string]json.RawMessage{"EvmIncomingConfirmationsFinalityFraction": json.RawMessage(`"0.2"`)}
+		assert.Equal(t, uint32(10), config.EffectiveIncomingConfirmations())
+
+		config.chainCfg = map[string]json.RawMessage{"EvmIncomingConfirmationsFinalityFraction": json.RawMessage(`"1.5"`)}
+		assert.Error(t, config.ValidatePersisted())
+	})
+
+	t.Run("rejects fractions outside of 0 to 1", func(t *testing.T) {
+		for _, fraction := range []string{"-0.1", "1.1", "NaN"} {
+			config := NewEVMConfigWithSource(NewGeneralConfig(), mapConfigSource{"ETH_INCOMING_CONFIRMATIONS_FINALITY_FRACTION": fraction}).(*evmConfig)
+			err := config.validate()
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), "ETH_INCOMING_CONFIRMATIONS_FINALITY_FRACTION must be between 0 and 1")
+		}
+	})
+}
+
+func TestEVMConfig_NativeToken(t *testing.T) {
+	t.Parallel()
+
+	config := newEVMConfigWithChainID("1")
+	assert.Equal(t, "ETH", config.NativeTokenSymbol())
+	assert.Equal(t, uint8(18), config.NativeTokenDecimals())
+
+	config = newEVMConfigWithChainID("137")
+	assert.Equal(t, "MATIC", config.NativeTokenSymbol())
+	assert.Equal(t, uint8(18), config.NativeTokenDecimals())
+
+	// Unknown chains fall back to ETH
+	config = newEVMConfigWithChainID("98765")
+	assert.Equal(t, "ETH", config.NativeTokenSymbol())
+
+	config = NewEVMConfigWithSource(NewGeneralConfig(), mapConfigSource{
+		"NATIVE_TOKEN_SYMBOL":   "FOO",
+		"NATIVE_TOKEN_DECIMALS": "8",
+	}).(*evmConfig)
+	assert.Equal(t, "FOO", config.NativeTokenSymbol())
+	assert.Equal(t, uint8(8), config.NativeTokenDecimals())
+}
+
+func TestEVMConfig_EvmGasEstimatorRequireWarmup(t *testing.T) {
+	t.Parallel()
+
+	config := newEVMConfigWithChainID("1")
+	assert.False(t, config.EvmGasEstimatorRequireWarmup())
+
+	config = NewEVMConfigWithSource(NewGeneralConfig(), mapConfigSource{"GAS_ESTIMATOR_REQUIRE_WARMUP": "true"}).(*evmConfig)
+	assert.True(t, config.EvmGasEstimatorRequireWarmup())
+
+	config.chainCfg = map[string]json.RawMessage{"EvmGasEstimatorRequireWarmup": json.RawMessage(`false`)}
+	assert.False(t, config.EvmGasEstimatorRequireWarmup())
+}
+
+func TestEVMConfig_LinkDecimals(t *testing.T) {
+	t.Parallel()
+
+	config := newEVMConfigWithChainID("1")
+	assert.Equal(t, uint8(18), config.LinkDecimals())
+	assert.Equal(t, "1000000000000000000", config.MinimumContractPayment().String())
+
+	// Chain defaults are rescaled to the configured decimals
+	config = NewEVMConfigWithSource(NewGeneralConfig(), mapConfigSource{"LINK_DECIMALS": "8"}).(*evmConfig)
+	assert.Equal(t, uint8(8), config.LinkDecimals())
+	assert.Equal(t, "100000000", config.MinimumContractPayment().String())
+
+	// Explicit values are already in the chain's smallest unit
+	config = NewEVMConfigWithSource(NewGeneralConfig(), mapConfigSource{
+		"LINK_DECIMALS":                       "8",
+		"MINIMUM_CONTRACT_PAYMENT_LINK_JUELS": "42",
+	}).(*evmConfig)
+	assert.Equal(t, "42", config.MinimumContractPayment().String())
+
+	config.chainCfg = map[string]json.RawMessage{"LinkDecimals": json.RawMessage(`6`)}
+	assert.Equal(t, uint8(6), config.LinkDecimals())
+}
+
+func TestEVMConfig_EvmGasLimitTransfer(t *testing.T) {
+	t.Parallel()
+
+	config := NewEVMConfigWithSource(NewGeneralConfig(), mapConfigSource{"ETH_GAS_LIMIT_TRANSFER": "30000"}).(*evmConfig)
+	assert.Equal(t, uint64(30000), config.EvmGasLimitTransfer())
+
+	config.chainCfg = map[string]json.RawMessage{"EvmGasLimitTransfer": json.RawMessage(`"800000"`)}
+	assert.Equal(t, uint64(800000), config.EvmGasLimitTransfer())
+
+	config = NewEVMConfigWithSource(NewGeneralConfig(), mapConfigSource{"ETH_GAS_LIMIT_TRANSFER": "2100"}).(*evmConfig)
+	assert.EqualError(t, config.validate(), "ETH_GAS_LIMIT_TRANSFER must be greater than or equal to 21000, got: 2100")
+}
+
+func TestEVMConfig_BlockHistoryEstimatorTransactionPercentile(t *testing.T) {
+	t.Parallel()
+
+	for _, tt := range []struct {
+		percentile string
+		valid      bool
+	}{
+		{"0", true},
+		{"50", true},
+		{"100", true},
+		{"101", false},
+	} {
+		tt := tt
+		t.Run(tt.percentile, func(t *testing.T) {
+			config := NewEVMConfigWithSource(NewGeneralConfig(), mapConfigSource{"BLOCK_HISTORY_ESTIMATOR_TRANSACTION_PERCENTILE": tt.percentile}).(*evmConfig)
+			err := config.validate()
+			if tt.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, "BLOCK_HISTORY_ESTIMATOR_TRANSACTION_PERCENTILE must be between 0 and 100, got: "+tt.percentile)
+			}
+		})
+	}
+
+	t.Run("persisted", func(t *testing.T) {
+		config := NewEVMConfigWithSource(NewGeneralConfig(), mapConfigSource{"BLOCK_HISTORY_ESTIMATOR_TRANSACTION_PERCENTILE": "50"}).(*evmConfig)
+		config.chainCfg = map[string]json.RawMessage{"BlockHistoryEstimatorTransactionPercentile": json.RawMessage(`80`)}
+		assert.Equal(t, uint16(80), config.BlockHistoryEstimatorTransactionPercentile())
+
+		// Out of range persisted values are ignored
+		config.chainCfg = map[string]json.RawMessage{"BlockHistoryEstimatorTransactionPercentile": json.RawMessage(`150`)}
+		assert.Equal(t, uint16(50), config.BlockHistoryEstimatorTransactionPercentile())
+		assert.Error(t, config.ValidatePersisted())
+	})
+}
+
+func TestEVMConfig_NodeWSReconnectBackoff(t *testing.T) {
+	t.Parallel()
+
+	config := newEVMConfigWithChainID("1")
+	assert.Equal(t, time.Second, config.NodeWSReconnectMinBackoff())
+	assert.Equal(t, 10*time.Second, config.NodeWSReconnectMaxBackoff())
+
+	config = NewEVMConfigWithSource(NewGeneralConfig(), mapConfigSource{
+		"ETH_NODE_WS_RECONNECT_MIN_BACKOFF": "2s",
+		"ETH_NODE_WS_RECONNECT_MAX_BACKOFF": "1m",
+	}).(*evmConfig)
+	assert.Equal(t, 2*time.Second, config.NodeWSReconnectMinBackoff())
+	assert.Equal(t, time.Minute, config.NodeWSReconnectMaxBackoff())
+	assert.NoError(t, config.validate())
+
+	config.chainCfg = map[string]json.RawMessage{"NodeWSReconnectMinBackoff": json.RawMessage(`"500ms"`)}
+	assert.Equal(t, 500*time.Millisecond, config.NodeWSReconnectMinBackoff())
+
+	config = NewEVMConfigWithSource(NewGeneralConfig(), mapConfigSource{
+		"ETH_NODE_WS_RECONNECT_MIN_BACKOFF": "20s",
+		"ETH_NODE_WS_RECONNECT_MAX_BACKOFF": "10s",
+	}).(*evmConfig)
+	assert.EqualError(t, config.validate(), "ETH_NODE_WS_RECONNECT_MIN_BACKOFF must not be greater than ETH_NODE_WS_RECONNECT_MAX_BACKOFF, got: 20s and 10s")
+
+	config = NewEVMConfigWithSource(NewGeneralConfig(), mapConfigSource{"ETH_NODE_WS_RECONNECT_MIN_BACKOFF": "0s"}).(*evmConfig)
+	assert.EqualError(t, config.validate(), "ETH_NODE_WS_RECONNECT_MIN_BACKOFF and ETH_NODE_WS_RECONNECT_MAX_BACKOFF must be positive, got: 0s and 10s")
+}
+
+func TestEVMConfig_ExportTOML_ApplyTOML(t *testing.T) {
+	t.Parallel()
+
+	newConfig := func(src mapConfigSource) *evmConfig {
+		gcfg := NewGeneralConfig()
+		gcfg.SetDB(nil)
+		return NewEVMConfigWithSource(gcfg, src).(*evmConfig)
+	}
+
+	src := newConfig(mapConfigSource{
+		"ETH_GAS_PRICE_DEFAULT":             "3000000000",
+		"ETH_NODE_WS_RECONNECT_MAX_BACKOFF": "42s",
+		"ETH_DISABLED_SERVICES":             "balance_monitor,log_broadcaster",
+		"LINK_DECIMALS":                     "8",
+	})
+	exported, err := src.ExportTOML()
+	require.NoError(t, err)
+	assert.Contains(t, string(exported), `EvmGasPriceDefault = "3000000000"`)
+	assert.Contains(t, string(exported), `NodeWSReconnectMaxBackoff = "42s"`)
+
+	t.Run("round trip", func(t *testing.T) {
+		dst := newConfig(mapConfigSource{})
+		require.True(t, errors.Is(dst.ApplyTOML(exported), ErrPersistenceDisabled))
+
+		assert.Equal(t, big.NewInt(3000000000), dst.EvmGasPriceDefault())
+		assert.Equal(t, 42*time.Second, dst.NodeWSReconnectMaxBackoff())
+		assert.Equal(t, []string{"balance_monitor", "log_broadcaster"}, dst.EvmDisabledServices())
+		assert.Equal(t, uint8(8), dst.LinkDecimals())
+		assert.NoError(t, dst.ValidatePersisted())
+
+		reexported, err := dst.ExportTOML()
+		require.NoError(t, err)
+		assert.Equal(t, string(exported), string(reexported))
+	})
+
+	t.Run("applies a subset of fields", func(t *testing.T) {
+		dst := newConfig(mapConfigSource{})
+		require.True(t, errors.Is(dst.ApplyTOML([]byte("EvmUseFinalityTag = true\nNodeRateLimitRPS = 2.5\n")), ErrPersistenceDisabled))
+
+		assert.True(t, dst.EvmUseFinalityTag())
+		rps, _ := dst.NodeRateLimit()
+		assert.Equal(t, 2.5, rps)
+		assert.Equal(t, dst.chainSpecificConfig.GasPriceDefault, *dst.EvmGasPriceDefault())
+	})
+
+	t.Run("rejects invalid input without applying any of it", func(t *testing.T) {
+		dst := newConfig(mapConfigSource{})
+		err := dst.ApplyTOML([]byte("EvmUseFinalityTag = true\nBlockHistoryEstimatorTransactionPercentile = 101\nEthTxReaperInterval = \"1h\"\n"))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "BlockHistoryEstimatorTransactionPercentile")
+		assert.Contains(t, err.Error(), "EthTxReaperInterval cannot be persisted")
+		assert.False(t, dst.EvmUseFinalityTag())
+
+		assert.Error(t, dst.ApplyTOML([]byte("not toml")))
+	})
+
+	t.Run("rejects values that make the config invalid without applying any of it", func(t *testing.T) {
+		dst := newConfig(mapConfigSource{})
+		max := dst.EvmMaxGasPriceWei()
+		above := new(big.Int).Add(max, big.NewInt(1))
+		err := dst.ApplyTOML([]byte(fmt.Sprintf("EvmUseFinalityTag = true\nEvmGasPriceDefault = %q\n", above.String())))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "ETH_MAX_GAS_PRICE_WEI must be greater than or equal to ETH_GAS_PRICE_DEFAULT")
+		assert.False(t, dst.EvmUseFinalityTag())
+		assert.Equal(t, dst.chainSpecificConfig.GasPriceDefault, *dst.EvmGasPriceDefault())
+
+		// Raising the max in the same TOML makes it valid
+		require.True(t, errors.Is(dst.ApplyTOML([]byte(fmt.Sprintf("EvmGasPriceDefault = %q\nEvmMaxGasPriceWei = %q\n", above.String(), above.String()))), ErrPersistenceDisabled))
+		assert.Equal(t, above, dst.EvmGasPriceDefault())
+		assert.Equal(t, above, dst.EvmMaxGasPriceWei())
+	})
+
+	t.Run("replaces a deferred default gas price", func(t *testing.T) {
+		dst := newConfig(mapConfigSource{})
+		dst.gasPriceDefaultPending = big.NewInt(42)
+		require.True(t, errors.Is(dst.ApplyTOML([]byte(`EvmGasPriceDefault = "3000000000"`)), ErrPersistenceDisabled))
+		assert.Equal(t, big.NewInt(3000000000), dst.EvmGasPriceDefault())
+	})
+}
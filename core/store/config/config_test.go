@@ -1,14 +1,19 @@
 package config_test
 
 import (
+	"context"
 	"math/big"
 	"testing"
+	"time"
 
+	"github.com/pkg/errors"
 	"github.com/smartcontractkit/chainlink/core/assets"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/configtest"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
 	"github.com/smartcontractkit/chainlink/core/store/config"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/time/rate"
 )
 
 func TestConfig_SetEvmGasPriceDefault(t *testing.T) {
@@ -76,3 +81,139 @@ func TestConfig_Profiles(t *testing.T) {
 		})
 	}
 }
+
+// reloadPersisted picks up values written to the configurations table
+// directly. The test chain has no evm_chains row, which is not an error here.
+func reloadPersisted(t *testing.T, cfg config.EVMConfig) {
+	t.Helper()
+	if err := cfg.ReloadPersistedConfig(); err != nil {
+		require.True(t, errors.Is(err, config.ErrChainNotFound), err.Error())
+	}
+}
+
+func TestConfig_PersistedValuesAreCached(t *testing.T) {
+	db := pgtest.NewGormDB(t)
+	cfg := config.NewEVMConfig(config.NewGeneralConfig())
+	cfg.SetDB(db)
+	orm := config.NewORM(db)
+
+	require.NoError(t, orm.SetConfigStrValue(context.Background(), "EvmCallTimeout", "20s"))
+	assert.Equal(t, config.NewEVMConfig(config.NewGeneralConfig()).EvmCallTimeout(), cfg.EvmCallTimeout())
+
+	reloadPersisted(t, cfg)
+	assert.Equal(t, 20*time.Second, cfg.EvmCallTimeout())
+
+	// Values set through the config are cached as they are written
+	require.NoError(t, cfg.SetEvmGasPriceDefault(big.NewInt(42000000000)))
+	require.NoError(t, db.Exec(`DELETE FROM configurations`).Error)
+	assert.Equal(t, big.NewInt(42000000000), cfg.EvmGasPriceDefault())
+	assert.Equal(t, 20*time.Second, cfg.EvmCallTimeout())
+}
+
+func TestConfig_BlockHistoryEstimatorBatchSize(t *testing.T) {
+	db := pgtest.NewGormDB(t)
+	cfg := config.NewEVMConfig(config.NewGeneralConfig())
+	cfg.SetDB(db)
+	orm := config.NewORM(db)
+
+	assert.Equal(t, uint32(4), cfg.BlockHistoryEstimatorBatchSize())
+
+	t.Run("reads the persisted value", func(t *testing.T) {
+		require.NoError(t, orm.SetConfigStrValue(context.Background(), "BlockHistoryEstimatorBatchSize", "8"))
+		reloadPersisted(t, cfg)
+		assert.Equal(t, uint32(8), cfg.BlockHistoryEstimatorBatchSize())
+	})
+
+	t.Run("falls back to EvmDefaultBatchSize when persisted as 0", func(t *testing.T) {
+		require.NoError(t, orm.SetConfigStrValue(context.Background(), "BlockHistoryEstimatorBatchSize", "0"))
+		reloadPersisted(t, cfg)
+		assert.Equal(t, cfg.EvmDefaultBatchSize(), cfg.BlockHistoryEstimatorBatchSize())
+	})
+}
+
+func TestConfig_OCRContractIntervals(t *testing.T) {
+	db := pgtest.NewGormDB(t)
+	gcfg := config.NewGeneralConfig()
+	cfg := config.NewEVMConfig(gcfg)
+	cfg.SetDB(db)
+	orm := config.NewORM(db)
+
+	assert.Equal(t, gcfg.OCRContractPollInterval(0), cfg.OCRContractPollInterval(0))
+	assert.Equal(t, gcfg.OCRContractSubscribeInterval(0), cfg.OCRContractSubscribeInterval(0))
+
+	require.NoError(t, orm.SetConfigStrValue(context.Background(), "OCRContractPollInterval", "15s"))
+	require.NoError(t, orm.SetConfigStrValue(context.Background(), "OCRContractSubscribeInterval", "1m"))
+	reloadPersisted(t, cfg)
+
+	t.Run("persisted values take precedence over the general config", func(t *testing.T) {
+		assert.Equal(t, 15*time.Second, cfg.OCRContractPollInterval(0))
+		assert.Equal(t, time.Minute, cfg.OCRContractSubscribeInterval(0))
+	})
+
+	t.Run("job spec overrides take precedence over persisted values", func(t *testing.T) {
+		assert.Equal(t, 30*time.Second, cfg.OCRContractPollInterval(30*time.Second))
+		assert.Equal(t, 3*time.Minute, cfg.OCRContractSubscribeInterval(3*time.Minute))
+	})
+
+	t.Run("persisted values are validated", func(t *testing.T) {
+		require.NoError(t, orm.SetConfigStrValue(context.Background(), "OCRContractPollInterval", "5s"))
+		reloadPersisted(t, cfg)
+		err := cfg.Validate()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "contract config tracker poll interval")
+	})
+}
+
+func TestConfig_ValidatePersisted(t *testing.T) {
+	db := pgtest.NewGormDB(t)
+	gcfg := config.NewGeneralConfig()
+	cfg := config.NewEVMConfig(gcfg)
+	cfg.SetDB(db)
+	orm := config.NewORM(db)
+
+	require.NoError(t, cfg.ValidatePersisted())
+
+	require.NoError(t, orm.SetConfigStrValue(context.Background(), "NodeRateLimitRPS", "-1"))
+	require.NoError(t, orm.SetConfigStrValue(context.Background(), "OCRContractPollInterval", "-5s"))
+	require.NoError(t, orm.SetConfigStrValue(context.Background(), "L1FinalityDepth", "garbage"))
+	reloadPersisted(t, cfg)
+
+	t.Run("getters fall back to defaults", func(t *testing.T) {
+		rps, _ := cfg.NodeRateLimit()
+		assert.Equal(t, float64(0), rps)
+		assert.Equal(t, gcfg.OCRContractPollInterval(0), cfg.OCRContractPollInterval(0))
+		assert.Equal(t, uint(0), cfg.L1FinalityDepth())
+	})
+
+	t.Run("returns every invalid persisted value", func(t *testing.T) {
+		err := cfg.ValidatePersisted()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "ETH_NODE_RATE_LIMIT_RPS")
+		assert.Contains(t, err.Error(), "OCR_CONTRACT_POLL_INTERVAL")
+		assert.Contains(t, err.Error(), "ETH_L1_FINALITY_DEPTH")
+	})
+}
+
+func TestConfig_NodeRateLimit_PersistedBurstOfZero(t *testing.T) {
+	db := pgtest.NewGormDB(t)
+	cfg := config.NewEVMConfig(config.NewGeneralConfig())
+	cfg.SetDB(db)
+	orm := config.NewORM(db)
+
+	require.NoError(t, orm.SetConfigStrValue(context.Background(), "NodeRateLimitRPS", "10"))
+	require.NoError(t, orm.SetConfigStrValue(context.Background(), "NodeRateLimitBurst", "0"))
+	reloadPersisted(t, cfg)
+
+	rps, burst := cfg.NodeRateLimit()
+	assert.Equal(t, float64(10), rps)
+	assert.Equal(t, 1, burst)
+
+	// The eth client builds each node's limiter from these, so requests must
+	// still be let through
+	limiter := rate.NewLimiter(rate.Limit(rps), burst)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	for i := 0; i < 3; i++ {
+		require.NoError(t, limiter.Wait(ctx))
+	}
+}
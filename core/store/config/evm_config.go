@@ -1,61 +1,127 @@
 package config
 
 import (
+	"context"
+	"encoding"
+	"encoding/json"
 	"fmt"
+	"math"
 	"math/big"
 	"os"
+	"sort"
+	"strings"
+	"sync"
 	"time"
 
 	ethCore "github.com/ethereum/go-ethereum/core"
 	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
 	"github.com/smartcontractkit/chainlink/core/assets"
 	"github.com/smartcontractkit/chainlink/core/chains"
 	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/eth"
 	ocr "github.com/smartcontractkit/libocr/offchainreporting"
 	ocrtypes "github.com/smartcontractkit/libocr/offchainreporting/types"
 	"go.uber.org/multierr"
-	"gorm.io/gorm"
+	"go.uber.org/zap"
 )
 
 type EVMOnlyConfig interface {
 	BalanceMonitorEnabled() bool
+	ApplyGasLimitMultiplier(gasLimit uint64) uint64
+	ApplyTOML(b []byte) error
 	BlockEmissionIdleWarningThreshold() time.Duration
 	BlockHistoryEstimatorBatchSize() (size uint32)
 	BlockHistoryEstimatorBlockDelay() uint16
 	BlockHistoryEstimatorBlockHistorySize() uint16
 	BlockHistoryEstimatorTransactionPercentile() uint16
+	ClampGasPrice(gasPrice *big.Int) *big.Int
+	EffectiveIncomingConfirmations() uint32
+	EffectiveOutgoingConfirmations(override uint64) uint64
 	EthTxReaperInterval() time.Duration
 	EthTxReaperThreshold() time.Duration
 	EthTxResendAfterThreshold() time.Duration
+	EvmBalanceMonitorBlockDelay() uint16
+	EvmCallTimeout() time.Duration
+	EvmChainEnabled() bool
+	EvmConfirmerConcurrency() uint32
 	EvmDefaultBatchSize() uint32
+	EvmDisabledServices() []string
 	EvmFinalityDepth() uint
+	EvmFinalityViolationAction() string
+	EvmForceTxType() int
+	EvmGasBumpOverflowProtection() bool
 	EvmGasBumpPercent() uint16
+	EvmGasBumpStrategy() string
 	EvmGasBumpThreshold() uint64
 	EvmGasBumpTxDepth() uint16
 	EvmGasBumpWei() *big.Int
+	EvmGasEstimatorRequireWarmup() bool
 	EvmGasLimitDefault() uint64
 	EvmGasLimitMultiplier() float32
 	EvmGasLimitTransfer() uint64
 	EvmGasPriceDefault() *big.Int
+	EvmGasPriceDefaultAutoWidenMax() bool
+	EvmGasPriceDefaultSeedFromNetwork() bool
+	EvmGasPriceDefaultUpdateInterval() time.Duration
+	GasPriceEnvelope() (min, def, max *big.Int)
+	EvmHeadTrackerBackfillDepth() uint
 	EvmHeadTrackerHistoryDepth() uint
 	EvmHeadTrackerMaxBufferSize() uint
+	EvmHeadTrackerMaxReorgDepth() uint
 	EvmHeadTrackerSamplingInterval() time.Duration
+	EvmHeadTrackerSamplingMode() string
+	EvmIncomingConfirmationsFinalityFraction() float64
+	EvmInsufficientFundsAction() string
 	EvmLogBackfillBatchSize() uint32
 	EvmMaxGasPriceWei() *big.Int
+	EvmMaxGasPriceWeiCeiling() *big.Int
 	EvmMaxInFlightTransactions() uint32
+	EvmMaxNonceGap() uint64
 	EvmMaxQueuedTransactions() uint64
 	EvmMinGasPriceWei() *big.Int
 	EvmNonceAutoSync() bool
+	EvmNonceGapAction() string
+	EvmReceiptFetchDepth() uint
+	EvmReceiptFetchMaxBlocks() uint64
 	EvmRPCDefaultBatchSize() uint32
+	EvmServiceDisabled(name string) bool
+	EvmSimulateTransactionsBeforeSend() bool
+	EvmSkipEstimationForSimpleTransfers() bool
+	EvmUseFinalityTag() bool
+	ExportTOML() ([]byte, error)
 	FlagsContractAddress() string
 	GasEstimatorMode() string
+	IsTxFinal(l2Depth, l1Depth uint) bool
+	L1FinalityDepth() uint
 	LinkContractAddress() string
+	LinkDecimals() uint8
 	MinIncomingConfirmations() uint32
 	MinRequiredOutgoingConfirmations() uint64
 	MinimumContractPayment() *assets.Link
+	NativeTokenDecimals() uint8
+	NativeTokenSymbol() string
+	RequireEIP155() bool
+	SignerChainID() *big.Int
+	NextBumpedGasPrice(current *big.Int) (bumped *big.Int, maxReached bool)
+	NodeCircuitBreakerCooldown() time.Duration
+	NodeCircuitBreakerThreshold() uint32
+	NodeRateLimit() (rps float64, burst int)
+	NodeSelectionMode() string
+	NodeWSReconnectMaxBackoff() time.Duration
+	NodeWSReconnectMinBackoff() time.Duration
 	OCRContractConfirmations(override uint16) uint16
+	OCRTimeouts() OCRTimeoutSet
+	SeedEvmGasPriceDefault(ctx context.Context, ethClient eth.Client) error
+	SendOnlyNodeMinAccepts() uint32
+	ReloadPersistedConfig() error
+	Close() error
 	SetEvmGasPriceDefault(value *big.Int) error
+	SetEvmGasPriceDefaultCtx(ctx context.Context, value *big.Int) error
+	SetEvmMaxGasPriceWei(ctx context.Context, value *big.Int) error
 	Validate() error
+	ValidatePersisted() error
+	ConfigOverrideConflicts() []ConflictReport
 }
 
 // EVMConfig contains configuration values specific to a particular chain
@@ -64,14 +130,151 @@ type EVMConfig interface {
 	EVMOnlyConfig
 }
 
+// ConfigSource provides raw string values for config keys, such as
+// ETH_GAS_BUMP_PERCENT, overriding the chain defaults
+type ConfigSource interface {
+	Lookup(key string) (string, bool)
+}
+
+// EnvConfigSource is a ConfigSource backed by the process environment
+type EnvConfigSource struct{}
+
+// Lookup returns the value of the environment variable named by key
+func (EnvConfigSource) Lookup(key string) (string, bool) {
+	return os.LookupEnv(key)
+}
+
 type evmConfig struct {
 	GeneralConfig
 	chainSpecificConfig chains.ChainSpecificConfig
+	source              ConfigSource
+	// chain overrides the general config's chain if set
+	chain *chains.Chain
+	// envOnly ignores persisted values entirely, see NewEVMConfigEnvOnly
+	envOnly bool
+
+	// Chain defaults for big.Int getters, allocated once since they never
+	// change after construction. Callers must not mutate the returned values.
+	defaultGasBumpWei      *big.Int
+	defaultGasPriceDefault *big.Int
+	defaultMaxGasPriceWei  *big.Int
+	defaultMinGasPriceWei  *big.Int
+
+	// persisted caches the configurations table's values as of the last
+	// ReloadPersistedConfig, plus any set since, so that getters do not query
+	// the DB. Without a DB it holds only the values set at runtime.
+	persisted map[string]string
+	// chainCfg holds this chain's evm_chains.cfg as of the last
+	// ReloadPersistedConfig
+	chainCfg map[string]json.RawMessage
+	// chainDisabled is set if this chain's evm_chains row was disabled as of
+	// the last ReloadPersistedConfig
+	chainDisabled bool
+	// persistedConflicts lists the persisted values that the last
+	// ReloadPersistedConfig ignored because they failed validation together
+	// with the rest of the config
+	persistedConflicts error
+	persistedMu        sync.RWMutex
+	// quiet discards log output, for the candidate configs validated while
+	// reloading
+	quiet bool
+
+	// gasPriceDefault* debounce persisting EvmGasPriceDefault, see
+	// EvmGasPriceDefaultUpdateInterval. gasPriceDefaultPending is the latest
+	// value set that has not been persisted yet.
+	gasPriceDefaultMu        sync.Mutex
+	gasPriceDefaultPending   *big.Int
+	gasPriceDefaultLastWrite time.Time
+	gasPriceDefaultTimer     *time.Timer
+	gasPriceDefaultClosed    bool
 }
 
+// ErrPersistenceDisabled is returned by the runtime setters when there is no
+// DB. The value is still applied in memory for the life of the config.
+var ErrPersistenceDisabled = errors.New("persistence disabled (no DB)")
+
+// NewEVMConfig returns an EVMConfig for the chain configured in cfg.
+//
+// It does not need a DB, which allows embedding without Postgres: if cfg has
+// no DB, values come only from env and chain defaults. In that mode the runtime
+// setters such as SetEvmGasPriceDefault apply values in memory for the life of
+// the config and return ErrPersistenceDisabled, and ReloadPersistedConfig
+// cannot load anything from evm_chains.
 func NewEVMConfig(cfg GeneralConfig) EVMConfig {
-	css := cfg.Chain().Config()
-	return &evmConfig{cfg, css}
+	return NewEVMConfigWithSource(cfg, EnvConfigSource{})
+}
+
+// NewEVMConfigWithSource returns an EVMConfig that reads overrides from source
+// instead of the process environment
+func NewEVMConfigWithSource(cfg GeneralConfig, source ConfigSource) EVMConfig {
+	return newEVMConfigForChain(cfg, cfg.Chain(), source)
+}
+
+// NewEVMConfigEnvOnly returns an EVMConfig for chainID that ignores
+// persistence entirely, for ephemeral or simulated chains: every getter uses
+// the env var, falling back to the chain default, whether or not cfg has a DB.
+// The runtime setters, including SetEvmGasPriceDefault, are no-ops that return
+// nil, and ReloadPersistedConfig does nothing.
+func NewEVMConfigEnvOnly(cfg GeneralConfig, chainID *big.Int) EVMConfig {
+	chain := chains.ChainFromID(chainID)
+	c := newEVMConfigForChain(cfg, chain, EnvConfigSource{})
+	c.chain = chain
+	c.envOnly = true
+	return c
+}
+
+func newEVMConfigForChain(cfg GeneralConfig, chain *chains.Chain, source ConfigSource) *evmConfig {
+	css := chain.Config()
+	return &evmConfig{
+		GeneralConfig:          cfg,
+		chainSpecificConfig:    css,
+		source:                 source,
+		defaultGasBumpWei:      new(big.Int).Set(&css.GasBumpWei),
+		defaultGasPriceDefault: new(big.Int).Set(&css.GasPriceDefault),
+		defaultMaxGasPriceWei:  new(big.Int).Set(&css.MaxGasPriceWei),
+		defaultMinGasPriceWei:  new(big.Int).Set(&css.MinGasPriceWei),
+	}
+}
+
+// Chain returns the chain this config is for
+func (c *evmConfig) Chain() *chains.Chain {
+	if c.chain != nil {
+		return c.chain
+	}
+	return c.GeneralConfig.Chain()
+}
+
+// ChainID returns the ID of the chain this config is for
+func (c *evmConfig) ChainID() *big.Int {
+	if c.chain != nil {
+		return c.chain.ID()
+	}
+	return c.GeneralConfig.ChainID()
+}
+
+// EvmChainEnabled reports whether this chain's evm_chains row is enabled, as
+// of the last ReloadPersistedConfig. A chain with no row is enabled. Services
+// are started or skipped at startup, so a change takes effect on restart.
+func (c *evmConfig) EvmChainEnabled() bool {
+	c.persistedMu.RLock()
+	defer c.persistedMu.RUnlock()
+	return !c.chainDisabled
+}
+
+// EthereumDisabled is set if ETHEREUM_DISABLED is set or this chain is
+// disabled in evm_chains, in which case none of its services are started
+func (c *evmConfig) EthereumDisabled() bool {
+	return c.GeneralConfig.EthereumDisabled() || !c.EvmChainEnabled()
+}
+
+// logger returns the default logger tagged with this config's chain ID. It is
+// not cached because the config is usually created before the application
+// logger has been set up.
+func (c *evmConfig) logger() *logger.Logger {
+	if c.quiet {
+		return logger.CreateLogger(zap.NewNop().Sugar())
+	}
+	return logger.CreateLogger(logger.Default.With("evmChainID", c.ChainID().String()))
 }
 
 func (c *evmConfig) Validate() error {
@@ -82,45 +285,179 @@ func (c *evmConfig) Validate() error {
 }
 
 func (c *evmConfig) validate() (err error) {
-	ethGasBumpPercent := c.EvmGasBumpPercent()
-	if uint64(ethGasBumpPercent) < ethCore.DefaultTxPoolConfig.PriceBump {
-		err = multierr.Combine(err, errors.Errorf(
-			"ETH_GAS_BUMP_PERCENT of %v may not be less than Geth's default of %v",
-			c.EvmGasBumpPercent(),
-			ethCore.DefaultTxPoolConfig.PriceBump,
-		))
+	// Secondary nodes are send-only, so an enabled chain without a primary
+	// cannot track heads and would silently do nothing useful
+	if !c.EthereumDisabled() && c.EthereumURL() == "" {
+		err = multierr.Combine(err, errors.Errorf("chain %s is enabled but has no primary node: ETH_URL must be set, secondary nodes are send-only", c.ChainID()))
+	}
+	// Chain ID 0 is allowed in dev mode since it is what the null eth client
+	// reports, but transactions signed with it are not replay protected
+	if c.RequireEIP155() && c.ChainID().Sign() <= 0 {
+		if c.Dev() {
+			c.logger().Warnf("ETH_CHAIN_ID is %s, transactions will not be replay protected. This is only allowed in dev mode", c.ChainID())
+		} else {
+			err = multierr.Combine(err, errors.Errorf("ETH_CHAIN_ID must be greater than 0 for EIP-155 replay-protected signing, got: %s. Set ETH_REQUIRE_EIP155=false only if this chain pre-dates EIP-155", c.ChainID()))
+		}
+	}
+	switch strategy := c.EvmGasBumpStrategy(); strategy {
+	case GasBumpStrategyMax, GasBumpStrategyPercent:
+		ethGasBumpPercent := c.EvmGasBumpPercent()
+		if uint64(ethGasBumpPercent) < ethCore.DefaultTxPoolConfig.PriceBump {
+			err = multierr.Combine(err, errors.Errorf(
+				"ETH_GAS_BUMP_PERCENT of %v may not be less than Geth's default of %v",
+				c.EvmGasBumpPercent(),
+				ethCore.DefaultTxPoolConfig.PriceBump,
+			))
+		}
+	case GasBumpStrategyWei:
+		// A fixed bump is a shrinking fraction of the price, so above some
+		// price it no longer meets Geth's minimum for replacing a transaction
+		limit := new(big.Int).Mul(c.EvmGasBumpWei(), big.NewInt(100))
+		limit.Div(limit, new(big.Int).SetUint64(ethCore.DefaultTxPoolConfig.PriceBump))
+		if limit.Cmp(c.EvmMaxGasPriceWei()) < 0 {
+			c.logger().Warnf("ETH_GAS_BUMP_STRATEGY is %q for chain %s, but ETH_GAS_BUMP_WEI of %s is less than Geth's minimum replacement bump of %v%% for gas prices above %s wei; bumped transactions above that price may be rejected", strategy, c.ChainID(), c.EvmGasBumpWei(), ethCore.DefaultTxPoolConfig.PriceBump, limit)
+		}
+	default:
+		err = multierr.Combine(err, errors.Errorf("ETH_GAS_BUMP_STRATEGY must be one of %q, %q or %q, got: %q", GasBumpStrategyMax, GasBumpStrategyPercent, GasBumpStrategyWei, strategy))
 	}
 
-	if uint32(c.EvmGasBumpTxDepth()) > c.EvmMaxInFlightTransactions() {
+	// ETH_MAX_IN_FLIGHT_TRANSACTIONS=0 means unlimited, so any bump depth fits
+	if maxInFlight := c.EvmMaxInFlightTransactions(); maxInFlight == 0 {
+		c.logger().Warnf("ETH_MAX_IN_FLIGHT_TRANSACTIONS is 0 for chain %s, so the number of in-flight transactions is unlimited. On a congested chain transactions may pile up without bound; this is rarely intended in production", c.ChainID())
+	} else if uint32(c.EvmGasBumpTxDepth()) > maxInFlight {
 		err = multierr.Combine(err, errors.New("ETH_GAS_BUMP_TX_DEPTH must be less than or equal to ETH_MAX_IN_FLIGHT_TRANSACTIONS"))
 	}
-	if c.EvmMinGasPriceWei().Cmp(c.EvmGasPriceDefault()) > 0 {
+	minGasPrice, defGasPrice, maxGasPrice := c.GasPriceEnvelope()
+	if minGasPrice.Cmp(defGasPrice) > 0 {
 		err = multierr.Combine(err, errors.New("ETH_MIN_GAS_PRICE_WEI must be less than or equal to ETH_GAS_PRICE_DEFAULT"))
 	}
-	if c.EvmMaxGasPriceWei().Cmp(c.EvmGasPriceDefault()) < 0 {
+	if maxGasPrice.Cmp(defGasPrice) < 0 {
 		err = multierr.Combine(err, errors.New("ETH_MAX_GAS_PRICE_WEI must be greater than or equal to ETH_GAS_PRICE_DEFAULT"))
 	}
+	// Compare against the configured max, since a widened persisted max may
+	// legitimately have reached the ceiling
+	if c.EvmGasPriceDefaultAutoWidenMax() {
+		if ceiling, configuredMax := c.EvmMaxGasPriceWeiCeiling(), c.evmMaxGasPriceWei(noPersisted); ceiling.Cmp(configuredMax) <= 0 {
+			err = multierr.Combine(err, errors.Errorf("ETH_MAX_GAS_PRICE_WEI_CEILING must be greater than ETH_MAX_GAS_PRICE_WEI of %s if ETH_GAS_PRICE_DEFAULT_AUTO_WIDEN_MAX is enabled, got: %s", configuredMax, ceiling))
+		}
+	}
 	if c.EvmHeadTrackerHistoryDepth() < c.EvmFinalityDepth() {
 		err = multierr.Combine(err, errors.New("ETH_HEAD_TRACKER_HISTORY_DEPTH must be equal to or greater than ETH_FINALITY_DEPTH"))
 	}
+	if receiptDepth := c.EvmReceiptFetchDepth(); receiptDepth < 1 {
+		err = multierr.Combine(err, errors.New("ETH_RECEIPT_FETCH_DEPTH must be greater than or equal to 1"))
+	} else if historyDepth := c.EvmHeadTrackerHistoryDepth(); receiptDepth > historyDepth {
+		c.logger().Warnf("ETH_RECEIPT_FETCH_DEPTH of %d is greater than ETH_HEAD_TRACKER_HISTORY_DEPTH of %d for chain %s; the confirmer will look for receipts of transactions broadcast before the oldest stored head", receiptDepth, historyDepth, c.ChainID())
+	}
+	if required, historyDepth := c.logBackfillHeadDepth(), c.EvmHeadTrackerHistoryDepth(); historyDepth < required {
+		c.logger().Warnf("ETH_HEAD_TRACKER_HISTORY_DEPTH of %d is less than the %d heads needed for log backfill for chain %s (the greater of ETH_FINALITY_DEPTH and the lesser of BLOCK_BACKFILL_DEPTH and ETH_LOG_BACKFILL_BATCH_SIZE); logs may go missing after a reorg", historyDepth, required, c.ChainID())
+	}
+	if uint(c.EvmBalanceMonitorBlockDelay()) > c.EvmHeadTrackerHistoryDepth() {
+		err = multierr.Combine(err, errors.New("ETH_BALANCE_MONITOR_BLOCK_DELAY must be less than or equal to ETH_HEAD_TRACKER_HISTORY_DEPTH"))
+	}
+	if c.EvmHeadTrackerBackfillDepth() > c.EvmHeadTrackerHistoryDepth() {
+		err = multierr.Combine(err, errors.New("ETH_HEAD_TRACKER_BACKFILL_DEPTH must be less than or equal to ETH_HEAD_TRACKER_HISTORY_DEPTH"))
+	}
+	if maxReorgDepth := c.EvmHeadTrackerMaxReorgDepth(); maxReorgDepth > 0 {
+		if maxReorgDepth <= c.EvmFinalityDepth() {
+			err = multierr.Combine(err, errors.New("ETH_HEAD_TRACKER_MAX_REORG_DEPTH must be 0 or greater than ETH_FINALITY_DEPTH"))
+		} else if historyDepth := c.EvmHeadTrackerHistoryDepth(); maxReorgDepth > historyDepth {
+			c.logger().Warnf("ETH_HEAD_TRACKER_MAX_REORG_DEPTH of %d is greater than ETH_HEAD_TRACKER_HISTORY_DEPTH of %d for chain %s; %d heads will be retained", maxReorgDepth, historyDepth, c.ChainID(), maxReorgDepth)
+		}
+	}
+	if gasLimit := c.EvmGasLimitTransfer(); gasLimit < MinGasLimitTransfer {
+		err = multierr.Combine(err, errors.Errorf("ETH_GAS_LIMIT_TRANSFER must be greater than or equal to %d, got: %d", MinGasLimitTransfer, gasLimit))
+	}
+	if c.EvmConfirmerConcurrency() < 1 {
+		err = multierr.Combine(err, errors.New("ETH_CONFIRMER_CONCURRENCY must be greater than or equal to 1"))
+	}
+	if c.EvmCallTimeout() <= 0 {
+		err = multierr.Combine(err, errors.New("ETH_CALL_TIMEOUT must be greater than 0"))
+	}
+	switch txType := c.EvmForceTxType(); txType {
+	case TxTypeAuto, TxTypeLegacy:
+	case TxTypeDynamicFee:
+		if !c.chainSpecificConfig.EIP1559DynamicFees {
+			err = multierr.Combine(err, errors.Errorf("ETH_FORCE_TX_TYPE of %d requires EIP-1559 dynamic fees, which are not supported on chain %s", txType, c.ChainID()))
+		}
+	default:
+		err = multierr.Combine(err, errors.Errorf("ETH_FORCE_TX_TYPE must be one of %d (auto), %d (legacy) or %d (dynamic fee), got: %d", TxTypeAuto, TxTypeLegacy, TxTypeDynamicFee, txType))
+	}
+	if c.EvmUseFinalityTag() && !c.chainSpecificConfig.FinalityTagSupported {
+		c.logger().Warnf("ETH_USE_FINALITY_TAG is enabled but chain %s is not known to support the finalized block tag; finality will fall back to ETH_FINALITY_DEPTH if the tag is unavailable", c.ChainID())
+	}
+	if m := c.EvmGasLimitMultiplier(); !(m > 0) || math.IsInf(float64(m), 0) {
+		err = multierr.Combine(err, errors.Errorf("ETH_GAS_LIMIT_MULTIPLIER must be a positive number, got: %v", m))
+	}
+	if mode := c.EvmHeadTrackerSamplingMode(); mode != HeadTrackerSamplingModeFixed && mode != HeadTrackerSamplingModeAdaptive {
+		err = multierr.Combine(err, errors.Errorf("ETH_HEAD_TRACKER_SAMPLING_MODE must be %q or %q, got: %q", HeadTrackerSamplingModeFixed, HeadTrackerSamplingModeAdaptive, mode))
+	}
+	if c.EvmHeadTrackerMaxBufferSize() < 1 {
+		err = multierr.Combine(err, errors.New("ETH_HEAD_TRACKER_MAX_BUFFER_SIZE must be greater than or equal to 1"))
+	}
 	if c.GasEstimatorMode() == "BlockHistory" && c.BlockHistoryEstimatorBlockHistorySize() <= 0 {
 		err = multierr.Combine(err, errors.New("GAS_UPDATER_BLOCK_HISTORY_SIZE must be greater than or equal to 1 if block history estimator is enabled"))
 	}
+	if percentile := c.BlockHistoryEstimatorTransactionPercentile(); percentile > 100 {
+		err = multierr.Combine(err, errors.Errorf("BLOCK_HISTORY_ESTIMATOR_TRANSACTION_PERCENTILE must be between 0 and 100, got: %d", percentile))
+	}
 	if c.EvmFinalityDepth() < 1 {
 		err = multierr.Combine(err, errors.New("ETH_FINALITY_DEPTH must be greater than or equal to 1"))
 	}
+	// Waiting for more confirmations than the finality depth gains nothing
+	if confs, depth := c.OCRContractConfirmations(0), c.EvmFinalityDepth(); confs < 1 || uint(confs) > depth {
+		err = multierr.Combine(err, errors.Errorf("OCR_CONTRACT_CONFIRMATIONS must be between 1 and ETH_FINALITY_DEPTH (%d) for chain %s, got: %d", depth, c.ChainID(), confs))
+	}
+	if min, max := c.NodeWSReconnectMinBackoff(), c.NodeWSReconnectMaxBackoff(); min <= 0 || max <= 0 {
+		err = multierr.Combine(err, errors.Errorf("ETH_NODE_WS_RECONNECT_MIN_BACKOFF and ETH_NODE_WS_RECONNECT_MAX_BACKOFF must be positive, got: %s and %s", min, max))
+	} else if min > max {
+		err = multierr.Combine(err, errors.Errorf("ETH_NODE_WS_RECONNECT_MIN_BACKOFF must not be greater than ETH_NODE_WS_RECONNECT_MAX_BACKOFF, got: %s and %s", min, max))
+	}
+	if c.NodeCircuitBreakerThreshold() > 0 && c.NodeCircuitBreakerCooldown() <= 0 {
+		err = multierr.Combine(err, errors.New("ETH_NODE_CIRCUIT_BREAKER_COOLDOWN must be greater than 0 if ETH_NODE_CIRCUIT_BREAKER_THRESHOLD is set"))
+	}
+	if mode := c.NodeSelectionMode(); mode != NodeSelectionModeRoundRobin && mode != NodeSelectionModeLowestLatency {
+		err = multierr.Combine(err, errors.Errorf("ETH_NODE_SELECTION_MODE must be %q or %q, got: %q", NodeSelectionModeRoundRobin, NodeSelectionModeLowestLatency, mode))
+	}
+	if minAccepts, secondaries := c.SendOnlyNodeMinAccepts(), len(c.EthereumSecondaryURLs()); int(minAccepts) > secondaries {
+		err = multierr.Combine(err, errors.Errorf("ETH_SEND_ONLY_NODE_MIN_ACCEPTS must not be greater than the number of ETH_SECONDARY_URLS (%d), got: %d", secondaries, minAccepts))
+	}
+	if rps, burst := c.nodeRateLimit(); rps > 0 && burst < 1 {
+		err = multierr.Combine(err, errors.Errorf("ETH_NODE_RATE_LIMIT_BURST must be greater than or equal to 1 if ETH_NODE_RATE_LIMIT_RPS is set, got: %d", burst))
+	}
 	if c.MinIncomingConfirmations() < 1 {
 		err = multierr.Combine(err, errors.New("MIN_INCOMING_CONFIRMATIONS must be greater than or equal to 1"))
 	}
+	if f := c.EvmIncomingConfirmationsFinalityFraction(); !(f >= 0 && f <= 1) {
+		err = multierr.Combine(err, errors.Errorf("ETH_INCOMING_CONFIRMATIONS_FINALITY_FRACTION must be between 0 and 1, got: %v", f))
+	}
+	if action := c.EvmNonceGapAction(); action != NonceGapActionAlert && action != NonceGapActionHalt {
+		err = multierr.Combine(err, errors.Errorf("ETH_NONCE_GAP_ACTION must be %q or %q, got: %q", NonceGapActionAlert, NonceGapActionHalt, action))
+	}
+	if action := c.EvmInsufficientFundsAction(); action != InsufficientFundsActionRetry && action != InsufficientFundsActionPause {
+		err = multierr.Combine(err, errors.Errorf("ETH_INSUFFICIENT_FUNDS_ACTION must be %q or %q, got: %q", InsufficientFundsActionRetry, InsufficientFundsActionPause, action))
+	}
+	if action := c.EvmFinalityViolationAction(); !knownFinalityViolationActions[action] {
+		err = multierr.Combine(err, errors.Errorf("ETH_FINALITY_VIOLATION_ACTION must be one of %q, %q or %q, got: %q", FinalityViolationActionLog, FinalityViolationActionAlert, FinalityViolationActionHalt, action))
+	}
+	for _, name := range c.EvmDisabledServices() {
+		if !knownEvmServices[name] {
+			c.logger().Warnf("ETH_DISABLED_SERVICES contains unknown service %q for chain %s, ignoring it. Known services are: %s, %s, %s, %s", name, c.ChainID(), EvmServiceBalanceMonitor, EvmServiceHeadTracker, EvmServiceLogPoller, EvmServiceTxBroadcaster)
+		}
+	}
+	if interval, threshold := c.EthTxReaperInterval(), c.EthTxReaperThreshold(); interval > 0 && threshold > 0 && interval >= threshold {
+		c.logger().Warnf("ETH_TX_REAPER_INTERVAL of %s is greater than or equal to ETH_TX_REAPER_THRESHOLD of %s for chain %s; eth_txes will accumulate well beyond the threshold between reaper runs", interval, threshold, c.ChainID())
+	}
 	var override time.Duration
+	timeouts := c.OCRTimeouts()
 	lc := ocrtypes.LocalConfig{
-		BlockchainTimeout:                      c.OCRBlockchainTimeout(override),
+		BlockchainTimeout:                      timeouts.Blockchain,
 		ContractConfigConfirmations:            c.OCRContractConfirmations(0),
 		ContractConfigTrackerPollInterval:      c.OCRContractPollInterval(override),
 		ContractConfigTrackerSubscribeInterval: c.OCRContractSubscribeInterval(override),
-		ContractTransmitterTransmitTimeout:     c.OCRContractTransmitterTransmitTimeout(),
-		DatabaseTimeout:                        c.OCRDatabaseTimeout(),
-		DataSourceTimeout:                      c.OCRObservationTimeout(override),
+		ContractTransmitterTransmitTimeout:     timeouts.ContractTransmit,
+		DatabaseTimeout:                        timeouts.Database,
+		DataSourceTimeout:                      timeouts.Observation,
 		DataSourceGracePeriod:                  c.OCRObservationGracePeriod(),
 	}
 	if ocrerr := ocr.SanityCheckLocalConfig(lc); ocrerr != nil {
@@ -140,8 +477,10 @@ func (c *evmConfig) validate() (err error) {
 // announce a new head, then route a request to a different node which does not
 // have this head yet.
 func (c *evmConfig) EvmBalanceMonitorBlockDelay() uint16 {
-	val, ok := lookupEnv("ETH_BALANCE_MONITOR_BLOCK_DELAY", parseUint16)
-	if ok {
+	if val, ok := c.lookupPersisted("EvmBalanceMonitorBlockDelay", parseUint16); ok {
+		return val.(uint16)
+	}
+	if val, ok := c.lookupEnv("ETH_BALANCE_MONITOR_BLOCK_DELAY", parseUint16); ok {
 		return val.(uint16)
 	}
 	return c.chainSpecificConfig.BalanceMonitorBlockDelay
@@ -150,7 +489,7 @@ func (c *evmConfig) EvmBalanceMonitorBlockDelay() uint16 {
 // EvmGasBumpThreshold is the number of blocks to wait before bumping gas again on unconfirmed transactions
 // Set to 0 to disable gas bumping
 func (c *evmConfig) EvmGasBumpThreshold() uint64 {
-	val, ok := lookupEnv("ETH_GAS_BUMP_THRESHOLD", parseUint64)
+	val, ok := c.lookupEnv("ETH_GAS_BUMP_THRESHOLD", parseUint64)
 	if ok {
 		return val.(uint64)
 	}
@@ -159,19 +498,18 @@ func (c *evmConfig) EvmGasBumpThreshold() uint64 {
 
 // EvmGasBumpWei is the minimum fixed amount of wei by which gas is bumped on each transaction attempt
 func (c *evmConfig) EvmGasBumpWei() *big.Int {
-	val, ok := lookupEnv("ETH_GAS_BUMP_WEI", parseBigInt)
+	val, ok := c.lookupEnv("ETH_GAS_BUMP_WEI", parseBigInt)
 	if ok {
 		return val.(*big.Int)
 	}
-	n := c.chainSpecificConfig.GasBumpWei
-	return &n
+	return c.defaultGasBumpWei
 }
 
 // EvmMaxInFlightTransactions controls how many transactions are allowed to be
 // "in-flight" i.e. broadcast but unconfirmed at any one time
 // 0 value disables the limit
 func (c *evmConfig) EvmMaxInFlightTransactions() uint32 {
-	val, ok := lookupEnv("ETH_MAX_IN_FLIGHT_TRANSACTIONS", parseUint32)
+	val, ok := c.lookupEnv("ETH_MAX_IN_FLIGHT_TRANSACTIONS", parseUint32)
 	if ok {
 		return val.(uint32)
 	}
@@ -181,12 +519,87 @@ func (c *evmConfig) EvmMaxInFlightTransactions() uint32 {
 // EvmMaxGasPriceWei is the maximum amount in Wei that a transaction will be
 // bumped to before abandoning it and marking it as errored.
 func (c *evmConfig) EvmMaxGasPriceWei() *big.Int {
-	val, ok := lookupEnv("ETH_MAX_GAS_PRICE_WEI", parseBigInt)
+	return c.evmMaxGasPriceWei(c.lookupPersisted)
+}
+
+func (c *evmConfig) evmMaxGasPriceWei(lookupPersisted persistedLookup) *big.Int {
+	if val, ok := lookupPersisted("EvmMaxGasPriceWei", parseBigInt); ok {
+		return val.(*big.Int)
+	}
+	val, ok := c.lookupEnv("ETH_MAX_GAS_PRICE_WEI", parseBigInt)
 	if ok {
 		return val.(*big.Int)
 	}
-	n := c.chainSpecificConfig.MaxGasPriceWei
-	return &n
+	return c.defaultMaxGasPriceWei
+}
+
+// EvmMaxGasPriceWeiCeiling is the absolute limit up to which
+// EvmGasPriceDefaultAutoWidenMax may raise EvmMaxGasPriceWei. It is 0 if
+// unset, which fails validation when auto-widening is enabled.
+func (c *evmConfig) EvmMaxGasPriceWeiCeiling() *big.Int {
+	if val, ok := c.lookupEnv("ETH_MAX_GAS_PRICE_WEI_CEILING", parseBigInt); ok {
+		return val.(*big.Int)
+	}
+	return big.NewInt(0)
+}
+
+// SetEvmMaxGasPriceWei saves a runtime value for the maximum gas price. It may
+// not be set below EvmMinGasPriceWei or EvmGasPriceDefault.
+func (c *evmConfig) SetEvmMaxGasPriceWei(ctx context.Context, value *big.Int) error {
+	if min := c.EvmMinGasPriceWei(); value.Cmp(min) < 0 {
+		return errors.Errorf("cannot set max gas price to %s, it is below the minimum gas price of %s", value.String(), min.String())
+	}
+	if def := c.EvmGasPriceDefault(); value.Cmp(def) < 0 {
+		return errors.Errorf("cannot set max gas price to %s, it is below the default gas price of %s", value.String(), def.String())
+	}
+	return c.setPersisted(ctx, "EvmMaxGasPriceWei", value)
+}
+
+// EvmMaxNonceGap is the largest gap allowed between the highest confirmed
+// nonce and the lowest unconfirmed nonce of a key before the EthConfirmer
+// takes the EvmNonceGapAction. Such gaps need manual intervention, usually
+// after a re-org deeper than EvmFinalityDepth. 0 disables the check.
+func (c *evmConfig) EvmMaxNonceGap() uint64 {
+	if val, ok := c.lookupEnv("ETH_MAX_NONCE_GAP", parseUint64); ok {
+		return val.(uint64)
+	}
+	return 0
+}
+
+// Actions the EthConfirmer may take on finding a nonce gap larger than
+// EvmMaxNonceGap
+const (
+	NonceGapActionAlert = "alert"
+	NonceGapActionHalt  = "halt"
+)
+
+// EvmNonceGapAction is what the EthConfirmer does on finding a nonce gap
+// larger than EvmMaxNonceGap. "alert" logs an error and reports the gap in the
+// tx_manager_nonce_gap metric, and "halt" also stops broadcasting new
+// transactions on the chain until the node is restarted.
+func (c *evmConfig) EvmNonceGapAction() string {
+	if val, ok := c.lookupEnv("ETH_NONCE_GAP_ACTION", parseString); ok {
+		return val.(string)
+	}
+	return NonceGapActionAlert
+}
+
+// Actions the EthBroadcaster may take when a transaction is rejected for
+// insufficient funds
+const (
+	InsufficientFundsActionRetry = "retry"
+	InsufficientFundsActionPause = "pause"
+)
+
+// EvmInsufficientFundsAction is what the EthBroadcaster does when a key does
+// not have enough funds to send a transaction. "retry" sends the transaction
+// again on every poll until it is accepted, and "pause" stops broadcasting
+// from the key until its balance covers the transaction.
+func (c *evmConfig) EvmInsufficientFundsAction() string {
+	if val, ok := c.lookupEnv("ETH_INSUFFICIENT_FUNDS_ACTION", parseString); ok {
+		return val.(string)
+	}
+	return InsufficientFundsActionRetry
 }
 
 // EvmMaxQueuedTransactions is the maximum number of unbroadcast
@@ -194,7 +607,7 @@ func (c *evmConfig) EvmMaxGasPriceWei() *big.Int {
 // failing and rejecting send of any further transactions.
 // 0 value disables
 func (c *evmConfig) EvmMaxQueuedTransactions() uint64 {
-	val, ok := lookupEnv("ETH_MAX_QUEUED_TRANSACTIONS", parseUint64)
+	val, ok := c.lookupEnv("ETH_MAX_QUEUED_TRANSACTIONS", parseUint64)
 	if ok {
 		return val.(uint64)
 	}
@@ -204,17 +617,16 @@ func (c *evmConfig) EvmMaxQueuedTransactions() uint64 {
 // EvmMinGasPriceWei is the minimum amount in Wei that a transaction may be priced.
 // Chainlink will never send a transaction priced below this amount.
 func (c *evmConfig) EvmMinGasPriceWei() *big.Int {
-	val, ok := lookupEnv("ETH_MIN_GAS_PRICE_WEI", parseBigInt)
+	val, ok := c.lookupEnv("ETH_MIN_GAS_PRICE_WEI", parseBigInt)
 	if ok {
 		return val.(*big.Int)
 	}
-	n := c.chainSpecificConfig.MinGasPriceWei
-	return &n
+	return c.defaultMinGasPriceWei
 }
 
 // EvmGasLimitDefault sets the default gas limit for outgoing transactions.
 func (c *evmConfig) EvmGasLimitDefault() uint64 {
-	val, ok := lookupEnv("ETH_GAS_LIMIT_DEFAULT", parseUint64)
+	val, ok := c.lookupEnv("ETH_GAS_LIMIT_DEFAULT", parseUint64)
 	if ok {
 		return val.(uint64)
 	}
@@ -223,56 +635,260 @@ func (c *evmConfig) EvmGasLimitDefault() uint64 {
 
 // EvmGasLimitTransfer is the gas limit for an ordinary eth->eth transfer
 func (c *evmConfig) EvmGasLimitTransfer() uint64 {
-	val, ok := lookupEnv("ETH_GAS_LIMIT_TRANSFER", parseUint64)
+	if val, ok := c.lookupPersisted("EvmGasLimitTransfer", parseUint64); ok {
+		return val.(uint64)
+	}
+	val, ok := c.lookupEnv("ETH_GAS_LIMIT_TRANSFER", parseUint64)
 	if ok {
 		return val.(uint64)
 	}
 	return c.chainSpecificConfig.GasLimitTransfer
 }
 
+// MinGasLimitTransfer is the intrinsic gas cost of a plain value transfer,
+// below which it can never succeed
+const MinGasLimitTransfer = 21000
+
 // EvmGasPriceDefault is the starting gas price for every transaction
 // FIXME: This needs to be scoped to the Chain not global config when multichain ships
 // See: https://app.clubhouse.io/chainlinklabs/story/12739/generalise-necessary-models-tables-on-the-send-side-to-support-the-concept-of-multiple-chains
 func (c *evmConfig) EvmGasPriceDefault() *big.Int {
-	// HACK: For now we do this manual cast which is less than ideal, but will
-	// be replaced with chain-specific configs in a followup PR
-	concreteGCfg, ok := c.GeneralConfig.(*generalConfig)
-	if ok && concreteGCfg.ORM != nil {
-		var value big.Int
-		if err := concreteGCfg.ORM.GetConfigValue("EvmGasPriceDefault", &value); err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
-			logger.Warnw("Error while trying to fetch EvmGasPriceDefault.", "error", err)
-		} else if err == nil {
-			return &value
-		}
+	return c.evmGasPriceDefault(c.lookupPersisted)
+}
+
+func (c *evmConfig) evmGasPriceDefault(lookupPersisted persistedLookup) *big.Int {
+	c.gasPriceDefaultMu.Lock()
+	pending := c.gasPriceDefaultPending
+	c.gasPriceDefaultMu.Unlock()
+	if pending != nil {
+		return pending
 	}
-	val, ok := lookupEnv("ETH_GAS_PRICE_DEFAULT", parseBigInt)
+	if val, ok := lookupPersisted("EvmGasPriceDefault", parseBigInt); ok {
+		return val.(*big.Int)
+	}
+	val, ok := c.lookupEnv("ETH_GAS_PRICE_DEFAULT", parseBigInt)
 	if ok {
 		return val.(*big.Int)
 	}
-	n := c.chainSpecificConfig.GasPriceDefault
-	return &n
+	return c.defaultGasPriceDefault
+}
+
+// GasPriceEnvelope returns EvmMinGasPriceWei, EvmGasPriceDefault and
+// EvmMaxGasPriceWei together. The chain's persisted config is read under a
+// single lock, so a concurrent ReloadPersistedConfig cannot cause a mix of old
+// and new values to be returned as it could with three separate calls.
+func (c *evmConfig) GasPriceEnvelope() (min, def, max *big.Int) {
+	c.persistedMu.RLock()
+	defer c.persistedMu.RUnlock()
+	return c.EvmMinGasPriceWei(), c.evmGasPriceDefault(c.lookupPersistedLocked), c.evmMaxGasPriceWei(c.lookupPersistedLocked)
+}
+
+// EvmGasPriceDefaultAutoWidenMax controls whether setting a default gas price
+// above EvmMaxGasPriceWei raises the persisted max to match, up to
+// EvmMaxGasPriceWeiCeiling, instead of being rejected. This keeps gas bumping
+// going during extreme congestion at the cost of more expensive transactions.
+func (c *evmConfig) EvmGasPriceDefaultAutoWidenMax() bool {
+	val, ok := c.lookupEnv("ETH_GAS_PRICE_DEFAULT_AUTO_WIDEN_MAX", parseBool)
+	if ok {
+		return val.(bool)
+	}
+	return false
+}
+
+// EvmGasPriceDefaultSeedFromNetwork controls whether, on a chain with no
+// persisted EvmGasPriceDefault, the default is seeded from eth_gasPrice at
+// startup instead of using the static chain default.
+func (c *evmConfig) EvmGasPriceDefaultSeedFromNetwork() bool {
+	val, ok := c.lookupEnv("ETH_GAS_PRICE_DEFAULT_SEED_FROM_NETWORK", parseBool)
+	if ok {
+		return val.(bool)
+	}
+	return false
+}
+
+// SeedEvmGasPriceDefault queries the network gas price and persists it as
+// EvmGasPriceDefault, clamped to EvmMinGasPriceWei and EvmMaxGasPriceWei. It
+// does nothing if a default has already been persisted.
+func (c *evmConfig) SeedEvmGasPriceDefault(ctx context.Context, ethClient eth.Client) error {
+	if _, ok := c.lookupPersisted("EvmGasPriceDefault", parseBigInt); ok {
+		return nil
+	}
+	price, err := ethClient.SuggestGasPrice(ctx)
+	if err != nil {
+		return errors.Wrap(err, "SeedEvmGasPriceDefault failed to fetch gas price")
+	}
+	if min := c.EvmMinGasPriceWei(); price.Cmp(min) < 0 {
+		price = min
+	} else if max := c.EvmMaxGasPriceWei(); price.Cmp(max) > 0 {
+		price = max
+	}
+	if err := c.SetEvmGasPriceDefaultCtx(ctx, price); err != nil {
+		return err
+	}
+	c.logger().Infow(fmt.Sprintf("Seeded EvmGasPriceDefault from network: %s wei", price.String()), "gasPriceWei", price)
+	return nil
 }
 
 // SetEvmGasPriceDefault saves a runtime value for the default gas price for transactions
 func (c *evmConfig) SetEvmGasPriceDefault(value *big.Int) error {
+	return c.SetEvmGasPriceDefaultCtx(context.Background(), value)
+}
+
+// SetEvmGasPriceDefaultCtx saves a runtime value for the default gas price for
+// transactions, aborting the database write if ctx is cancelled. If
+// EvmGasPriceDefaultAutoWidenMax is enabled, a value above EvmMaxGasPriceWei
+// raises the persisted max to match, up to EvmMaxGasPriceWeiCeiling.
+func (c *evmConfig) SetEvmGasPriceDefaultCtx(ctx context.Context, value *big.Int) error {
+	if c.envOnly {
+		return nil
+	}
 	min := c.EvmMinGasPriceWei()
 	max := c.EvmMaxGasPriceWei()
 	if value.Cmp(min) < 0 {
 		return errors.Errorf("cannot set default gas price to %s, it is below the minimum allowed value of %s", value.String(), min.String())
 	}
 	if value.Cmp(max) > 0 {
-		return errors.Errorf("cannot set default gas price to %s, it is above the maximum allowed value of %s", value.String(), max.String())
+		if !c.EvmGasPriceDefaultAutoWidenMax() {
+			return errors.Errorf("cannot set default gas price to %s, it is above the maximum allowed value of %s", value.String(), max.String())
+		}
+		ceiling := c.EvmMaxGasPriceWeiCeiling()
+		if value.Cmp(ceiling) > 0 {
+			return errors.Errorf("cannot set default gas price to %s, it is above the maximum allowed value of %s and cannot be widened past the ceiling of %s", value.String(), max.String(), ceiling.String())
+		}
+		if err := c.setPersisted(ctx, "EvmMaxGasPriceWei", value); err != nil && !errors.Is(err, ErrPersistenceDisabled) {
+			return errors.Wrap(err, "failed to widen max gas price")
+		}
+		c.logger().Errorw(fmt.Sprintf("Raised EvmMaxGasPriceWei from %s to %s wei to allow the requested default gas price. Transactions on this chain may now cost up to %s wei per gas; it will not be raised past %s wei", max, value, value, ceiling),
+			"oldMaxGasPriceWei", max, "newMaxGasPriceWei", value, "ceilingWei", ceiling)
 	}
-	// HACK: For now we do this manual cast which is less than ideal, but will
-	// be replaced with chain-specific configs in a followup PR
-	concreteGCfg, ok := c.GeneralConfig.(*generalConfig)
-	if !ok {
-		return errors.Errorf("cannot get runtime store; %T is not *generalConfig", c.GeneralConfig)
+	if c.EvmGasPriceDefaultUpdateInterval() <= 0 {
+		return c.setPersisted(ctx, "EvmGasPriceDefault", value)
 	}
-	if concreteGCfg.ORM == nil {
-		return errors.New("SetEvmGasPriceDefault: No runtime store installed")
+	return c.setEvmGasPriceDefaultDebounced(ctx, value)
+}
+
+// setEvmGasPriceDefaultDebounced makes value visible to EvmGasPriceDefault
+// immediately, but persists it at most once per
+// EvmGasPriceDefaultUpdateInterval. If the last write was too recent, the
+// write is deferred until the interval has passed and then saves whichever
+// value was set last. A deferred write that fails is logged and retried after
+// another interval.
+func (c *evmConfig) setEvmGasPriceDefaultDebounced(ctx context.Context, value *big.Int) error {
+	interval := c.EvmGasPriceDefaultUpdateInterval()
+
+	c.gasPriceDefaultMu.Lock()
+	c.gasPriceDefaultPending = new(big.Int).Set(value)
+	if c.gasPriceDefaultTimer != nil {
+		// A deferred write is already scheduled and will pick up this value
+		c.gasPriceDefaultMu.Unlock()
+		return nil
+	}
+	if wait := interval - time.Since(c.gasPriceDefaultLastWrite); wait > 0 && !c.gasPriceDefaultClosed {
+		c.deferEvmGasPriceDefaultFlushLocked(wait)
+		c.gasPriceDefaultMu.Unlock()
+		return nil
+	}
+	c.gasPriceDefaultMu.Unlock()
+	return c.flushEvmGasPriceDefault(ctx, false)
+}
+
+// deferEvmGasPriceDefaultFlushLocked schedules a write of the pending
+// EvmGasPriceDefault after wait. gasPriceDefaultMu must be held.
+func (c *evmConfig) deferEvmGasPriceDefaultFlushLocked(wait time.Duration) {
+	c.gasPriceDefaultTimer = time.AfterFunc(wait, func() {
+		err := c.flushEvmGasPriceDefault(context.Background(), true)
+		if err == nil || errors.Is(err, ErrPersistenceDisabled) {
+			return
+		}
+		interval := c.EvmGasPriceDefaultUpdateInterval()
+		c.logger().Errorw(fmt.Sprintf("Failed to persist EvmGasPriceDefault, retrying in %s", interval), "err", err)
+		c.gasPriceDefaultMu.Lock()
+		defer c.gasPriceDefaultMu.Unlock()
+		if c.gasPriceDefaultTimer == nil && c.gasPriceDefaultPending != nil && !c.gasPriceDefaultClosed {
+			c.deferEvmGasPriceDefaultFlushLocked(interval)
+		}
+	})
+}
+
+// flushEvmGasPriceDefault persists the pending EvmGasPriceDefault, if any. If
+// the write fails, the pending value is kept, and still served, when retry is
+// set, so that a deferred write can try again; otherwise it is dropped since
+// the caller reports the error.
+func (c *evmConfig) flushEvmGasPriceDefault(ctx context.Context, retry bool) error {
+	c.gasPriceDefaultMu.Lock()
+	pending := c.gasPriceDefaultPending
+	c.gasPriceDefaultTimer = nil
+	c.gasPriceDefaultLastWrite = time.Now()
+	c.gasPriceDefaultMu.Unlock()
+	if pending == nil {
+		return nil
+	}
+
+	err := c.setPersisted(ctx, "EvmGasPriceDefault", pending)
+	if err != nil && !errors.Is(err, ErrPersistenceDisabled) && retry {
+		return err
+	}
+	c.gasPriceDefaultMu.Lock()
+	if c.gasPriceDefaultPending == pending {
+		c.gasPriceDefaultPending = nil
+	}
+	c.gasPriceDefaultMu.Unlock()
+	return err
+}
+
+// Close persists any EvmGasPriceDefault whose write was deferred by
+// EvmGasPriceDefaultUpdateInterval, and stops the deferred write. Values set
+// after Close are persisted straight away. It must be called on shutdown,
+// before the DB is closed.
+func (c *evmConfig) Close() error {
+	c.gasPriceDefaultMu.Lock()
+	c.gasPriceDefaultClosed = true
+	if c.gasPriceDefaultTimer != nil {
+		c.gasPriceDefaultTimer.Stop()
+		c.gasPriceDefaultTimer = nil
+	}
+	c.gasPriceDefaultMu.Unlock()
+	err := c.flushEvmGasPriceDefault(context.Background(), false)
+	if errors.Is(err, ErrPersistenceDisabled) {
+		return nil
+	}
+	return err
+}
+
+// EvmGasPriceDefaultUpdateInterval limits how often SetEvmGasPriceDefault
+// writes to the database. New values are used immediately, but are persisted
+// at most once per interval, so an estimator updating the default many times
+// a second does not cause a DB write each time. 0 persists every update.
+func (c *evmConfig) EvmGasPriceDefaultUpdateInterval() time.Duration {
+	if val, ok := c.lookupEnv("ETH_GAS_PRICE_DEFAULT_UPDATE_INTERVAL", parseDuration); ok {
+		return val.(time.Duration)
+	}
+	return 0
+}
+
+// GasPriceBounds is implemented by any config that bounds gas prices
+type GasPriceBounds interface {
+	EvmMaxGasPriceWei() *big.Int
+	EvmMinGasPriceWei() *big.Int
+}
+
+// ClampGasPrice returns a copy of gasPrice bounded to [EvmMinGasPriceWei,
+// EvmMaxGasPriceWei]. Gas estimators must route their output through this so
+// that no estimator can emit an out-of-bounds price.
+func ClampGasPrice(bounds GasPriceBounds, gasPrice *big.Int) *big.Int {
+	max := bounds.EvmMaxGasPriceWei()
+	min := bounds.EvmMinGasPriceWei()
+	if gasPrice.Cmp(max) > 0 {
+		return new(big.Int).Set(max)
+	} else if gasPrice.Cmp(min) < 0 {
+		return new(big.Int).Set(min)
 	}
-	return concreteGCfg.ORM.SetConfigValue("EvmGasPriceDefault", value)
+	return new(big.Int).Set(gasPrice)
+}
+
+// ClampGasPrice returns gasPrice bounded to [EvmMinGasPriceWei, EvmMaxGasPriceWei]
+func (c *evmConfig) ClampGasPrice(gasPrice *big.Int) *big.Int {
+	return ClampGasPrice(c, gasPrice)
 }
 
 // EvmFinalityDepth is the number of blocks after which an ethereum transaction is considered "final"
@@ -296,35 +912,241 @@ func (c *evmConfig) SetEvmGasPriceDefault(value *big.Int) error {
 // A re-org occurs at height 46 starting at block 41, transaction is marked for rebroadcast
 // A re-org occurs at height 47 starting at block 41, transaction is NOT marked for rebroadcast
 func (c *evmConfig) EvmFinalityDepth() uint {
-	val, ok := lookupEnv("ETH_FINALITY_DEPTH", parseUint64)
+	val, ok := c.lookupEnv("ETH_FINALITY_DEPTH", parseUint64)
 	if ok {
-		return val.(uint)
+		return uint(val.(uint64))
 	}
 	return c.chainSpecificConfig.FinalityDepth
 }
 
+// EvmReceiptFetchDepth is how many blocks back the confirmer keeps looking for
+// a receipt of a transaction that is missing one. Once all of its attempts
+// were broadcast further back than this, it is marked as fatally errored.
+// Operators on chains where receipts lag behind blocks may want to widen it.
+// Defaults to EvmFinalityDepth.
+func (c *evmConfig) EvmReceiptFetchDepth() uint {
+	if val, ok := c.lookupEnv("ETH_RECEIPT_FETCH_DEPTH", parseUint64); ok {
+		return uint(val.(uint64))
+	}
+	return c.EvmFinalityDepth()
+}
+
+// EvmReceiptFetchMaxBlocks limits the confirmer to fetching receipts for
+// attempts broadcast within this many blocks on each head, so that catching up
+// on a large backlog, e.g. after downtime, is spread over several heads rather
+// than sent to the eth node all at once. 0 means no limit.
+func (c *evmConfig) EvmReceiptFetchMaxBlocks() uint64 {
+	if val, ok := c.lookupEnv("ETH_RECEIPT_FETCH_MAX_BLOCKS", parseUint64); ok {
+		return val.(uint64)
+	}
+	return 0
+}
+
+// RequireEIP155 controls whether transactions are signed with EIP-155 replay
+// protection, which binds them to ChainID. It should only be disabled on the
+// rare chains that pre-date EIP-155.
+func (c *evmConfig) RequireEIP155() bool {
+	if val, ok := c.lookupEnv("ETH_REQUIRE_EIP155", parseBool); ok {
+		return val.(bool)
+	}
+	return c.chainSpecificConfig.RequireEIP155
+}
+
+// SignerChainID is the chain ID to sign transactions with. It is nil if
+// RequireEIP155 is false, which selects a pre-EIP-155 signer.
+func (c *evmConfig) SignerChainID() *big.Int {
+	if !c.RequireEIP155() {
+		return nil
+	}
+	return c.ChainID()
+}
+
+// EvmSimulateTransactionsBeforeSend controls whether the EthBroadcaster
+// simulates each transaction with eth_call before sending it. Transactions
+// that would revert are not sent and are marked as errored with the revert
+// reason instead.
+func (c *evmConfig) EvmSimulateTransactionsBeforeSend() bool {
+	if val, ok := c.lookupPersisted("EvmSimulateTransactionsBeforeSend", parseBool); ok {
+		return val.(bool)
+	}
+	if val, ok := c.lookupEnv("ETH_SIMULATE_TRANSACTIONS_BEFORE_SEND", parseBool); ok {
+		return val.(bool)
+	}
+	return c.chainSpecificConfig.SimulateTransactionsBeforeSend
+}
+
+// EvmSkipEstimationForSimpleTransfers makes the EthBroadcaster send
+// transactions with no value and no data, such as heartbeats, at
+// EvmGasLimitTransfer and EvmGasPriceDefault without asking the gas
+// estimator. This saves eth node requests on chains where many are sent.
+func (c *evmConfig) EvmSkipEstimationForSimpleTransfers() bool {
+	if val, ok := c.lookupPersisted("EvmSkipEstimationForSimpleTransfers", parseBool); ok {
+		return val.(bool)
+	}
+	if val, ok := c.lookupEnv("ETH_SKIP_ESTIMATION_FOR_SIMPLE_TRANSFERS", parseBool); ok {
+		return val.(bool)
+	}
+	return false
+}
+
+// Actions the head tracker may take on observing a re-org deeper than
+// EvmFinalityDepth
+const (
+	FinalityViolationActionLog   = "log"
+	FinalityViolationActionAlert = "alert"
+	FinalityViolationActionHalt  = "halt"
+)
+
+var knownFinalityViolationActions = map[string]bool{
+	FinalityViolationActionLog:   true,
+	FinalityViolationActionAlert: true,
+	FinalityViolationActionHalt:  true,
+}
+
+// EvmFinalityViolationAction is what the head tracker does when it sees a
+// re-org deeper than EvmFinalityDepth. "log" logs an error, "alert" also
+// increments the head_tracker_finality_violations_total metric so operators
+// can be paged on it, and "halt" additionally stops broadcasting transactions
+// on the chain until the node is restarted.
+func (c *evmConfig) EvmFinalityViolationAction() string {
+	if val, ok := c.lookupPersisted("EvmFinalityViolationAction", parseString); ok {
+		return val.(string)
+	}
+	if val, ok := c.lookupEnv("ETH_FINALITY_VIOLATION_ACTION", parseString); ok {
+		return val.(string)
+	}
+	return FinalityViolationActionLog
+}
+
+// Transaction types that EvmForceTxType may select
+const (
+	TxTypeAuto       = -1
+	TxTypeLegacy     = 0
+	TxTypeDynamicFee = 2
+)
+
+// EvmForceTxType forces the type of transactions sent on this chain: 0 for
+// legacy and 2 for EIP-1559 dynamic fee transactions. TxTypeAuto (-1) lets the
+// tx builder pick the best type the chain supports. This is useful on chains
+// that advertise EIP-1559 but handle it badly.
+func (c *evmConfig) EvmForceTxType() int {
+	if val, ok := c.lookupPersisted("EvmForceTxType", parseInt); ok {
+		return val.(int)
+	}
+	if val, ok := c.lookupEnv("ETH_FORCE_TX_TYPE", parseInt); ok {
+		return val.(int)
+	}
+	return c.chainSpecificConfig.ForceTxType
+}
+
+// EvmUseFinalityTag controls whether finality is determined by the block
+// returned for the `finalized` tag rather than by EvmFinalityDepth. Consumers
+// should fall back to depth-based finality if the node does not serve the tag.
+func (c *evmConfig) EvmUseFinalityTag() bool {
+	if val, ok := c.lookupPersisted("EvmUseFinalityTag", parseBool); ok {
+		return val.(bool)
+	}
+	if val, ok := c.lookupEnv("ETH_USE_FINALITY_TAG", parseBool); ok {
+		return val.(bool)
+	}
+	return c.chainSpecificConfig.UseFinalityTag
+}
+
+// L1FinalityDepth is the number of L1 blocks after which the batch containing
+// an L2 transaction is considered "final" on L1. L2 chains such as Optimism and
+// Arbitrum only offer finality once the sequencer's batch has been posted to
+// L1 and is itself final there, which EvmFinalityDepth alone cannot express.
+// It is ignored for chains that are not L2s.
+func (c *evmConfig) L1FinalityDepth() uint {
+	if val, ok := c.lookupPersisted("L1FinalityDepth", parseUint64); ok {
+		return uint(val.(uint64))
+	}
+	if val, ok := c.lookupEnv("ETH_L1_FINALITY_DEPTH", parseUint64); ok {
+		return uint(val.(uint64))
+	}
+	return c.chainSpecificConfig.L1FinalityDepth
+}
+
+// IsTxFinal returns true if a transaction that is l2Depth blocks deep on this
+// chain, and whose batch is l1Depth blocks deep on L1, can be considered
+// final. l1Depth is ignored for chains that are not L2s.
+func (c *evmConfig) IsTxFinal(l2Depth, l1Depth uint) bool {
+	if l2Depth < c.EvmFinalityDepth() {
+		return false
+	}
+	if !c.Chain().IsL2() {
+		return true
+	}
+	return l1Depth >= c.L1FinalityDepth()
+}
+
+// EvmHeadTrackerBackfillDepth is the maximum number of blocks the head tracker
+// will backfill for the first head it sees after starting up, e.g. to catch up
+// after downtime. It lets operators cap the catch-up work independently of
+// how many heads are retained. Defaults to EvmHeadTrackerHistoryDepth.
+// Values below EvmFinalityDepth have no effect since every head is backfilled
+// to finality depth regardless.
+func (c *evmConfig) EvmHeadTrackerBackfillDepth() uint {
+	if val, ok := c.lookupPersisted("EvmHeadTrackerBackfillDepth", parseUint64); ok {
+		return uint(val.(uint64))
+	}
+	if val, ok := c.lookupEnv("ETH_HEAD_TRACKER_BACKFILL_DEPTH", parseUint64); ok {
+		return uint(val.(uint64))
+	}
+	return c.EvmHeadTrackerHistoryDepth()
+}
+
 // EvmHeadTrackerHistoryDepth tracks the top N block numbers to keep in the `heads` database table.
 // Note that this can easily result in MORE than N records since in the case of re-orgs we keep multiple heads for a particular block height.
 // This number should be at least as large as `EvmFinalityDepth`.
 // There may be a small performance penalty to setting this to something very large (10,000+)
 func (c *evmConfig) EvmHeadTrackerHistoryDepth() uint {
-	val, ok := lookupEnv("ETH_HEAD_TRACKER_HISTORY_DEPTH", parseUint64)
+	val, ok := c.lookupEnv("ETH_HEAD_TRACKER_HISTORY_DEPTH", parseUint64)
 	if ok {
-		return val.(uint)
+		return uint(val.(uint64))
 	}
 	return c.chainSpecificConfig.HeadTrackerHistoryDepth
 }
 
+// EvmHeadTrackerMaxReorgDepth is the deepest re-org that the head tracker
+// expects to see on chains prone to re-orgs deeper than EvmFinalityDepth, such
+// as some PoA chains. When set, at least this many heads are retained, and a
+// re-org deeper than finality but within this depth is logged as a warning
+// rather than treated as a finality violation. 0 disables it.
+func (c *evmConfig) EvmHeadTrackerMaxReorgDepth() uint {
+	if val, ok := c.lookupEnv("ETH_HEAD_TRACKER_MAX_REORG_DEPTH", parseUint64); ok {
+		return uint(val.(uint64))
+	}
+	return 0
+}
+
 // EvmHeadTrackerSamplingInterval is the interval between sampled head callbacks
 // to services that are only interested in the latest head every some time
 func (c *evmConfig) EvmHeadTrackerSamplingInterval() time.Duration {
-	val, ok := lookupEnv("ETH_HEAD_TRACKER_SAMPLING_INTERVAL", parseDuration)
+	val, ok := c.lookupEnv("ETH_HEAD_TRACKER_SAMPLING_INTERVAL", parseDuration)
 	if ok {
 		return val.(time.Duration)
 	}
 	return c.chainSpecificConfig.HeadTrackerSamplingInterval
 }
 
+// Head tracker sampling modes
+const (
+	HeadTrackerSamplingModeFixed    = "fixed"
+	HeadTrackerSamplingModeAdaptive = "adaptive"
+)
+
+// EvmHeadTrackerSamplingMode controls how often sampled heads are sent.
+// "fixed" uses EvmHeadTrackerSamplingInterval. "adaptive" uses the average
+// block time observed in the head chain instead, and only falls back to
+// EvmHeadTrackerSamplingInterval until there is enough history to measure it.
+func (c *evmConfig) EvmHeadTrackerSamplingMode() string {
+	if val, ok := c.lookupEnv("ETH_HEAD_TRACKER_SAMPLING_MODE", parseString); ok {
+		return val.(string)
+	}
+	return HeadTrackerSamplingModeFixed
+}
+
 // BlockEmissionIdleWarningThreshold is the duration of time since last received head
 // to print a warning log message indicating not receiving heads
 func (c *evmConfig) BlockEmissionIdleWarningThreshold() time.Duration {
@@ -338,7 +1160,7 @@ func (c *evmConfig) BlockEmissionIdleWarningThreshold() time.Duration {
 // mempool.
 // See eth_resender.go for more details
 func (c *evmConfig) EthTxResendAfterThreshold() time.Duration {
-	val, ok := lookupEnv("ETH_TX_RESEND_AFTER_THRESHOLD", parseDuration)
+	val, ok := c.lookupEnv("ETH_TX_RESEND_AFTER_THRESHOLD", parseDuration)
 	if ok {
 		return val.(time.Duration)
 	}
@@ -346,10 +1168,11 @@ func (c *evmConfig) EthTxResendAfterThreshold() time.Duration {
 }
 
 // BlockHistoryEstimatorBatchSize sets the maximum number of blocks to fetch in one batch in the block history estimator
-// If the env var GAS_UPDATER_BATCH_SIZE is set to 0, it defaults to ETH_RPC_DEFAULT_BATCH_SIZE
+// If the persisted value or env var BLOCK_HISTORY_ESTIMATOR_BATCH_SIZE is set to 0, it defaults to ETH_RPC_DEFAULT_BATCH_SIZE
 func (c *evmConfig) BlockHistoryEstimatorBatchSize() (size uint32) {
-	val, ok := lookupEnv("BLOCK_HISTORY_ESTIMATOR_BATCH_SIZE", parseUint32)
-	if ok {
+	if val, ok := c.lookupPersisted("BlockHistoryEstimatorBatchSize", parseUint32); ok {
+		size = val.(uint32)
+	} else if val, ok := c.lookupEnv("BLOCK_HISTORY_ESTIMATOR_BATCH_SIZE", parseUint32); ok {
 		size = val.(uint32)
 	} else {
 		size = c.chainSpecificConfig.BlockHistoryEstimatorBatchSize
@@ -368,7 +1191,7 @@ func (c *evmConfig) BlockHistoryEstimatorBatchSize() (size uint32) {
 // available from the connected node via RPC. In this case you will get false
 // "zero" blocks that are missing transactions.
 func (c *evmConfig) BlockHistoryEstimatorBlockDelay() uint16 {
-	val, ok := lookupEnv("BLOCK_HISTORY_ESTIMATOR_BLOCK_DELAY", parseUint16)
+	val, ok := c.lookupEnv("BLOCK_HISTORY_ESTIMATOR_BLOCK_DELAY", parseUint16)
 	if ok {
 		return val.(uint16)
 	}
@@ -378,7 +1201,7 @@ func (c *evmConfig) BlockHistoryEstimatorBlockDelay() uint16 {
 // BlockHistoryEstimatorBlockHistorySize is the number of past blocks to keep in memory to
 // use as a basis for calculating a percentile gas price
 func (c *evmConfig) BlockHistoryEstimatorBlockHistorySize() uint16 {
-	val, ok := lookupEnv("BLOCK_HISTORY_ESTIMATOR_BLOCK_HISTORY_SIZE", parseUint16)
+	val, ok := c.lookupEnv("BLOCK_HISTORY_ESTIMATOR_BLOCK_HISTORY_SIZE", parseUint16)
 	if ok {
 		return val.(uint16)
 	}
@@ -389,7 +1212,10 @@ func (c *evmConfig) BlockHistoryEstimatorBlockHistorySize() uint16 {
 // if the past transaction history contains four transactions with gas prices:
 // [100, 200, 300, 400], picking 25 for this number will give a value of 200
 func (c *evmConfig) BlockHistoryEstimatorTransactionPercentile() uint16 {
-	val, ok := lookupEnv("BLOCK_HISTORY_ESTIMATOR_TRANSACTION_PERCENTILE", parseUint16)
+	if val, ok := c.lookupPersisted("BlockHistoryEstimatorTransactionPercentile", parseUint16); ok {
+		return val.(uint16)
+	}
+	val, ok := c.lookupEnv("BLOCK_HISTORY_ESTIMATOR_TRANSACTION_PERCENTILE", parseUint16)
 	if ok {
 		return val.(uint16)
 	}
@@ -401,74 +1227,208 @@ func (c *evmConfig) GasEstimatorMode() string {
 	if c.EthereumDisabled() {
 		return "FixedPrice"
 	}
-	val, ok := lookupEnv("GAS_ESTIMATOR_MODE", parseString)
+	val, ok := c.lookupEnv("GAS_ESTIMATOR_MODE", parseString)
 	if ok {
 		return val.(string)
 	}
 	return c.chainSpecificConfig.GasEstimatorMode
 }
 
+// EvmGasEstimatorRequireWarmup keeps the transaction manager from reporting
+// ready until the gas estimator has collected enough data to price
+// transactions itself, e.g. GAS_UPDATER_BLOCK_HISTORY_SIZE blocks in
+// BlockHistory mode. Otherwise transactions sent right after startup are
+// priced at ETH_GAS_PRICE_DEFAULT.
+func (c *evmConfig) EvmGasEstimatorRequireWarmup() bool {
+	if val, ok := c.lookupPersisted("EvmGasEstimatorRequireWarmup", parseBool); ok {
+		return val.(bool)
+	}
+	if val, ok := c.lookupEnv("GAS_ESTIMATOR_REQUIRE_WARMUP", parseBool); ok {
+		return val.(bool)
+	}
+	return c.chainSpecificConfig.GasEstimatorRequireWarmup
+}
+
 // LinkContractAddress represents the address of the official LINK token
 // contract on the current Chain
 func (c *evmConfig) LinkContractAddress() string {
-	val, ok := lookupEnv("LINK_CONTRACT_ADDRESS", parseString)
+	val, ok := c.lookupEnv("LINK_CONTRACT_ADDRESS", parseString)
 	if ok {
 		return val.(string)
 	}
 	return c.chainSpecificConfig.LinkContractAddress
 }
 
+// OCRContractConfirmations returns override if set, otherwise the number of
+// confirmations persisted for this chain, falling back to the env and then
+// the chain default
 func (c *evmConfig) OCRContractConfirmations(override uint16) uint16 {
 	if override != uint16(0) {
 		return override
 	}
-	val, ok := lookupEnv("OCR_CONTRACT_CONFIRMATIONS", parseUint16)
-	if ok {
+	if val, ok := c.lookupPersisted("OCRContractConfirmations", parseUint16); ok {
+		return val.(uint16)
+	}
+	val, ok := c.lookupEnv("OCR_CONTRACT_CONFIRMATIONS", parseUint16)
+	if ok {
 		return val.(uint16)
 	}
 	return c.chainSpecificConfig.OCRContractConfirmations
 }
 
+// OCRContractPollInterval returns override if set, otherwise the interval
+// persisted for this chain, falling back to the general config. Fast L2s
+// typically want a shorter interval than the node-wide default.
+func (c *evmConfig) OCRContractPollInterval(override time.Duration) time.Duration {
+	if override != time.Duration(0) {
+		return override
+	}
+	if d, ok := c.lookupPersistedDuration("OCRContractPollInterval"); ok {
+		return d
+	}
+	return c.GeneralConfig.OCRContractPollInterval(override)
+}
+
+// OCRContractSubscribeInterval returns override if set, otherwise the
+// interval persisted for this chain, falling back to the general config
+func (c *evmConfig) OCRContractSubscribeInterval(override time.Duration) time.Duration {
+	if override != time.Duration(0) {
+		return override
+	}
+	if d, ok := c.lookupPersistedDuration("OCRContractSubscribeInterval"); ok {
+		return d
+	}
+	return c.GeneralConfig.OCRContractSubscribeInterval(override)
+}
+
+// OCRTimeoutSet holds the OCR timeouts that apply to this chain
+type OCRTimeoutSet struct {
+	Blockchain       time.Duration
+	Database         time.Duration
+	Observation      time.Duration
+	ContractTransmit time.Duration
+}
+
+// OCRTimeouts returns the blockchain, database, observation and contract
+// transmit timeouts used by OCR on this chain, without any job-level
+// overrides applied. None of these can currently be overridden per chain, so
+// they always come from the general config.
+func (c *evmConfig) OCRTimeouts() OCRTimeoutSet {
+	return OCRTimeoutSet{
+		Blockchain:       c.OCRBlockchainTimeout(0),
+		Database:         c.OCRDatabaseTimeout(),
+		Observation:      c.OCRObservationTimeout(0),
+		ContractTransmit: c.OCRContractTransmitterTransmitTimeout(),
+	}
+}
+
 // MinIncomingConfirmations represents the minimum number of block
 // confirmations that need to be recorded since a job run started before a task
 // can proceed.
 // MIN_INCOMING_CONFIRMATIONS=1 would kick off a job after seeing the transaction in a block
 // MIN_INCOMING_CONFIRMATIONS=0 would kick off a job even before the transaction is mined, which is not supported
 func (c *evmConfig) MinIncomingConfirmations() uint32 {
-	val, ok := lookupEnv("MIN_INCOMING_CONFIRMATIONS", parseUint32)
+	val, ok := c.lookupEnv("MIN_INCOMING_CONFIRMATIONS", parseUint32)
 	if ok {
 		return val.(uint32)
 	}
 	return c.chainSpecificConfig.MinIncomingConfirmations
 }
 
+// EffectiveIncomingConfirmations is the number of confirmations that incoming
+// logs need before they are acted on: the greater of MinIncomingConfirmations
+// and EvmIncomingConfirmationsFinalityFraction of EvmFinalityDepth, rounded
+// up. Consumers should use it rather than MinIncomingConfirmations.
+func (c *evmConfig) EffectiveIncomingConfirmations() uint32 {
+	return EffectiveIncomingConfirmationsFor(c)
+}
+
+// EffectiveIncomingConfirmationsFor computes EffectiveIncomingConfirmations
+// from cfg's getters, for wrappers of EVMConfig that override them
+func EffectiveIncomingConfirmationsFor(cfg EVMConfig) uint32 {
+	minConfs := cfg.MinIncomingConfirmations()
+	if confs := uint32(math.Ceil(cfg.EvmIncomingConfirmationsFinalityFraction() * float64(cfg.EvmFinalityDepth()))); confs > minConfs {
+		return confs
+	}
+	return minConfs
+}
+
 // MinRequiredOutgoingConfirmations represents the default minimum number of block
 // confirmations that need to be recorded on an outgoing ethtx task before the run can move onto the next task.
 // This can be overridden on a per-task basis by setting the `MinRequiredOutgoingConfirmations` parameter.
 // MIN_OUTGOING_CONFIRMATIONS=1 considers a transaction as "done" once it has been mined into one block
 // MIN_OUTGOING_CONFIRMATIONS=0 would consider a transaction as "done" even before it has been mined
 func (c *evmConfig) MinRequiredOutgoingConfirmations() uint64 {
-	val, ok := lookupEnv("MIN_REQUIRED_OUTGOING_CONFIRMATIONS", parseUint64)
+	val, ok := c.lookupEnv("MIN_REQUIRED_OUTGOING_CONFIRMATIONS", parseUint64)
 	if ok {
 		return val.(uint64)
 	}
 	return c.chainSpecificConfig.MinRequiredOutgoingConfirmations
 }
 
+// EffectiveOutgoingConfirmations returns override if set, otherwise
+// MinRequiredOutgoingConfirmations. Task runners should use it to apply a
+// per-task `minConfirmations` consistently.
+func (c *evmConfig) EffectiveOutgoingConfirmations(override uint64) uint64 {
+	if override != 0 {
+		return override
+	}
+	return c.MinRequiredOutgoingConfirmations()
+}
+
 // MinimumContractPayment represents the minimum amount of LINK that must be
 // supplied for a contract to be considered.
+// An explicitly configured value is taken to be in the smallest unit of the
+// chain's LINK token. Chain defaults assume 18 decimals and are rescaled to
+// LinkDecimals.
 func (c *evmConfig) MinimumContractPayment() *assets.Link {
-	val, ok := lookupEnv("MINIMUM_CONTRACT_PAYMENT_LINK_JUELS", parseLink)
+	val, ok := c.lookupEnv("MINIMUM_CONTRACT_PAYMENT_LINK_JUELS", parseLink)
 	if ok {
 		return val.(*assets.Link)
 	}
-	return c.chainSpecificConfig.MinimumContractPayment
+	return c.chainSpecificConfig.MinimumContractPayment.Rescale(assets.LinkDecimals, c.LinkDecimals())
+}
+
+// LinkDecimals is the number of decimals of the LINK token on this chain.
+// Bridged LINK tokens do not always use the canonical 18.
+func (c *evmConfig) LinkDecimals() uint8 {
+	if val, ok := c.lookupPersisted("LinkDecimals", parseUint8); ok {
+		return val.(uint8)
+	}
+	if val, ok := c.lookupEnv("LINK_DECIMALS", parseUint8); ok {
+		return val.(uint8)
+	}
+	return c.chainSpecificConfig.LinkDecimals
+}
+
+// NativeTokenDecimals is the number of decimals of the chain's native token,
+// for displaying amounts of it
+func (c *evmConfig) NativeTokenDecimals() uint8 {
+	if val, ok := c.lookupPersisted("NativeTokenDecimals", parseUint8); ok {
+		return val.(uint8)
+	}
+	if val, ok := c.lookupEnv("NATIVE_TOKEN_DECIMALS", parseUint8); ok {
+		return val.(uint8)
+	}
+	return c.chainSpecificConfig.NativeTokenDecimals
+}
+
+// NativeTokenSymbol is the symbol of the chain's native token, such as ETH or
+// MATIC, for displaying amounts of it
+func (c *evmConfig) NativeTokenSymbol() string {
+	if val, ok := c.lookupPersisted("NativeTokenSymbol", parseString); ok {
+		return val.(string)
+	}
+	if val, ok := c.lookupEnv("NATIVE_TOKEN_SYMBOL", parseString); ok {
+		return val.(string)
+	}
+	return c.chainSpecificConfig.NativeTokenSymbol
 }
 
 // EvmGasBumpTxDepth is the number of transactions to gas bump starting from oldest.
 // Set to 0 for no limit (i.e. bump all)
 func (c *evmConfig) EvmGasBumpTxDepth() uint16 {
-	val, ok := lookupEnv("ETH_GAS_BUMP_TX_DEPTH", parseUint16)
+	val, ok := c.lookupEnv("ETH_GAS_BUMP_TX_DEPTH", parseUint16)
 	if ok {
 		return val.(uint16)
 	}
@@ -478,7 +1438,7 @@ func (c *evmConfig) EvmGasBumpTxDepth() uint16 {
 // EvmDefaultBatchSize controls the number of receipts fetched in each
 // request in the EvmConfirmer
 func (c *evmConfig) EvmDefaultBatchSize() uint32 {
-	val, ok := lookupEnv("ETH_RPC_DEFAULT_BATCH_SIZE", parseUint32)
+	val, ok := c.lookupEnv("ETH_RPC_DEFAULT_BATCH_SIZE", parseUint32)
 	if ok {
 		return val.(uint32)
 	}
@@ -488,16 +1448,88 @@ func (c *evmConfig) EvmDefaultBatchSize() uint32 {
 // EvmGasBumpPercent is the minimum percentage by which gas is bumped on each transaction attempt
 // Change with care since values below geth's default will fail with "underpriced replacement transaction"
 func (c *evmConfig) EvmGasBumpPercent() uint16 {
-	val, ok := lookupEnv("ETH_GAS_BUMP_PERCENT", parseUint16)
+	val, ok := c.lookupEnv("ETH_GAS_BUMP_PERCENT", parseUint16)
 	if ok {
 		return val.(uint16)
 	}
 	return c.chainSpecificConfig.GasBumpPercent
 }
 
+// Gas bump strategies, which control how EvmGasBumpPercent and EvmGasBumpWei
+// are combined when bumping the gas price of a transaction
+const (
+	// GasBumpStrategyMax bumps by the larger of the two
+	GasBumpStrategyMax = "max"
+	// GasBumpStrategyPercent only bumps by EvmGasBumpPercent
+	GasBumpStrategyPercent = "percent"
+	// GasBumpStrategyWei only bumps by EvmGasBumpWei
+	GasBumpStrategyWei = "wei"
+)
+
+// EvmGasBumpStrategy is how EvmGasBumpPercent and EvmGasBumpWei are combined
+// when bumping gas. "max" (the default) uses whichever gives the larger bump,
+// "percent" bumps geometrically and "wei" bumps by a fixed amount.
+func (c *evmConfig) EvmGasBumpStrategy() string {
+	val, ok := c.lookupEnv("ETH_GAS_BUMP_STRATEGY", parseString)
+	if ok {
+		return val.(string)
+	}
+	return GasBumpStrategyMax
+}
+
+// EvmGasBumpOverflowProtection controls whether NextBumpedGasPrice clamps the
+// bumped gas price to EvmMaxGasPriceWei. With a high EvmGasBumpPercent the
+// price compounds quickly over successive bumps, so this should only be
+// disabled if the caller enforces the maximum itself.
+func (c *evmConfig) EvmGasBumpOverflowProtection() bool {
+	val, ok := c.lookupEnv("ETH_GAS_BUMP_OVERFLOW_PROTECTION", parseBool)
+	if ok {
+		return val.(bool)
+	}
+	return c.chainSpecificConfig.GasBumpOverflowProtection
+}
+
+// NextBumpedGasPrice returns the gas price for the next bump of a transaction
+// currently priced at current. This is EvmGasBumpPercent and/or EvmGasBumpWei,
+// according to EvmGasBumpStrategy, applied on top of the larger of current and
+// EvmGasPriceDefault. maxReached is true if the result is at or above
+// EvmMaxGasPriceWei, in which case it is clamped to EvmMaxGasPriceWei unless
+// EvmGasBumpOverflowProtection is disabled.
+func (c *evmConfig) NextBumpedGasPrice(current *big.Int) (bumped *big.Int, maxReached bool) {
+	baseline := current
+	if def := c.EvmGasPriceDefault(); def.Cmp(baseline) > 0 {
+		baseline = def
+	}
+
+	byPercentage := new(big.Int).Mul(baseline, big.NewInt(int64(100+c.EvmGasBumpPercent())))
+	byPercentage.Div(byPercentage, big.NewInt(100))
+	byIncrement := new(big.Int).Add(baseline, c.EvmGasBumpWei())
+
+	switch c.EvmGasBumpStrategy() {
+	case GasBumpStrategyPercent:
+		bumped = byPercentage
+	case GasBumpStrategyWei:
+		bumped = byIncrement
+	default:
+		bumped = byPercentage
+		if byIncrement.Cmp(bumped) > 0 {
+			bumped = byIncrement
+		}
+	}
+
+	max := c.EvmMaxGasPriceWei()
+	if bumped.Cmp(max) < 0 {
+		return bumped, false
+	}
+	if c.EvmGasBumpOverflowProtection() {
+		return new(big.Int).Set(max), true
+	}
+	return bumped, true
+}
+
 // EvmNonceAutoSync enables/disables running the NonceSyncer on application start
 func (c *evmConfig) EvmNonceAutoSync() bool {
-	val, ok := lookupEnv("ETH_NONCE_AUTO_SYNC", parseBool)
+	val, ok := c.lookupEnv("ETH_NONCE_AUTO_SYNC", parseBool)
 	if ok {
 		return val.(bool)
 	}
@@ -511,19 +1543,49 @@ func (c *evmConfig) EvmNonceAutoSync() bool {
 // This factor is always applied, so includes Optimism L2 transactions which
 // uses a default gas limit of 1 and is also applied to EvmGasLimitDefault.
 func (c *evmConfig) EvmGasLimitMultiplier() float32 {
-	val, ok := lookupEnv("ETH_GAS_LIMIT_MULTIPLIER", parseF32)
+	val, ok := c.lookupEnv("ETH_GAS_LIMIT_MULTIPLIER", parseF32)
 	if ok {
 		return val.(float32)
 	}
 	return c.chainSpecificConfig.GasLimitMultiplier
 }
 
+var maxUint64Decimal = decimal.NewFromBigInt(new(big.Int).SetUint64(math.MaxUint64), 0)
+
+// ApplyGasLimitMultiplier returns gasLimit multiplied by multiplier, rounded up
+// so that transactions are never under-provisioned. The result saturates at
+// math.MaxUint64. A NaN multiplier leaves gasLimit unchanged and a
+// non-positive one gives 0, but validation rejects both.
+func ApplyGasLimitMultiplier(gasLimit uint64, multiplier float32) uint64 {
+	m := float64(multiplier)
+	switch {
+	case math.IsNaN(m):
+		return gasLimit
+	case gasLimit == 0 || m <= 0:
+		return 0
+	case math.IsInf(m, 1):
+		return math.MaxUint64
+	}
+	product := decimal.NewFromBigInt(new(big.Int).SetUint64(gasLimit), 0).Mul(decimal.NewFromFloat32(multiplier)).Ceil()
+	if product.GreaterThan(maxUint64Decimal) {
+		return math.MaxUint64
+	}
+	return product.BigInt().Uint64()
+}
+
+// ApplyGasLimitMultiplier returns gasLimit with EvmGasLimitMultiplier applied
+func (c *evmConfig) ApplyGasLimitMultiplier(gasLimit uint64) uint64 {
+	return ApplyGasLimitMultiplier(gasLimit, c.EvmGasLimitMultiplier())
+}
+
 // EvmHeadTrackerMaxBufferSize is the maximum number of heads that may be
 // buffered in front of the head tracker before older heads start to be
 // dropped. You may think of it as something like the maximum permittable "lag"
 // for the head tracker before we start dropping heads to keep up.
+//
+// Must be at least 1; a buffer of zero would drop every head as it arrives.
 func (c *evmConfig) EvmHeadTrackerMaxBufferSize() uint {
-	val, ok := lookupEnv("ETH_HEAD_TRACKER_MAX_BUFFER_SIZE", parseUint64)
+	val, ok := c.lookupEnv("ETH_HEAD_TRACKER_MAX_BUFFER_SIZE", parseUint64)
 	if ok {
 		return uint(val.(uint64))
 	}
@@ -532,7 +1594,7 @@ func (c *evmConfig) EvmHeadTrackerMaxBufferSize() uint {
 
 // EthTxReaperInterval controls how often the eth tx reaper should run
 func (c *evmConfig) EthTxReaperInterval() time.Duration {
-	val, ok := lookupEnv("ETH_TX_REAPER_INTERVAL", parseDuration)
+	val, ok := c.lookupEnv("ETH_TX_REAPER_INTERVAL", parseDuration)
 	if ok {
 		return val.(time.Duration)
 	}
@@ -549,16 +1611,46 @@ func (c *evmConfig) EthTxReaperInterval() time.Duration {
 // Current head is 142, any eth_tx confirmed in block 91 or below will be reaped as long as its created_at was more than EthTxReaperThreshold ago
 // Set to 0 to disable eth_tx reaping
 func (c *evmConfig) EthTxReaperThreshold() time.Duration {
-	val, ok := lookupEnv("ETH_TX_REAPER_THRESHOLD", parseDuration)
+	val, ok := c.lookupEnv("ETH_TX_REAPER_THRESHOLD", parseDuration)
 	if ok {
 		return val.(time.Duration)
 	}
 	return c.chainSpecificConfig.EthTxReaperThreshold
 }
 
+// logBackfillHeadDepth is the number of heads that must be retained for the
+// log broadcaster to reliably backfill logs. On restart it re-fetches up to
+// BlockBackfillDepth blocks below the last saved head, at most
+// EvmLogBackfillBatchSize blocks at a time, and logs may still be reorged out
+// for EvmFinalityDepth blocks.
+func (c *evmConfig) logBackfillHeadDepth() uint {
+	depth := uint64(c.EvmLogBackfillBatchSize())
+	if backfillDepth := c.BlockBackfillDepth(); backfillDepth < depth {
+		depth = backfillDepth
+	}
+	if finalityDepth := uint64(c.EvmFinalityDepth()); finalityDepth > depth {
+		depth = finalityDepth
+	}
+	return uint(depth)
+}
+
+// EvmIncomingConfirmationsFinalityFraction is the fraction of
+// EvmFinalityDepth that incoming confirmations must reach, for chains with
+// frequent shallow reorgs where a flat MinIncomingConfirmations is too low. 0
+// disables it. See EffectiveIncomingConfirmations.
+func (c *evmConfig) EvmIncomingConfirmationsFinalityFraction() float64 {
+	if val, ok := c.lookupPersisted("EvmIncomingConfirmationsFinalityFraction", parseF64); ok {
+		return val.(float64)
+	}
+	if val, ok := c.lookupEnv("ETH_INCOMING_CONFIRMATIONS_FINALITY_FRACTION", parseF64); ok {
+		return val.(float64)
+	}
+	return c.chainSpecificConfig.IncomingConfirmationsFinalityFraction
+}
+
 // EvmLogBackfillBatchSize sets the batch size for calling FilterLogs when we backfill missing logs
 func (c *evmConfig) EvmLogBackfillBatchSize() uint32 {
-	val, ok := lookupEnv("ETH_LOG_BACKFILL_BATCH_SIZE", parseUint32)
+	val, ok := c.lookupEnv("ETH_LOG_BACKFILL_BATCH_SIZE", parseUint32)
 	if ok {
 		return val.(uint32)
 	}
@@ -568,40 +1660,740 @@ func (c *evmConfig) EvmLogBackfillBatchSize() uint32 {
 // EvmRPCDefaultBatchSize controls the number of receipts fetched in each
 // request in the EvmConfirmer
 func (c *evmConfig) EvmRPCDefaultBatchSize() uint32 {
-	val, ok := lookupEnv("ETH_RPC_DEFAULT_BATCH_SIZE", parseUint32)
+	val, ok := c.lookupEnv("ETH_RPC_DEFAULT_BATCH_SIZE", parseUint32)
 	if ok {
 		return val.(uint32)
 	}
 	return c.chainSpecificConfig.RPCDefaultBatchSize
 }
 
+// EvmCallTimeout is the timeout for read calls made by the balance monitor
+// (eth_getBalance) and by transaction simulation (eth_call), which may
+// legitimately take longer than other requests on slow archive nodes. It
+// defaults to the timeout for other requests to the eth node.
+func (c *evmConfig) EvmCallTimeout() time.Duration {
+	if d, ok := c.lookupPersistedDuration("EvmCallTimeout"); ok {
+		return d
+	}
+	if val, ok := c.lookupEnv("ETH_CALL_TIMEOUT", parseDuration); ok {
+		return val.(time.Duration)
+	}
+	return eth.DefaultQueryTimeout
+}
+
+// EvmConfirmerConcurrency controls how many batches of receipts the
+// EthConfirmer fetches in parallel. Raise it on high-throughput chains, or
+// leave it at 1 for rate-limited providers. Must be at least 1.
+func (c *evmConfig) EvmConfirmerConcurrency() uint32 {
+	if val, ok := c.lookupPersisted("EvmConfirmerConcurrency", parseUint32); ok {
+		return val.(uint32)
+	}
+	if val, ok := c.lookupEnv("ETH_CONFIRMER_CONCURRENCY", parseUint32); ok {
+		return val.(uint32)
+	}
+	return 1
+}
+
 // FlagsContractAddress represents the Flags contract address
 func (c *evmConfig) FlagsContractAddress() string {
-	val, ok := lookupEnv("FLAGS_CONTRACT_ADDRESS", parseString)
+	val, ok := c.lookupEnv("FLAGS_CONTRACT_ADDRESS", parseString)
 	if ok {
 		return val.(string)
 	}
 	return c.chainSpecificConfig.FlagsContractAddress
 }
 
+// NodeCircuitBreakerThreshold is the number of consecutive failures after
+// which a secondary node is taken out of rotation for
+// NodeCircuitBreakerCooldown. 0 disables the circuit breaker.
+func (c *evmConfig) NodeCircuitBreakerThreshold() uint32 {
+	val, ok := c.lookupEnv("ETH_NODE_CIRCUIT_BREAKER_THRESHOLD", parseUint32)
+	if ok {
+		return val.(uint32)
+	}
+	return 0
+}
+
+// NodeCircuitBreakerCooldown is how long a node is kept out of rotation once
+// its circuit breaker trips, after which a single request is let through to
+// probe it. Failures further apart than this are not consecutive.
+func (c *evmConfig) NodeCircuitBreakerCooldown() time.Duration {
+	val, ok := c.lookupEnv("ETH_NODE_CIRCUIT_BREAKER_COOLDOWN", parseDuration)
+	if ok {
+		return val.(time.Duration)
+	}
+	return time.Minute
+}
+
+// Policies for choosing the node that serves requests spread across nodes
+const (
+	NodeSelectionModeRoundRobin    = "RoundRobin"
+	NodeSelectionModeLowestLatency = "LowestLatency"
+)
+
+// NodeSelectionMode is how requests that may be served by any node, such as
+// the batched receipt fetches of the EthConfirmer, are spread across the
+// primary and secondary nodes. "RoundRobin" rotates through them, and
+// "LowestLatency" prefers the node with the lowest recent response time.
+func (c *evmConfig) NodeSelectionMode() string {
+	if val, ok := c.lookupEnv("ETH_NODE_SELECTION_MODE", parseString); ok {
+		return val.(string)
+	}
+	return NodeSelectionModeRoundRobin
+}
+
+// SendOnlyNodeMinAccepts is the number of secondary (send-only) nodes that
+// must accept each transaction, or all reachable ones if fewer are reachable,
+// before it is considered sent. Otherwise the broadcast fails and is retried.
+// 0 sends to secondary nodes on a best-effort basis.
+func (c *evmConfig) SendOnlyNodeMinAccepts() uint32 {
+	val, ok := c.lookupEnv("ETH_SEND_ONLY_NODE_MIN_ACCEPTS", parseUint32)
+	if ok {
+		return val.(uint32)
+	}
+	return 0
+}
+
+// NodeRateLimit is the maximum sustained number of requests per second, and
+// the burst size above that, that will be sent to each eth node. Providers
+// that meter usage will otherwise start rejecting requests with HTTP 429.
+// An rps of 0 disables rate limiting.
+func (c *evmConfig) NodeRateLimit() (rps float64, burst int) {
+	rps, burst = c.nodeRateLimit()
+	if rps > 0 && burst < 1 {
+		// A limiter with a burst of 0 rejects every request
+		def := int(c.chainSpecificConfig.NodeRateLimitBurst)
+		if def < 1 {
+			def = 1
+		}
+		c.logger().Errorf("ETH_NODE_RATE_LIMIT_BURST of %d is invalid with an ETH_NODE_RATE_LIMIT_RPS of %v for chain %s, using a burst of %d instead", burst, rps, c.ChainID(), def)
+		burst = def
+	}
+	return rps, burst
+}
+
+// nodeRateLimit returns the configured rate limit without checking that
+// burst and rps are consistent, see NodeRateLimit
+func (c *evmConfig) nodeRateLimit() (rps float64, burst int) {
+	rps = c.chainSpecificConfig.NodeRateLimitRPS
+	if val, ok := c.lookupPersisted("NodeRateLimitRPS", parseF64); ok {
+		rps = val.(float64)
+	} else if val, ok := c.lookupEnv("ETH_NODE_RATE_LIMIT_RPS", parseF64); ok {
+		rps = val.(float64)
+	}
+	burst = int(c.chainSpecificConfig.NodeRateLimitBurst)
+	if val, ok := c.lookupPersisted("NodeRateLimitBurst", parseInt); ok {
+		burst = val.(int)
+	} else if val, ok := c.lookupEnv("ETH_NODE_RATE_LIMIT_BURST", parseInt); ok {
+		burst = val.(int)
+	}
+	return rps, burst
+}
+
+// NodeWSReconnectMinBackoff is how long the head listener waits before its
+// second attempt to resubscribe to the primary node's websocket after it
+// drops. The wait doubles on each further attempt, up to
+// NodeWSReconnectMaxBackoff.
+func (c *evmConfig) NodeWSReconnectMinBackoff() time.Duration {
+	if d, ok := c.lookupPersistedDuration("NodeWSReconnectMinBackoff"); ok {
+		return d
+	}
+	if val, ok := c.lookupEnv("ETH_NODE_WS_RECONNECT_MIN_BACKOFF", parseDuration); ok {
+		return val.(time.Duration)
+	}
+	return c.chainSpecificConfig.NodeWSReconnectMinBackoff
+}
+
+// NodeWSReconnectMaxBackoff is the longest the head listener waits between
+// attempts to resubscribe to the primary node's websocket
+func (c *evmConfig) NodeWSReconnectMaxBackoff() time.Duration {
+	if d, ok := c.lookupPersistedDuration("NodeWSReconnectMaxBackoff"); ok {
+		return d
+	}
+	if val, ok := c.lookupEnv("ETH_NODE_WS_RECONNECT_MAX_BACKOFF", parseDuration); ok {
+		return val.(time.Duration)
+	}
+	return c.chainSpecificConfig.NodeWSReconnectMaxBackoff
+}
+
 // BalanceMonitorEnabled enables the balance monitor
 func (c *evmConfig) BalanceMonitorEnabled() bool {
-	if c.EthereumDisabled() {
+	if c.EthereumDisabled() || c.EvmServiceDisabled(EvmServiceBalanceMonitor) {
 		return false
 	}
-	val, ok := lookupEnv("BALANCE_MONITOR_ENABLED", parseBool)
+	val, ok := c.lookupEnv("BALANCE_MONITOR_ENABLED", parseBool)
 	if ok {
 		return val.(bool)
 	}
 	return c.chainSpecificConfig.BalanceMonitorEnabled
 }
 
-func lookupEnv(k string, parse func(string) (interface{}, error)) (interface{}, bool) {
-	s, ok := os.LookupEnv(k)
+// Names of the per-chain services that may be listed in EvmDisabledServices
+const (
+	EvmServiceBalanceMonitor = "balance_monitor"
+	EvmServiceHeadTracker    = "head_tracker"
+	EvmServiceLogPoller      = "log_poller"
+	EvmServiceTxBroadcaster  = "tx_broadcaster"
+)
+
+var knownEvmServices = map[string]bool{
+	EvmServiceBalanceMonitor: true,
+	EvmServiceHeadTracker:    true,
+	EvmServiceLogPoller:      true,
+	EvmServiceTxBroadcaster:  true,
+}
+
+// EvmDisabledServices lists the services that will not be started for this
+// chain, for example the balance monitor on a chain only used for reads. This
+// is finer-grained than ETH_DISABLED, which turns off the chain entirely.
+// Unknown names are ignored with a warning.
+func (c *evmConfig) EvmDisabledServices() []string {
+	if val, ok := c.lookupPersisted("EvmDisabledServices", parseStringList); ok {
+		return val.([]string)
+	}
+	if val, ok := c.lookupEnv("ETH_DISABLED_SERVICES", parseStringList); ok {
+		return val.([]string)
+	}
+	return nil
+}
+
+// EvmServiceDisabled returns true if the named service is listed in
+// EvmDisabledServices
+func (c *evmConfig) EvmServiceDisabled(name string) bool {
+	for _, s := range c.EvmDisabledServices() {
+		if s == name {
+			return true
+		}
+	}
+	return false
+}
+
+// persistedField describes how to read a runtime value saved to the
+// configurations table, and an optional sanity check on the parsed value
+type persistedField struct {
+	parse func(string) (interface{}, error)
+	check func(interface{}) error
+}
+
+// persistedFields lists every EVM config field that may be persisted. Values
+// failing their check are ignored in favour of the env or chain default.
+var persistedFields = map[string]persistedField{
+	"BlockHistoryEstimatorBatchSize": {parseUint32, nil},
+	"BlockHistoryEstimatorTransactionPercentile": {parseUint16, func(v interface{}) error {
+		if v.(uint16) > 100 {
+			return errors.Errorf("must be between 0 and 100, got %d", v.(uint16))
+		}
+		return nil
+	}},
+	"EvmBalanceMonitorBlockDelay": {parseUint16, nil},
+	"EvmCallTimeout":              {parseNullDuration, checkPositiveDuration},
+	"EvmConfirmerConcurrency": {parseUint32, func(v interface{}) error {
+		if v.(uint32) < 1 {
+			return errors.New("must be greater than or equal to 1")
+		}
+		return nil
+	}},
+	"EvmDisabledServices": {parseStringList, nil},
+	"EvmFinalityViolationAction": {parseString, func(v interface{}) error {
+		if !knownFinalityViolationActions[v.(string)] {
+			return errors.Errorf("must be one of %q, %q or %q, got %q", FinalityViolationActionLog, FinalityViolationActionAlert, FinalityViolationActionHalt, v.(string))
+		}
+		return nil
+	}},
+	"EvmForceTxType": {parseInt, func(v interface{}) error {
+		switch v.(int) {
+		case TxTypeAuto, TxTypeLegacy:
+			return nil
+		case TxTypeDynamicFee:
+			// No chain supports EIP-1559 yet, so every attempt would fail to build
+			return errors.Errorf("%d (dynamic fee) requires EIP-1559 dynamic fees, which are not supported on any chain", TxTypeDynamicFee)
+		}
+		return errors.Errorf("must be one of %d or %d, got %d", TxTypeAuto, TxTypeLegacy, v.(int))
+	}},
+	"EvmGasPriceDefault": {parseBigInt, func(v interface{}) error {
+		if v.(*big.Int).Sign() < 0 {
+			return errors.Errorf("must not be negative, got %s", v.(*big.Int).String())
+		}
+		return nil
+	}},
+	"EvmGasLimitTransfer": {parseUint64, func(v interface{}) error {
+		if v.(uint64) < MinGasLimitTransfer {
+			return errors.Errorf("must be greater than or equal to %d, got %d", MinGasLimitTransfer, v.(uint64))
+		}
+		return nil
+	}},
+	"EvmHeadTrackerBackfillDepth": {parseUint64, nil},
+	"EvmIncomingConfirmationsFinalityFraction": {parseF64, func(v interface{}) error {
+		if f := v.(float64); !(f >= 0 && f <= 1) {
+			return errors.Errorf("must be between 0 and 1, got %v", f)
+		}
+		return nil
+	}},
+	"EvmMaxGasPriceWei": {parseBigInt, func(v interface{}) error {
+		if v.(*big.Int).Sign() <= 0 {
+			return errors.Errorf("must be positive, got %s", v.(*big.Int).String())
+		}
+		return nil
+	}},
+	"EvmGasEstimatorRequireWarmup":        {parseBool, nil},
+	"EvmSimulateTransactionsBeforeSend":   {parseBool, nil},
+	"EvmSkipEstimationForSimpleTransfers": {parseBool, nil},
+	"EvmUseFinalityTag":                   {parseBool, nil},
+	"L1FinalityDepth":                     {parseUint64, nil},
+	"LinkDecimals":                        {parseUint8, nil},
+	"NativeTokenDecimals":                 {parseUint8, nil},
+	"NativeTokenSymbol": {parseString, func(v interface{}) error {
+		if v.(string) == "" {
+			return errors.New("must not be empty")
+		}
+		return nil
+	}},
+	"NodeRateLimitBurst": {parseInt, func(v interface{}) error {
+		if v.(int) < 0 {
+			return errors.Errorf("must not be negative, got %d", v.(int))
+		}
+		return nil
+	}},
+	"NodeRateLimitRPS": {parseF64, func(v interface{}) error {
+		if f := v.(float64); f < 0 || math.IsNaN(f) || math.IsInf(f, 0) {
+			return errors.Errorf("must be a non-negative number, got %v", f)
+		}
+		return nil
+	}},
+	"NodeWSReconnectMaxBackoff": {parseNullDuration, checkPositiveDuration},
+	"NodeWSReconnectMinBackoff": {parseNullDuration, checkPositiveDuration},
+	"OCRContractConfirmations": {parseUint16, func(v interface{}) error {
+		if v.(uint16) < 1 {
+			return errors.New("must be greater than or equal to 1")
+		}
+		return nil
+	}},
+	"OCRContractPollInterval":      {parseNullDuration, checkPositiveDuration},
+	"OCRContractSubscribeInterval": {parseNullDuration, checkPositiveDuration},
+}
+
+func checkPositiveDuration(v interface{}) error {
+	if d := v.(chains.NullDuration); d.Valid && d.Duration <= 0 {
+		return errors.Errorf("must be positive, got %s", d.Duration)
+	}
+	return nil
+}
+
+func parseNullDuration(s string) (interface{}, error) {
+	var d chains.NullDuration
+	err := d.UnmarshalText([]byte(s))
+	return d, err
+}
+
+// ValidatePersisted checks every runtime value saved to the configurations
+// table and returns the combined issues, including any values that the last
+// ReloadPersistedConfig ignored because they conflict with the rest of the
+// config. Getters already ignore invalid persisted values, so this is for
+// surfacing them to the operator.
+func (c *evmConfig) ValidatePersisted() (err error) {
+	fields := make([]string, 0, len(persistedFields))
+	for field := range persistedFields {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+	for _, field := range fields {
+		if _, perr := c.readPersisted(field, persistedFields[field].parse); perr != nil {
+			err = multierr.Append(err, perr)
+		}
+	}
+	c.persistedMu.RLock()
+	defer c.persistedMu.RUnlock()
+	return multierr.Append(err, c.persistedConflicts)
+}
+
+// ConflictReport describes a field that is set both in the environment and
+// as a persisted runtime value. The persisted value takes precedence, so the
+// env value is ignored.
+type ConflictReport struct {
+	Field          string
+	EnvVar         string
+	EnvValue       string
+	PersistedValue string
+}
+
+// ConfigOverrideConflicts lists the fields whose env value is shadowed by a
+// different persisted value, sorted by field. It helps operators migrating
+// between env and DB config who expect the value they just set to apply.
+func (c *evmConfig) ConfigOverrideConflicts() (conflicts []ConflictReport) {
+	fields := make([]string, 0, len(persistedFields))
+	for field := range persistedFields {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+	for _, field := range fields {
+		envVar := EnvVarName(field)
+		envValue, ok := c.source.Lookup(envVar)
+		if !ok {
+			continue
+		}
+		c.persistedMu.RLock()
+		persistedValue, ok := c.persistedStringLocked(field)
+		c.persistedMu.RUnlock()
+		if !ok || persistedValue == envValue {
+			continue
+		}
+		conflicts = append(conflicts, ConflictReport{
+			Field:          field,
+			EnvVar:         envVar,
+			EnvValue:       envValue,
+			PersistedValue: persistedValue,
+		})
+	}
+	return conflicts
+}
+
+// ChainCfgFromEnv snapshots the env vars of every persisted EVM config field
+// into a ChainCfg. This lets an operator moving from env var config to
+// per-chain DB config write their current settings to evm_chains.cfg. Fields
+// whose env var is unset are left out so that they inherit defaults, as are
+// fields whose env var is invalid, which are logged.
+func ChainCfgFromEnv() chains.ChainCfg {
+	return chainCfgFromSource(EnvConfigSource{})
+}
+
+func chainCfgFromSource(source ConfigSource) chains.ChainCfg {
+	cfg := chains.ChainCfg{Version: chains.ChainCfgVersion, Fields: make(map[string]json.RawMessage)}
+	for field, pf := range persistedFields {
+		envVar := EnvVarName(field)
+		s, ok := source.Lookup(envVar)
+		if !ok {
+			continue
+		}
+		val, err := pf.parse(s)
+		if err == nil && pf.check != nil {
+			err = pf.check(val)
+		}
+		if err != nil {
+			logger.Warnw(fmt.Sprintf("Invalid value for %s, leaving it out of chain config", envVar), "value", s, "error", err)
+			continue
+		}
+		raw, err := json.Marshal(s)
+		if err != nil {
+			logger.Warnw(fmt.Sprintf("Could not encode %s, leaving it out of chain config", envVar), "value", s, "error", err)
+			continue
+		}
+		cfg.Fields[field] = raw
+	}
+	return cfg
+}
+
+// persistedLookup is the signature of lookupPersisted and
+// lookupPersistedLocked
+type persistedLookup func(field string, parse func(string) (interface{}, error)) (interface{}, bool)
+
+// noPersisted is a persistedLookup that finds nothing, for reading the
+// configured value of a field regardless of any runtime override
+func noPersisted(string, func(string) (interface{}, error)) (interface{}, bool) {
+	return nil, false
+}
+
+// lookupPersisted returns the runtime value for the given field that was saved
+// to the configurations table, if any
+func (c *evmConfig) lookupPersisted(field string, parse func(string) (interface{}, error)) (interface{}, bool) {
+	c.persistedMu.RLock()
+	defer c.persistedMu.RUnlock()
+	return c.lookupPersistedLocked(field, parse)
+}
+
+// lookupPersistedDuration is lookupPersisted for duration fields
+func (c *evmConfig) lookupPersistedDuration(field string) (time.Duration, bool) {
+	val, ok := c.lookupPersisted(field, parseNullDuration)
+	if !ok {
+		return 0, false
+	}
+	d := val.(chains.NullDuration)
+	return d.Duration, d.Valid
+}
+
+// lookupPersistedLocked is lookupPersisted for callers that already hold
+// persistedMu
+func (c *evmConfig) lookupPersistedLocked(field string, parse func(string) (interface{}, error)) (interface{}, bool) {
+	val, err := c.readPersistedLocked(field, parse)
+	if err != nil {
+		c.logger().Errorw(
+			fmt.Sprintf("Invalid value persisted for %s, ignoring.", field),
+			"field", field,
+			"error", err)
+		return nil, false
+	}
+	return val, val != nil
+}
+
+// setPersisted saves a runtime value for field. Without a DB the value is only
+// kept in memory and ErrPersistenceDisabled is returned, so that callers such
+// as tests that construct a config without a DB get explicit behaviour rather
+// than a nil dereference.
+//
+// If the chain's cfg holds field, the value is written there since it would
+// otherwise shadow the new value.
+func (c *evmConfig) setPersisted(ctx context.Context, field string, value encoding.TextMarshaler) error {
+	if c.envOnly {
+		return nil
+	}
+	concreteGCfg, ok := c.GeneralConfig.(*generalConfig)
+	if !ok {
+		return errors.Errorf("cannot get runtime store; %T is not *generalConfig", c.GeneralConfig)
+	}
+	text, err := value.MarshalText()
+	if err != nil {
+		return err
+	}
+	if concreteGCfg.ORM != nil {
+		if err = concreteGCfg.ORM.SetEvmConfigValue(ctx, c.ChainID(), field, value); err != nil {
+			return err
+		}
+	}
+	c.persistedMu.Lock()
+	defer c.persistedMu.Unlock()
+	if err = c.setPersistedLocked(field, string(text)); err != nil {
+		return err
+	}
+	if concreteGCfg.ORM != nil {
+		return nil
+	}
+	return errors.Wrapf(ErrPersistenceDisabled, "%s was only set in memory", field)
+}
+
+// setPersistedLocked applies a runtime value for field in memory, where the
+// chain's cfg holds it if set. persistedMu must be held.
+func (c *evmConfig) setPersistedLocked(field string, text string) (err error) {
+	c.persisted, c.chainCfg, err = withPersistedField(c.persisted, c.chainCfg, field, text)
+	return err
+}
+
+// withPersistedField sets field to text in chainCfg if it holds the field, and
+// in persisted otherwise, allocating persisted if needed
+func withPersistedField(persisted map[string]string, chainCfg map[string]json.RawMessage, field string, text string) (map[string]string, map[string]json.RawMessage, error) {
+	if raw, ok := chainCfg[field]; ok && string(raw) != "null" {
+		raw, err := json.Marshal(text)
+		if err != nil {
+			return persisted, chainCfg, err
+		}
+		chainCfg[field] = raw
+	}
+	if persisted == nil {
+		persisted = make(map[string]string)
+	}
+	persisted[field] = text
+	return persisted, chainCfg, nil
+}
+
+// ReloadPersistedConfig re-reads this chain's cfg from evm_chains and the
+// runtime values in the configurations table, and swaps them in. Getters read
+// persisted values from memory, so this takes effect immediately without
+// restarting any services, and no other chain's config is touched. Values
+// written to the DB other than through this config's setters are not seen
+// until it is called. If the chain has no evm_chains row, the configurations
+// table is still reloaded and ErrChainNotFound is returned.
+//
+// Values that fail validation together with the rest of the config are
+// ignored until corrected; they are logged and returned by ValidatePersisted.
+func (c *evmConfig) ReloadPersistedConfig() error {
+	if c.envOnly {
+		return nil
+	}
+	concreteGCfg, ok := c.GeneralConfig.(*generalConfig)
+	if !ok {
+		return errors.Errorf("cannot get runtime store; %T is not *generalConfig", c.GeneralConfig)
+	}
+	if concreteGCfg.ORM == nil {
+		return ErrPersistenceDisabled
+	}
+	values, err := concreteGCfg.ORM.GetConfigStrValues()
+	if err != nil {
+		return err
+	}
+	persisted := make(map[string]string)
+	for field := range persistedFields {
+		if s, ok := values[EnvVarName(field)]; ok {
+			persisted[field] = s
+		}
+	}
+	cfg, enabled, err := concreteGCfg.ORM.GetChainCfg(c.ChainID())
+	chainCfg := cfg.Fields
+	c.persistedMu.RLock()
+	chainDisabled := c.chainDisabled
+	c.persistedMu.RUnlock()
+	if err == nil {
+		chainDisabled = !enabled
+	} else if errors.Is(err, ErrChainNotFound) {
+		chainDisabled = false
+	}
+	if err != nil {
+		// Keep the cfg last loaded, copied since setPersisted writes to it
+		c.persistedMu.RLock()
+		_, chainCfg = copyPersisted(nil, c.chainCfg)
+		c.persistedMu.RUnlock()
+	}
+	persisted, chainCfg, conflicts := c.dropConflictingPersisted(persisted, chainCfg)
+	c.persistedMu.Lock()
+	defer c.persistedMu.Unlock()
+	c.persisted = persisted
+	c.chainCfg = chainCfg
+	c.chainDisabled = chainDisabled
+	c.persistedConflicts = conflicts
+	return err
+}
+
+// dropConflictingPersisted validates the config that persisted and chainCfg
+// would give, and drops the persisted fields involved in any failure that env
+// and chain defaults alone do not have, such as a persisted max gas price
+// below the default. Each field, in order, is dropped if that clears a
+// failure; if failures remain, every persisted value is dropped. The dropped
+// fields are logged and returned with the failures.
+func (c *evmConfig) dropConflictingPersisted(persisted map[string]string, chainCfg map[string]json.RawMessage) (map[string]string, map[string]json.RawMessage, error) {
+	baseline := make(map[string]bool)
+	for _, err := range multierr.Errors(c.candidate(nil, nil).validate()) {
+		baseline[err.Error()] = true
+	}
+	failures := func(persisted map[string]string, chainCfg map[string]json.RawMessage) (errs []error) {
+		for _, err := range multierr.Errors(c.candidate(persisted, chainCfg).validate()) {
+			if !baseline[err.Error()] {
+				errs = append(errs, err)
+			}
+		}
+		return errs
+	}
+	errs := failures(persisted, chainCfg)
+	if len(errs) == 0 {
+		return persisted, chainCfg, nil
+	}
+
+	var fields []string
+	for field := range persistedFields {
+		_, inPersisted := persisted[field]
+		_, inChainCfg := chainCfg[field]
+		if inPersisted || inChainCfg {
+			fields = append(fields, field)
+		}
+	}
+	sort.Strings(fields)
+	var dropped []string
+	remaining := errs
+	for _, field := range fields {
+		if len(remaining) == 0 {
+			break
+		}
+		p, cc := withoutPersistedField(persisted, chainCfg, field)
+		if left := failures(p, cc); len(left) < len(remaining) {
+			persisted, chainCfg, remaining = p, cc, left
+			dropped = append(dropped, field)
+		}
+	}
+	if len(remaining) > 0 {
+		persisted, chainCfg, dropped = map[string]string{}, map[string]json.RawMessage{}, fields
+	}
+
+	envVars := make([]string, len(dropped))
+	for i, field := range dropped {
+		envVars[i] = EnvVarName(field)
+	}
+	err := errors.Wrapf(multierr.Combine(errs...), "persisted values for %s conflict with the rest of the config and are ignored until corrected", strings.Join(envVars, ", "))
+	c.logger().Errorw("Ignoring conflicting persisted config values", "fields", envVars, "error", err)
+	return persisted, chainCfg, err
+}
+
+// candidate returns a copy of this config with persisted and chainCfg in place
+// of the loaded values, for validating them before they are swapped in
+func (c *evmConfig) candidate(persisted map[string]string, chainCfg map[string]json.RawMessage) *evmConfig {
+	c.gasPriceDefaultMu.Lock()
+	pending := c.gasPriceDefaultPending
+	c.gasPriceDefaultMu.Unlock()
+	return &evmConfig{
+		GeneralConfig:          c.GeneralConfig,
+		chainSpecificConfig:    c.chainSpecificConfig,
+		source:                 c.source,
+		chain:                  c.chain,
+		defaultGasBumpWei:      c.defaultGasBumpWei,
+		defaultGasPriceDefault: c.defaultGasPriceDefault,
+		defaultMaxGasPriceWei:  c.defaultMaxGasPriceWei,
+		defaultMinGasPriceWei:  c.defaultMinGasPriceWei,
+		persisted:              persisted,
+		chainCfg:               chainCfg,
+		gasPriceDefaultPending: pending,
+		quiet:                  true,
+	}
+}
+
+// copyPersisted returns copies of persisted and chainCfg
+func copyPersisted(persisted map[string]string, chainCfg map[string]json.RawMessage) (map[string]string, map[string]json.RawMessage) {
+	p := make(map[string]string, len(persisted))
+	for k, v := range persisted {
+		p[k] = v
+	}
+	cc := make(map[string]json.RawMessage, len(chainCfg))
+	for k, v := range chainCfg {
+		cc[k] = v
+	}
+	return p, cc
+}
+
+// withoutPersistedField returns copies of persisted and chainCfg without field
+func withoutPersistedField(persisted map[string]string, chainCfg map[string]json.RawMessage, field string) (map[string]string, map[string]json.RawMessage) {
+	p, cc := copyPersisted(persisted, chainCfg)
+	delete(p, field)
+	delete(cc, field)
+	return p, cc
+}
+
+// readPersisted returns nil if nothing is persisted for field, or an error if
+// the persisted value is invalid
+func (c *evmConfig) readPersisted(field string, parse func(string) (interface{}, error)) (interface{}, error) {
+	c.persistedMu.RLock()
+	defer c.persistedMu.RUnlock()
+	return c.readPersistedLocked(field, parse)
+}
+
+func (c *evmConfig) readPersistedLocked(field string, parse func(string) (interface{}, error)) (interface{}, error) {
+	s, ok := c.persistedStringLocked(field)
+	if !ok {
+		return nil, nil
+	}
+	val, err := parse(s)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid persisted value %q for %s", s, EnvVarName(field))
+	}
+	if f, ok := persistedFields[field]; ok && f.check != nil {
+		if err := f.check(val); err != nil {
+			return nil, errors.Wrapf(err, "invalid persisted value %q for %s", s, EnvVarName(field))
+		}
+	}
+	return val, nil
+}
+
+// persistedStringLocked returns the raw runtime value saved for field. The
+// chain's cfg takes precedence over the configurations table. persistedMu must
+// be held.
+func (c *evmConfig) persistedStringLocked(field string) (string, bool) {
+	if c.envOnly {
+		return "", false
+	}
+	raw, ok := c.chainCfg[field]
+	if ok && string(raw) != "null" {
+		// Values are usually JSON strings, but plain numbers and bools are
+		// accepted as written
+		var s string
+		if err := json.Unmarshal(raw, &s); err != nil {
+			return string(raw), true
+		}
+		return s, true
+	}
+
+	s, ok := c.persisted[field]
+	return s, ok
+}
+
+func (c *evmConfig) lookupEnv(k string, parse func(string) (interface{}, error)) (interface{}, bool) {
+	s, ok := c.source.Lookup(k)
 	if ok {
 		val, err := parse(s)
 		if err != nil {
-			logger.Errorw(
+			c.logger().Errorw(
 				fmt.Sprintf("Invalid value provided for %s, falling back to default.", s),
 				"value", s,
 				"key", k,
@@ -0,0 +1,226 @@
+package config
+
+import (
+	"context"
+	"math/big"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pelletier/go-toml"
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+)
+
+// ExportTOML renders the effective value of every field that may be
+// persisted for this chain as TOML, keyed by field name, so that operators
+// can review it and re-import it with ApplyTOML. Big integers and durations
+// are rendered as strings.
+func (c *evmConfig) ExportTOML() ([]byte, error) {
+	values := make(map[string]interface{}, len(persistedFields))
+	for field := range persistedFields {
+		val, err := c.effectiveValue(field)
+		if err != nil {
+			return nil, err
+		}
+		values[field] = tomlValue(val)
+	}
+	tree, err := toml.TreeFromMap(values)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to build TOML")
+	}
+	s, err := tree.ToTomlString()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to render TOML")
+	}
+	return []byte(s), nil
+}
+
+// ApplyTOML validates b, as produced by ExportTOML, and persists every field
+// in it in a single transaction. Nothing is persisted unless all fields are
+// valid and the config as a whole would still validate with them. Fields that
+// are left out keep their current value. As with the other runtime setters,
+// ErrPersistenceDisabled is returned if there is no DB, after the values
+// have been applied in memory.
+func (c *evmConfig) ApplyTOML(b []byte) error {
+	tree, err := toml.LoadBytes(b)
+	if err != nil {
+		return errors.Wrap(err, "invalid TOML")
+	}
+
+	fields := tree.Keys()
+	sort.Strings(fields)
+	texts := make(map[string]string, len(fields))
+	var merr error
+	for _, field := range fields {
+		f, ok := persistedFields[field]
+		if !ok {
+			merr = multierr.Append(merr, errors.Errorf("%s cannot be persisted", field))
+			continue
+		}
+		text, err := tomlText(tree.Get(field))
+		if err != nil {
+			merr = multierr.Append(merr, errors.Wrapf(err, "invalid value for %s", field))
+			continue
+		}
+		val, err := f.parse(text)
+		if err == nil && f.check != nil {
+			err = f.check(val)
+		}
+		if err != nil {
+			merr = multierr.Append(merr, errors.Wrapf(err, "invalid value %q for %s", text, field))
+			continue
+		}
+		texts[field] = text
+	}
+	if merr != nil {
+		return merr
+	}
+
+	if c.envOnly {
+		return nil
+	}
+
+	// Validate the config as it would be with the new values, so that rules
+	// across fields, such as the default gas price being within the min and
+	// max, hold. Only failures the current config does not already have are
+	// reported.
+	c.persistedMu.RLock()
+	persisted, chainCfg := copyPersisted(c.persisted, c.chainCfg)
+	c.persistedMu.RUnlock()
+	current := make(map[string]bool)
+	for _, err := range multierr.Errors(c.candidate(persisted, chainCfg).validate()) {
+		current[err.Error()] = true
+	}
+	for _, field := range fields {
+		if persisted, chainCfg, err = withPersistedField(persisted, chainCfg, field, texts[field]); err != nil {
+			return errors.Wrapf(err, "invalid value for %s", field)
+		}
+	}
+	candidate := c.candidate(persisted, chainCfg)
+	_, setsGasPriceDefault := texts["EvmGasPriceDefault"]
+	if setsGasPriceDefault {
+		candidate.gasPriceDefaultPending = nil
+	}
+	for _, err := range multierr.Errors(candidate.validate()) {
+		if !current[err.Error()] {
+			merr = multierr.Append(merr, err)
+		}
+	}
+	if merr != nil {
+		return errors.Wrap(merr, "TOML would make the config invalid")
+	}
+
+	concreteGCfg, ok := c.GeneralConfig.(*generalConfig)
+	if !ok {
+		return errors.Errorf("cannot get runtime store; %T is not *generalConfig", c.GeneralConfig)
+	}
+	if concreteGCfg.ORM != nil {
+		if err = concreteGCfg.ORM.SetEvmConfigValues(context.Background(), c.ChainID(), texts); err != nil {
+			return errors.Wrap(err, "failed to persist TOML")
+		}
+	}
+	c.persistedMu.Lock()
+	for _, field := range fields {
+		if err = c.setPersistedLocked(field, texts[field]); err != nil {
+			break
+		}
+	}
+	c.persistedMu.Unlock()
+	if err != nil {
+		return err
+	}
+	if setsGasPriceDefault {
+		// The applied value replaces any deferred write
+		c.gasPriceDefaultMu.Lock()
+		c.gasPriceDefaultPending = nil
+		c.gasPriceDefaultMu.Unlock()
+	}
+	if concreteGCfg.ORM == nil {
+		return ErrPersistenceDisabled
+	}
+	return nil
+}
+
+// effectiveValue returns the value the getter for field currently resolves
+// to. Most fields have a getter of the same name.
+func (c *evmConfig) effectiveValue(field string) (interface{}, error) {
+	switch field {
+	case "NodeRateLimitBurst":
+		_, burst := c.NodeRateLimit()
+		return burst, nil
+	case "NodeRateLimitRPS":
+		rps, _ := c.NodeRateLimit()
+		return rps, nil
+	case "OCRContractConfirmations":
+		return c.OCRContractConfirmations(0), nil
+	case "OCRContractPollInterval":
+		return c.OCRContractPollInterval(0), nil
+	case "OCRContractSubscribeInterval":
+		return c.OCRContractSubscribeInterval(0), nil
+	}
+	m := reflect.ValueOf(c).MethodByName(field)
+	if !m.IsValid() || m.Type().NumIn() != 0 || m.Type().NumOut() != 1 {
+		return nil, errors.Errorf("no getter for %s", field)
+	}
+	return m.Call(nil)[0].Interface(), nil
+}
+
+// tomlValue converts a getter's value to one that round-trips through TOML
+// and the field's parser
+func tomlValue(val interface{}) interface{} {
+	switch v := val.(type) {
+	case *big.Int:
+		return v.String()
+	case time.Duration:
+		return v.String()
+	case []string:
+		if v == nil {
+			return []string{}
+		}
+		return v
+	}
+	rv := reflect.ValueOf(val)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return rv.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return int64(rv.Uint())
+	}
+	return val
+}
+
+// tomlText converts a value loaded from TOML to the text its field's parser
+// expects
+func tomlText(val interface{}) (string, error) {
+	switch v := val.(type) {
+	case string:
+		return v, nil
+	case bool:
+		return strconv.FormatBool(v), nil
+	case int64:
+		return strconv.FormatInt(v, 10), nil
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), nil
+	case []interface{}:
+		items := make([]string, len(v))
+		for i, item := range v {
+			s, ok := item.(string)
+			if !ok {
+				return "", errors.Errorf("expected a list of strings, got %v", v)
+			}
+			items[i] = s
+		}
+		return strings.Join(items, ","), nil
+	}
+	return "", errors.Errorf("unsupported TOML value %v of type %T", val, val)
+}
+
+// persistedText is a raw value as accepted by a persisted field's parser
+type persistedText string
+
+func (t persistedText) MarshalText() ([]byte, error) {
+	return []byte(t), nil
+}
@@ -13,6 +13,7 @@ import (
 	"reflect"
 	"regexp"
 	"strconv"
+	"strings"
 	"sync"
 	"time"
 
@@ -60,6 +61,7 @@ type GeneralConfig interface {
 	Chain() *chains.Chain
 	ChainID() *big.Int
 	ClientNodeURL() string
+	ConfigRevalidationInterval() time.Duration
 	CreateProductionLogger() *logger.Logger
 	DatabaseBackupDir() string
 	DatabaseBackupFrequency() time.Duration
@@ -94,6 +96,7 @@ type GeneralConfig interface {
 	GetAdvisoryLockIDConfiguredOrDefault() int64
 	GetDatabaseDialectConfiguredOrDefault() dialects.DialectName
 	GlobalLockRetryInterval() models.Duration
+	GlobalMaxInFlightTransactions() uint32
 	InsecureFastScrypt() bool
 	InsecureSkipVerify() bool
 	JSONConsole() bool
@@ -280,10 +283,14 @@ func (c *generalConfig) Validate() error {
 	return nil
 }
 
-// SetDB provides a database connection to use for runtime configuration values
+// SetDB provides a database connection to use for runtime configuration values.
+// A nil db removes it, which disables persistence of runtime config values.
 func (c *generalConfig) SetDB(db *gorm.DB) {
-	orm := NewORM(db)
-	c.ORM = orm
+	if db == nil {
+		c.ORM = nil
+		return
+	}
+	c.ORM = NewORM(db)
 }
 
 func (c *generalConfig) SetDialect(d dialects.DialectName) {
@@ -369,6 +376,13 @@ func (c *generalConfig) FeatureUIFeedsManager() bool {
 	return c.getWithFallback("FeatureUIFeedsManager", parseBool).(bool)
 }
 
+// ConfigRevalidationInterval is how often each chain's config is validated
+// again after boot, so that runtime changes which leave it invalid are caught
+// by the health checks. 0 disables revalidation.
+func (c *generalConfig) ConfigRevalidationInterval() time.Duration {
+	return c.getWithFallback("ConfigRevalidationInterval", parseDuration).(time.Duration)
+}
+
 func (c *generalConfig) DatabaseListenerMinReconnectInterval() time.Duration {
 	return c.getWithFallback("DatabaseListenerMinReconnectInterval", parseDuration).(time.Duration)
 }
@@ -421,6 +435,13 @@ func (c *generalConfig) GlobalLockRetryInterval() models.Duration {
 	return models.MustMakeDuration(c.getWithFallback("GlobalLockRetryInterval", parseDuration).(time.Duration))
 }
 
+// GlobalMaxInFlightTransactions caps the number of unconfirmed transactions
+// across all keys and chains, on top of the per-key
+// ETH_MAX_IN_FLIGHT_TRANSACTIONS. Set to 0 for no limit.
+func (c *generalConfig) GlobalMaxInFlightTransactions() uint32 {
+	return c.getWithFallback("GlobalMaxInFlightTransactions", parseUint32).(uint32)
+}
+
 // DatabaseURL configures the URL for chainlink to connect to. This must be
 // a properly formatted URL, with a valid scheme (postgres://)
 func (c *generalConfig) DatabaseURL() url.URL {
@@ -1189,6 +1210,18 @@ func parseString(str string) (interface{}, error) {
 	return str, nil
 }
 
+// parseStringList parses a comma-separated list, trimming whitespace and
+// dropping empty entries
+func parseStringList(str string) (interface{}, error) {
+	var list []string
+	for _, s := range strings.Split(str, ",") {
+		if s = strings.TrimSpace(s); s != "" {
+			list = append(list, s)
+		}
+	}
+	return list, nil
+}
+
 func parseAddress(str string) (interface{}, error) {
 	if str == "" {
 		return nil, nil
@@ -1216,6 +1249,11 @@ func parseLogLevel(str string) (interface{}, error) {
 	return lvl, err
 }
 
+func parseUint8(s string) (interface{}, error) {
+	v, err := strconv.ParseUint(s, 10, 8)
+	return uint8(v), err
+}
+
 func parseUint16(s string) (interface{}, error) {
 	v, err := strconv.ParseUint(s, 10, 16)
 	return uint16(v), err
@@ -1233,7 +1271,15 @@ func parseUint64(s string) (interface{}, error) {
 
 func parseF32(s string) (interface{}, error) {
 	v, err := strconv.ParseFloat(s, 32)
-	return v, err
+	return float32(v), err
+}
+
+func parseF64(s string) (interface{}, error) {
+	return strconv.ParseFloat(s, 64)
+}
+
+func parseInt(s string) (interface{}, error) {
+	return strconv.Atoi(s)
 }
 
 func parseURL(s string) (interface{}, error) {
@@ -0,0 +1,9 @@
+package config
+
+// NewGeneralConfigWithChainID returns a GeneralConfig for the given chain ID
+// without touching the process environment
+func NewGeneralConfigWithChainID(id string) GeneralConfig {
+	gcfg := NewGeneralConfig()
+	gcfg.(*generalConfig).viper.Set("ETH_CHAIN_ID", id)
+	return gcfg
+}
@@ -2,11 +2,20 @@ package config
 
 import (
 	"context"
+	"database/sql"
 	"encoding"
+	"math/big"
+	"sort"
 	"strconv"
+	"time"
 
+	"github.com/pkg/errors"
+	"github.com/smartcontractkit/chainlink/core/chains"
 	"github.com/smartcontractkit/chainlink/core/store/models"
+	"github.com/smartcontractkit/chainlink/core/utils"
+	"gopkg.in/guregu/null.v4"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type ORM struct {
@@ -27,6 +36,30 @@ func (orm *ORM) GetConfigValue(field string, value encoding.TextUnmarshaler) err
 	return value.UnmarshalText([]byte(config.Value))
 }
 
+// GetConfigStrValue returns the raw string value for a named configuration entry
+func (orm *ORM) GetConfigStrValue(field string) (string, error) {
+	name := EnvVarName(field)
+	config := models.Configuration{}
+	if err := orm.db.First(&config, "name = ?", name).Error; err != nil {
+		return "", err
+	}
+	return config.Value, nil
+}
+
+// GetConfigStrValues returns the raw string values of every configuration
+// entry, keyed by name
+func (orm *ORM) GetConfigStrValues() (map[string]string, error) {
+	var configs []models.Configuration
+	if err := orm.db.Find(&configs).Error; err != nil {
+		return nil, errors.Wrap(err, "failed to load configurations")
+	}
+	values := make(map[string]string, len(configs))
+	for _, config := range configs {
+		values[config.Name] = config.Value
+	}
+	return values, nil
+}
+
 // GetConfigBoolValue returns a boolean value for a named configuration entry
 func (orm *ORM) GetConfigBoolValue(field string) (*bool, error) {
 	name := EnvVarName(field)
@@ -42,13 +75,13 @@ func (orm *ORM) GetConfigBoolValue(field string) (*bool, error) {
 }
 
 // SetConfigValue returns the value for a named configuration entry
-func (orm *ORM) SetConfigValue(field string, value encoding.TextMarshaler) error {
+func (orm *ORM) SetConfigValue(ctx context.Context, field string, value encoding.TextMarshaler) error {
 	name := EnvVarName(field)
 	textValue, err := value.MarshalText()
 	if err != nil {
 		return err
 	}
-	return orm.db.Where(models.Configuration{Name: name}).
+	return orm.db.WithContext(ctx).Where(models.Configuration{Name: name}).
 		Assign(models.Configuration{Name: name, Value: string(textValue)}).
 		FirstOrCreate(&models.Configuration{}).Error
 }
@@ -60,3 +93,126 @@ func (orm *ORM) SetConfigStrValue(ctx context.Context, field string, value strin
 		Assign(models.Configuration{Name: name, Value: value}).
 		FirstOrCreate(&models.Configuration{}).Error
 }
+
+// ErrChainNotFound is returned when a chain has no row in evm_chains
+var ErrChainNotFound = errors.New("chain not found")
+
+// GetChainCfg returns the cfg stored in evm_chains for the given chain, and
+// whether the chain is enabled. A cfg stored by an older version is upgraded
+// and written back, so that queries on the raw JSON, such as
+// SetEvmConfigValue's, see the current field names.
+func (orm *ORM) GetChainCfg(chainID *big.Int) (cfg chains.ChainCfg, enabled bool, err error) {
+	err = orm.db.Transaction(func(tx *gorm.DB) error {
+		err := tx.Raw(`SELECT cfg, enabled FROM evm_chains WHERE id = ? FOR UPDATE`, utils.NewBig(chainID)).Row().Scan(&cfg, &enabled)
+		if errors.Is(err, sql.ErrNoRows) {
+			return errors.Wrapf(ErrChainNotFound, "no evm_chains row for chain %s", chainID)
+		} else if err != nil {
+			return errors.Wrapf(err, "failed to load cfg for chain %s", chainID)
+		}
+		if !cfg.Upgraded {
+			return nil
+		}
+		upgraded, err := cfg.Value()
+		if err != nil {
+			return err
+		}
+		err = tx.Exec(`UPDATE evm_chains SET cfg = ?::jsonb, updated_at = NOW() WHERE id = ?`, string(upgraded.([]byte)), utils.NewBig(chainID)).Error
+		return errors.Wrapf(err, "failed to save upgraded cfg for chain %s", chainID)
+	})
+	return cfg, enabled, err
+}
+
+// AuditEntry records a change to a persisted EVM config value
+type AuditEntry struct {
+	ID         int64
+	EVMChainID utils.Big `gorm:"column:evm_chain_id"`
+	Key        string
+	OldValue   null.String
+	NewValue   string
+	ChangedAt  time.Time
+}
+
+func (AuditEntry) TableName() string {
+	return "evm_chain_config_audit"
+}
+
+// SetEvmConfigValue saves a runtime value for an EVM config field and records
+// the previous and new values in evm_chain_config_audit in the same
+// transaction. Values in the chain's evm_chains.cfg take precedence over the
+// configurations table, so if the chain's cfg already holds the field it is
+// updated there; otherwise the value goes to the configurations table.
+func (orm *ORM) SetEvmConfigValue(ctx context.Context, chainID *big.Int, field string, value encoding.TextMarshaler) error {
+	textValue, err := value.MarshalText()
+	if err != nil {
+		return err
+	}
+	return orm.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return setEvmConfigValue(tx, chainID, field, string(textValue))
+	})
+}
+
+// SetEvmConfigValues is SetEvmConfigValue for several fields at once, keyed by
+// field. Either every value is saved or none is.
+func (orm *ORM) SetEvmConfigValues(ctx context.Context, chainID *big.Int, values map[string]string) error {
+	fields := make([]string, 0, len(values))
+	for field := range values {
+		fields = append(fields, field)
+	}
+	// Lock rows in a consistent order
+	sort.Strings(fields)
+	return orm.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for _, field := range fields {
+			if err := setEvmConfigValue(tx, chainID, field, values[field]); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func setEvmConfigValue(tx *gorm.DB, chainID *big.Int, field string, textValue string) error {
+	name := EnvVarName(field)
+	var oldValue null.String
+	err := tx.Raw(`SELECT cfg->>? FROM evm_chains WHERE id = ? FOR UPDATE`, field, utils.NewBig(chainID)).Row().Scan(&oldValue)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return errors.Wrapf(err, "failed to load chain cfg value of %s", field)
+	}
+	if oldValue.Valid {
+		err = tx.Exec(`UPDATE evm_chains SET cfg = jsonb_set(cfg, ?::text[], to_jsonb(?::text)), updated_at = NOW() WHERE id = ?`,
+			"{"+field+"}", textValue, utils.NewBig(chainID)).Error
+		if err != nil {
+			return errors.Wrapf(err, "failed to update chain cfg value of %s", field)
+		}
+	} else {
+		existing := models.Configuration{}
+		err = tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&existing, "name = ?", name).Error
+		if err == nil {
+			oldValue = null.StringFrom(existing.Value)
+		} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return errors.Wrapf(err, "failed to load current value of %s", name)
+		}
+		err = tx.Where(models.Configuration{Name: name}).
+			Assign(models.Configuration{Name: name, Value: textValue}).
+			FirstOrCreate(&models.Configuration{}).Error
+		if err != nil {
+			return err
+		}
+	}
+	return errors.Wrap(tx.Create(&AuditEntry{
+		EVMChainID: *utils.NewBig(chainID),
+		Key:        name,
+		OldValue:   oldValue,
+		NewValue:   textValue,
+		ChangedAt:  time.Now(),
+	}).Error, "failed to record config audit entry")
+}
+
+// ConfigHistory returns the recorded changes to an EVM config field for the
+// given chain, oldest first
+func (orm *ORM) ConfigHistory(chainID *big.Int, field string) (entries []AuditEntry, err error) {
+	err = orm.db.
+		Where("evm_chain_id = ? AND key = ?", utils.NewBig(chainID), EnvVarName(field)).
+		Order("changed_at ASC, id ASC").
+		Find(&entries).Error
+	return entries, err
+}
@@ -2,6 +2,7 @@ package config_test
 
 import (
 	"context"
+	"math/big"
 	"strconv"
 	"testing"
 
@@ -11,6 +12,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/store/models"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
 )
 
 func TestORM_SetConfigStrValue(t *testing.T) {
@@ -52,3 +54,63 @@ func TestORM_GetConfigBoolValue(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, isSqlStatementEnabled, cfg.LogSQLStatements())
 }
+
+func TestORM_SetEvmConfigValue_RecordsHistory(t *testing.T) {
+	t.Parallel()
+	db := pgtest.NewGormDB(t)
+	orm := config.NewORM(db)
+	chainID := big.NewInt(1)
+	otherChainID := big.NewInt(42)
+
+	require.NoError(t, orm.SetEvmConfigValue(context.TODO(), chainID, "EvmGasPriceDefault", big.NewInt(1000)))
+	require.NoError(t, orm.SetEvmConfigValue(context.TODO(), chainID, "EvmGasPriceDefault", big.NewInt(2000)))
+	require.NoError(t, orm.SetEvmConfigValue(context.TODO(), otherChainID, "EvmMaxGasPriceWei", big.NewInt(3000)))
+
+	value, err := orm.GetConfigStrValue("EvmGasPriceDefault")
+	require.NoError(t, err)
+	assert.Equal(t, "2000", value)
+
+	history, err := orm.ConfigHistory(chainID, "EvmGasPriceDefault")
+	require.NoError(t, err)
+	require.Len(t, history, 2)
+	assert.Equal(t, "ETH_GAS_PRICE_DEFAULT", history[0].Key)
+	assert.False(t, history[0].OldValue.Valid)
+	assert.Equal(t, "1000", history[0].NewValue)
+	assert.Equal(t, null.StringFrom("1000"), history[1].OldValue)
+	assert.Equal(t, "2000", history[1].NewValue)
+	assert.Equal(t, chainID, history[1].EVMChainID.ToInt())
+
+	history, err = orm.ConfigHistory(chainID, "EvmMaxGasPriceWei")
+	require.NoError(t, err)
+	assert.Empty(t, history)
+}
+
+func TestORM_SetEvmConfigValues(t *testing.T) {
+	t.Parallel()
+	db := pgtest.NewGormDB(t)
+	orm := config.NewORM(db)
+	chainID := big.NewInt(1)
+
+	require.NoError(t, orm.SetEvmConfigValue(context.TODO(), chainID, "EvmGasPriceDefault", big.NewInt(1000)))
+	require.NoError(t, orm.SetEvmConfigValues(context.TODO(), chainID, map[string]string{
+		"EvmGasPriceDefault": "2000",
+		"EvmMaxGasPriceWei":  "3000",
+	}))
+
+	value, err := orm.GetConfigStrValue("EvmGasPriceDefault")
+	require.NoError(t, err)
+	assert.Equal(t, "2000", value)
+	value, err = orm.GetConfigStrValue("EvmMaxGasPriceWei")
+	require.NoError(t, err)
+	assert.Equal(t, "3000", value)
+
+	history, err := orm.ConfigHistory(chainID, "EvmGasPriceDefault")
+	require.NoError(t, err)
+	require.Len(t, history, 2)
+	assert.Equal(t, null.StringFrom("1000"), history[1].OldValue)
+	assert.Equal(t, "2000", history[1].NewValue)
+	history, err = orm.ConfigHistory(chainID, "EvmMaxGasPriceWei")
+	require.NoError(t, err)
+	require.Len(t, history, 1)
+	assert.False(t, history[0].OldValue.Valid)
+}
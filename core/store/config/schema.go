@@ -6,6 +6,7 @@ import (
 	"net"
 	"net/url"
 	"reflect"
+	"sort"
 	"time"
 
 	"github.com/smartcontractkit/chainlink/core/assets"
@@ -30,6 +31,7 @@ type ConfigSchema struct {
 	BridgeResponseURL                          url.URL         `env:"BRIDGE_RESPONSE_URL"`
 	ChainID                                    big.Int         `env:"ETH_CHAIN_ID" default:"1"`
 	ClientNodeURL                              string          `env:"CLIENT_NODE_URL" default:"http://localhost:6688"`
+	ConfigRevalidationInterval                 time.Duration   `env:"CONFIG_REVALIDATION_INTERVAL" default:"0"`
 	DatabaseBackupDir                          string          `env:"DATABASE_BACKUP_DIR" default:""`
 	DatabaseBackupFrequency                    time.Duration   `env:"DATABASE_BACKUP_FREQUENCY" default:"1h"`
 	DatabaseBackupMode                         string          `env:"DATABASE_BACKUP_MODE" default:"none"`
@@ -44,14 +46,59 @@ type ConfigSchema struct {
 	DefaultHTTPTimeout                         models.Duration `env:"DEFAULT_HTTP_TIMEOUT" default:"15s"`
 	DefaultMaxHTTPAttempts                     uint            `env:"MAX_HTTP_ATTEMPTS" default:"5"`
 	Dev                                        bool            `env:"CHAINLINK_DEV" default:"false"`
+	EthTxReaperInterval                        time.Duration   `env:"ETH_TX_REAPER_INTERVAL"`
+	EthTxReaperThreshold                       time.Duration   `env:"ETH_TX_REAPER_THRESHOLD"`
+	EthTxResendAfterThreshold                  time.Duration   `env:"ETH_TX_RESEND_AFTER_THRESHOLD"`
 	EthereumDisabled                           bool            `env:"ETH_DISABLED" default:"false"`
 	EthereumHTTPURL                            string          `env:"ETH_HTTP_URL"`
 	EthereumSecondaryURL                       string          `env:"ETH_SECONDARY_URL" default:""`
 	EthereumSecondaryURLs                      string          `env:"ETH_SECONDARY_URLS" default:""`
 	EthereumURL                                string          `env:"ETH_URL" default:"ws://localhost:8546"`
+	EvmIncomingConfirmationsFinalityFraction   float64         `env:"ETH_INCOMING_CONFIRMATIONS_FINALITY_FRACTION"`
 	// TODO: EvmGasPriceDefault left only for compatibility with old way of saving config, will be removed in:
 	// https://app.clubhouse.io/chainlinklabs/story/12739/generalise-necessary-models-tables-on-the-send-side-to-support-the-concept-of-multiple-chains
+	EvmBalanceMonitorBlockDelay           uint16                        `env:"ETH_BALANCE_MONITOR_BLOCK_DELAY"`
+	EvmCallTimeout                        time.Duration                 `env:"ETH_CALL_TIMEOUT"`
+	EvmConfirmerConcurrency               uint32                        `env:"ETH_CONFIRMER_CONCURRENCY"`
+	EvmDisabledServices                   string                        `env:"ETH_DISABLED_SERVICES"`
+	EvmFinalityDepth                      uint                          `env:"ETH_FINALITY_DEPTH"`
+	EvmFinalityViolationAction            string                        `env:"ETH_FINALITY_VIOLATION_ACTION"`
+	EvmForceTxType                        int                           `env:"ETH_FORCE_TX_TYPE"`
+	EvmGasBumpOverflowProtection          bool                          `env:"ETH_GAS_BUMP_OVERFLOW_PROTECTION"`
+	EvmGasBumpPercent                     uint16                        `env:"ETH_GAS_BUMP_PERCENT"`
+	EvmGasBumpStrategy                    string                        `env:"ETH_GAS_BUMP_STRATEGY"`
+	EvmGasBumpThreshold                   uint64                        `env:"ETH_GAS_BUMP_THRESHOLD"`
+	EvmGasBumpTxDepth                     uint16                        `env:"ETH_GAS_BUMP_TX_DEPTH"`
+	EvmGasBumpWei                         *big.Int                      `env:"ETH_GAS_BUMP_WEI"`
+	EvmGasLimitDefault                    uint64                        `env:"ETH_GAS_LIMIT_DEFAULT"`
+	EvmGasLimitMultiplier                 float32                       `env:"ETH_GAS_LIMIT_MULTIPLIER"`
+	EvmGasLimitTransfer                   uint64                        `env:"ETH_GAS_LIMIT_TRANSFER"`
 	EvmGasPriceDefault                    string                        `env:"ETH_GAS_PRICE_DEFAULT"`
+	EvmGasPriceDefaultAutoWidenMax        bool                          `env:"ETH_GAS_PRICE_DEFAULT_AUTO_WIDEN_MAX"`
+	EvmGasPriceDefaultSeedFromNetwork     bool                          `env:"ETH_GAS_PRICE_DEFAULT_SEED_FROM_NETWORK"`
+	EvmGasPriceDefaultUpdateInterval      time.Duration                 `env:"ETH_GAS_PRICE_DEFAULT_UPDATE_INTERVAL"`
+	EvmHeadTrackerBackfillDepth           uint                          `env:"ETH_HEAD_TRACKER_BACKFILL_DEPTH"`
+	EvmHeadTrackerHistoryDepth            uint                          `env:"ETH_HEAD_TRACKER_HISTORY_DEPTH"`
+	EvmHeadTrackerMaxBufferSize           uint                          `env:"ETH_HEAD_TRACKER_MAX_BUFFER_SIZE"`
+	EvmHeadTrackerMaxReorgDepth           uint                          `env:"ETH_HEAD_TRACKER_MAX_REORG_DEPTH"`
+	EvmHeadTrackerSamplingInterval        time.Duration                 `env:"ETH_HEAD_TRACKER_SAMPLING_INTERVAL"`
+	EvmHeadTrackerSamplingMode            string                        `env:"ETH_HEAD_TRACKER_SAMPLING_MODE"`
+	EvmLogBackfillBatchSize               uint32                        `env:"ETH_LOG_BACKFILL_BATCH_SIZE"`
+	EvmMaxGasPriceWei                     big.Int                       `env:"ETH_MAX_GAS_PRICE_WEI"`
+	EvmMaxGasPriceWeiCeiling              big.Int                       `env:"ETH_MAX_GAS_PRICE_WEI_CEILING"`
+	EvmMaxInFlightTransactions            uint32                        `env:"ETH_MAX_IN_FLIGHT_TRANSACTIONS"`
+	EvmMaxNonceGap                        uint64                        `env:"ETH_MAX_NONCE_GAP"`
+	EvmMaxQueuedTransactions              uint64                        `env:"ETH_MAX_QUEUED_TRANSACTIONS"`
+	EvmMinGasPriceWei                     *big.Int                      `env:"ETH_MIN_GAS_PRICE_WEI"`
+	EvmNonceAutoSync                      bool                          `env:"ETH_NONCE_AUTO_SYNC"`
+	EvmNonceGapAction                     string                        `env:"ETH_NONCE_GAP_ACTION"`
+	EvmInsufficientFundsAction            string                        `env:"ETH_INSUFFICIENT_FUNDS_ACTION"`
+	EvmRPCDefaultBatchSize                uint32                        `env:"ETH_RPC_DEFAULT_BATCH_SIZE"`
+	EvmReceiptFetchDepth                  uint                          `env:"ETH_RECEIPT_FETCH_DEPTH"`
+	EvmReceiptFetchMaxBlocks              uint64                        `env:"ETH_RECEIPT_FETCH_MAX_BLOCKS"`
+	EvmSimulateTransactionsBeforeSend     bool                          `env:"ETH_SIMULATE_TRANSACTIONS_BEFORE_SEND"`
+	EvmSkipEstimationForSimpleTransfers   bool                          `env:"ETH_SKIP_ESTIMATION_FOR_SIMPLE_TRANSFERS"`
+	EvmUseFinalityTag                     bool                          `env:"ETH_USE_FINALITY_TAG"`
 	ExplorerAccessKey                     string                        `env:"EXPLORER_ACCESS_KEY"`
 	ExplorerSecret                        string                        `env:"EXPLORER_SECRET"`
 	ExplorerURL                           *url.URL                      `env:"EXPLORER_URL"`
@@ -63,13 +110,16 @@ type ConfigSchema struct {
 	FeatureUICSAKeys                      bool                          `env:"FEATURE_UI_CSA_KEYS" default:"false"`
 	FeatureUIFeedsManager                 bool                          `env:"FEATURE_UI_FEEDS_MANAGER" default:"false"`
 	FeatureWebhookV2                      bool                          `env:"FEATURE_WEBHOOK_V2" default:"false"`
+	FlagsContractAddress                  string                        `env:"FLAGS_CONTRACT_ADDRESS"`
 	GasEstimatorMode                      string                        `env:"GAS_ESTIMATOR_MODE"`
+	EvmGasEstimatorRequireWarmup          bool                          `env:"GAS_ESTIMATOR_REQUIRE_WARMUP"`
 	GasUpdaterBatchSize                   uint32                        `env:"GAS_UPDATER_BATCH_SIZE"`
 	GasUpdaterBlockDelay                  uint16                        `env:"GAS_UPDATER_BLOCK_DELAY"`
 	GasUpdaterBlockHistorySize            uint16                        `env:"GAS_UPDATER_BLOCK_HISTORY_SIZE"`
 	GasUpdaterEnabled                     bool                          `env:"GAS_UPDATER_ENABLED"`
 	GasUpdaterTransactionPercentile       uint16                        `env:"GAS_UPDATER_TRANSACTION_PERCENTILE" default:"60"`
 	GlobalLockRetryInterval               models.Duration               `env:"GLOBAL_LOCK_RETRY_INTERVAL" default:"1s"`
+	GlobalMaxInFlightTransactions         uint32                        `env:"GLOBAL_MAX_IN_FLIGHT_TRANSACTIONS" default:"0"`
 	HTTPServerWriteTimeout                time.Duration                 `env:"HTTP_SERVER_WRITE_TIMEOUT" default:"10s"`
 	InsecureFastScrypt                    bool                          `env:"INSECURE_FAST_SCRYPT" default:"false"`
 	InsecureSkipVerify                    bool                          `env:"INSECURE_SKIP_VERIFY" default:"false"`
@@ -84,7 +134,9 @@ type ConfigSchema struct {
 	KeeperRegistryCheckGasOverhead        uint64                        `env:"KEEPER_REGISTRY_CHECK_GAS_OVERHEAD" default:"200000"`
 	KeeperRegistryPerformGasOverhead      uint64                        `env:"KEEPER_REGISTRY_PERFORM_GAS_OVERHEAD" default:"150000"`
 	KeeperRegistrySyncInterval            time.Duration                 `env:"KEEPER_REGISTRY_SYNC_INTERVAL" default:"30m"`
+	L1FinalityDepth                       uint                          `env:"ETH_L1_FINALITY_DEPTH"`
 	LinkContractAddress                   string                        `env:"LINK_CONTRACT_ADDRESS"`
+	LinkDecimals                          uint8                         `env:"LINK_DECIMALS"`
 	LogLevel                              LogLevel                      `env:"LOG_LEVEL" default:"info"`
 	LogSQLMigrations                      bool                          `env:"LOG_SQL_MIGRATIONS" default:"true"`
 	LogSQLStatements                      bool                          `env:"LOG_SQL" default:"false"`
@@ -93,6 +145,15 @@ type ConfigSchema struct {
 	MinIncomingConfirmations              uint32                        `env:"MIN_INCOMING_CONFIRMATIONS"`
 	MinRequiredOutgoingConfirmations      uint64                        `env:"MIN_OUTGOING_CONFIRMATIONS"`
 	MinimumContractPayment                assets.Link                   `env:"MINIMUM_CONTRACT_PAYMENT_LINK_JUELS"`
+	NativeTokenDecimals                   uint8                         `env:"NATIVE_TOKEN_DECIMALS"`
+	NativeTokenSymbol                     string                        `env:"NATIVE_TOKEN_SYMBOL"`
+	NodeCircuitBreakerCooldown            time.Duration                 `env:"ETH_NODE_CIRCUIT_BREAKER_COOLDOWN"`
+	NodeCircuitBreakerThreshold           uint32                        `env:"ETH_NODE_CIRCUIT_BREAKER_THRESHOLD"`
+	NodeRateLimitBurst                    int                           `env:"ETH_NODE_RATE_LIMIT_BURST"`
+	NodeRateLimitRPS                      float64                       `env:"ETH_NODE_RATE_LIMIT_RPS"`
+	NodeSelectionMode                     string                        `env:"ETH_NODE_SELECTION_MODE"`
+	NodeWSReconnectMaxBackoff             time.Duration                 `env:"ETH_NODE_WS_RECONNECT_MAX_BACKOFF"`
+	NodeWSReconnectMinBackoff             time.Duration                 `env:"ETH_NODE_WS_RECONNECT_MIN_BACKOFF"`
 	OCRBlockchainTimeout                  time.Duration                 `env:"OCR_BLOCKCHAIN_TIMEOUT" default:"20s"`
 	OCRBootstrapCheckInterval             time.Duration                 `env:"OCR_BOOTSTRAP_CHECK_INTERVAL" default:"20s"`
 	OCRContractConfirmations              uint                          `env:"OCR_CONTRACT_CONFIRMATIONS"`
@@ -130,8 +191,10 @@ type ConfigSchema struct {
 	Port                                  uint16                        `env:"CHAINLINK_PORT" default:"6688"`
 	ReaperExpiration                      models.Duration               `env:"REAPER_EXPIRATION" default:"240h"`
 	ReplayFromBlock                       int64                         `env:"REPLAY_FROM_BLOCK" default:"-1"`
+	RequireEIP155                         bool                          `env:"ETH_REQUIRE_EIP155"`
 	RootDir                               string                        `env:"ROOT" default:"~/.chainlink"`
 	SecureCookies                         bool                          `env:"SECURE_COOKIES" default:"true"`
+	SendOnlyNodeMinAccepts                uint32                        `env:"ETH_SEND_ONLY_NODE_MIN_ACCEPTS"`
 	SessionTimeout                        models.Duration               `env:"SESSION_TIMEOUT" default:"15m"`
 	StatsPusherLogging                    string                        `env:"STATS_PUSHER_LOGGING" default:"false"`
 	TelemetryIngressLogging               bool                          `env:"TELEMETRY_INGRESS_LOGGING" default:"false"`
@@ -157,6 +220,38 @@ func EnvVarName(field string) string {
 	return item.Tag.Get("env")
 }
 
+// ConfigKeyMeta describes a configurable parameter
+type ConfigKeyMeta struct {
+	// Name is the ConfigSchema field name, which is also the key under which
+	// the value is persisted
+	Name   string
+	EnvVar string
+	// Type is the Go type declared in ConfigSchema. This is the raw form of
+	// the value, e.g. "string" for a comma-separated list.
+	Type string
+	// Persistable is true if the value may also be set at runtime per chain
+	Persistable bool
+}
+
+// ConfigKeys lists every configurable parameter in ConfigSchema, sorted by
+// name, for generating admin forms and validating their input
+func ConfigKeys() []ConfigKeyMeta {
+	schemaT := reflect.TypeOf(ConfigSchema{})
+	keys := make([]ConfigKeyMeta, schemaT.NumField())
+	for i := range keys {
+		item := schemaT.Field(i)
+		_, persistable := persistedFields[item.Name]
+		keys[i] = ConfigKeyMeta{
+			Name:        item.Name,
+			EnvVar:      item.Tag.Get("env"),
+			Type:        item.Type.String(),
+			Persistable: persistable,
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i].Name < keys[j].Name })
+	return keys
+}
+
 func defaultValue(name string) (string, bool) {
 	schemaT := reflect.TypeOf(ConfigSchema{})
 	if item, ok := schemaT.FieldByName(name); ok {
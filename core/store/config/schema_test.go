@@ -2,6 +2,7 @@ package config
 
 import (
 	"reflect"
+	"sort"
 	"testing"
 
 	"github.com/smartcontractkit/chainlink/core/utils"
@@ -24,6 +25,7 @@ func TestConfigSchema(t *testing.T) {
 		"BridgeResponseURL":                          "BRIDGE_RESPONSE_URL",
 		"ChainID":                                    "ETH_CHAIN_ID",
 		"ClientNodeURL":                              "CLIENT_NODE_URL",
+		"ConfigRevalidationInterval":                 "CONFIG_REVALIDATION_INTERVAL",
 		"DatabaseBackupDir":                          "DATABASE_BACKUP_DIR",
 		"DatabaseBackupFrequency":                    "DATABASE_BACKUP_FREQUENCY",
 		"DatabaseBackupMode":                         "DATABASE_BACKUP_MODE",
@@ -39,8 +41,15 @@ func TestConfigSchema(t *testing.T) {
 		"DefaultMaxHTTPAttempts":                     "MAX_HTTP_ATTEMPTS",
 		"Dev":                                        "CHAINLINK_DEV",
 		"EvmBalanceMonitorBlockDelay":                "ETH_BALANCE_MONITOR_BLOCK_DELAY",
+		"EvmCallTimeout":                             "ETH_CALL_TIMEOUT",
+		"EvmConfirmerConcurrency":                    "ETH_CONFIRMER_CONCURRENCY",
+		"EvmDisabledServices":                        "ETH_DISABLED_SERVICES",
 		"EvmFinalityDepth":                           "ETH_FINALITY_DEPTH",
+		"EvmFinalityViolationAction":                 "ETH_FINALITY_VIOLATION_ACTION",
+		"EvmForceTxType":                             "ETH_FORCE_TX_TYPE",
+		"EvmGasBumpOverflowProtection":               "ETH_GAS_BUMP_OVERFLOW_PROTECTION",
 		"EvmGasBumpPercent":                          "ETH_GAS_BUMP_PERCENT",
+		"EvmGasBumpStrategy":                         "ETH_GAS_BUMP_STRATEGY",
 		"EvmGasBumpThreshold":                        "ETH_GAS_BUMP_THRESHOLD",
 		"EvmGasBumpTxDepth":                          "ETH_GAS_BUMP_TX_DEPTH",
 		"EvmGasBumpWei":                              "ETH_GAS_BUMP_WEI",
@@ -48,16 +57,32 @@ func TestConfigSchema(t *testing.T) {
 		"EvmGasLimitMultiplier":                      "ETH_GAS_LIMIT_MULTIPLIER",
 		"EvmGasLimitTransfer":                        "ETH_GAS_LIMIT_TRANSFER",
 		"EvmGasPriceDefault":                         "ETH_GAS_PRICE_DEFAULT",
+		"EvmGasPriceDefaultAutoWidenMax":             "ETH_GAS_PRICE_DEFAULT_AUTO_WIDEN_MAX",
+		"EvmGasPriceDefaultSeedFromNetwork":          "ETH_GAS_PRICE_DEFAULT_SEED_FROM_NETWORK",
+		"EvmGasPriceDefaultUpdateInterval":           "ETH_GAS_PRICE_DEFAULT_UPDATE_INTERVAL",
+		"EvmHeadTrackerBackfillDepth":                "ETH_HEAD_TRACKER_BACKFILL_DEPTH",
 		"EvmHeadTrackerHistoryDepth":                 "ETH_HEAD_TRACKER_HISTORY_DEPTH",
 		"EvmHeadTrackerMaxBufferSize":                "ETH_HEAD_TRACKER_MAX_BUFFER_SIZE",
+		"EvmHeadTrackerMaxReorgDepth":                "ETH_HEAD_TRACKER_MAX_REORG_DEPTH",
+		"EvmHeadTrackerSamplingMode":                 "ETH_HEAD_TRACKER_SAMPLING_MODE",
 		"EvmHeadTrackerSamplingInterval":             "ETH_HEAD_TRACKER_SAMPLING_INTERVAL",
+		"EvmIncomingConfirmationsFinalityFraction":   "ETH_INCOMING_CONFIRMATIONS_FINALITY_FRACTION",
 		"EvmLogBackfillBatchSize":                    "ETH_LOG_BACKFILL_BATCH_SIZE",
 		"EvmMaxGasPriceWei":                          "ETH_MAX_GAS_PRICE_WEI",
+		"EvmMaxGasPriceWeiCeiling":                   "ETH_MAX_GAS_PRICE_WEI_CEILING",
+		"EvmMaxNonceGap":                             "ETH_MAX_NONCE_GAP",
+		"EvmNonceGapAction":                          "ETH_NONCE_GAP_ACTION",
+		"EvmInsufficientFundsAction":                 "ETH_INSUFFICIENT_FUNDS_ACTION",
 		"EvmMaxInFlightTransactions":                 "ETH_MAX_IN_FLIGHT_TRANSACTIONS",
 		"EvmMaxQueuedTransactions":                   "ETH_MAX_QUEUED_TRANSACTIONS",
 		"EvmMinGasPriceWei":                          "ETH_MIN_GAS_PRICE_WEI",
 		"EvmNonceAutoSync":                           "ETH_NONCE_AUTO_SYNC",
+		"EvmReceiptFetchDepth":                       "ETH_RECEIPT_FETCH_DEPTH",
+		"EvmReceiptFetchMaxBlocks":                   "ETH_RECEIPT_FETCH_MAX_BLOCKS",
 		"EvmRPCDefaultBatchSize":                     "ETH_RPC_DEFAULT_BATCH_SIZE",
+		"EvmSimulateTransactionsBeforeSend":          "ETH_SIMULATE_TRANSACTIONS_BEFORE_SEND",
+		"EvmSkipEstimationForSimpleTransfers":        "ETH_SKIP_ESTIMATION_FOR_SIMPLE_TRANSFERS",
+		"EvmUseFinalityTag":                          "ETH_USE_FINALITY_TAG",
 		"EthTxReaperInterval":                        "ETH_TX_REAPER_INTERVAL",
 		"EthTxReaperThreshold":                       "ETH_TX_REAPER_THRESHOLD",
 		"EthTxResendAfterThreshold":                  "ETH_TX_RESEND_AFTER_THRESHOLD",
@@ -79,12 +104,14 @@ func TestConfigSchema(t *testing.T) {
 		"FeatureWebhookV2":                           "FEATURE_WEBHOOK_V2",
 		"FlagsContractAddress":                       "FLAGS_CONTRACT_ADDRESS",
 		"GasEstimatorMode":                           "GAS_ESTIMATOR_MODE",
+		"EvmGasEstimatorRequireWarmup":               "GAS_ESTIMATOR_REQUIRE_WARMUP",
 		"GasUpdaterBatchSize":                        "GAS_UPDATER_BATCH_SIZE",
 		"GasUpdaterBlockDelay":                       "GAS_UPDATER_BLOCK_DELAY",
 		"GasUpdaterBlockHistorySize":                 "GAS_UPDATER_BLOCK_HISTORY_SIZE",
 		"GasUpdaterEnabled":                          "GAS_UPDATER_ENABLED",
 		"GasUpdaterTransactionPercentile":            "GAS_UPDATER_TRANSACTION_PERCENTILE",
 		"GlobalLockRetryInterval":                    "GLOBAL_LOCK_RETRY_INTERVAL",
+		"GlobalMaxInFlightTransactions":              "GLOBAL_MAX_IN_FLIGHT_TRANSACTIONS",
 		"HTTPServerWriteTimeout":                     "HTTP_SERVER_WRITE_TIMEOUT",
 		"InsecureFastScrypt":                         "INSECURE_FAST_SCRYPT",
 		"InsecureSkipVerify":                         "INSECURE_SKIP_VERIFY",
@@ -99,7 +126,9 @@ func TestConfigSchema(t *testing.T) {
 		"KeeperRegistryCheckGasOverhead":             "KEEPER_REGISTRY_CHECK_GAS_OVERHEAD",
 		"KeeperRegistryPerformGasOverhead":           "KEEPER_REGISTRY_PERFORM_GAS_OVERHEAD",
 		"KeeperRegistrySyncInterval":                 "KEEPER_REGISTRY_SYNC_INTERVAL",
+		"L1FinalityDepth":                            "ETH_L1_FINALITY_DEPTH",
 		"LinkContractAddress":                        "LINK_CONTRACT_ADDRESS",
+		"LinkDecimals":                               "LINK_DECIMALS",
 		"LogLevel":                                   "LOG_LEVEL",
 		"LogSQLMigrations":                           "LOG_SQL_MIGRATIONS",
 		"LogSQLStatements":                           "LOG_SQL",
@@ -110,6 +139,15 @@ func TestConfigSchema(t *testing.T) {
 		"MinRequiredOutgoingConfirmations":           "MIN_OUTGOING_CONFIRMATIONS",
 		"MinimumContractPayment":                     "MINIMUM_CONTRACT_PAYMENT_LINK_JUELS",
 		"MinimumServiceDuration":                     "MINIMUM_SERVICE_DURATION",
+		"NativeTokenDecimals":                        "NATIVE_TOKEN_DECIMALS",
+		"NativeTokenSymbol":                          "NATIVE_TOKEN_SYMBOL",
+		"NodeCircuitBreakerCooldown":                 "ETH_NODE_CIRCUIT_BREAKER_COOLDOWN",
+		"NodeCircuitBreakerThreshold":                "ETH_NODE_CIRCUIT_BREAKER_THRESHOLD",
+		"NodeRateLimitBurst":                         "ETH_NODE_RATE_LIMIT_BURST",
+		"NodeRateLimitRPS":                           "ETH_NODE_RATE_LIMIT_RPS",
+		"NodeSelectionMode":                          "ETH_NODE_SELECTION_MODE",
+		"NodeWSReconnectMaxBackoff":                  "ETH_NODE_WS_RECONNECT_MAX_BACKOFF",
+		"NodeWSReconnectMinBackoff":                  "ETH_NODE_WS_RECONNECT_MIN_BACKOFF",
 		"OCRBlockchainTimeout":                       "OCR_BLOCKCHAIN_TIMEOUT",
 		"OCRBootstrapCheckInterval":                  "OCR_BOOTSTRAP_CHECK_INTERVAL",
 		"OCRContractConfirmations":                   "OCR_CONTRACT_CONFIRMATIONS",
@@ -149,8 +187,10 @@ func TestConfigSchema(t *testing.T) {
 		"Port":                                       "CHAINLINK_PORT",
 		"ReaperExpiration":                           "REAPER_EXPIRATION",
 		"ReplayFromBlock":                            "REPLAY_FROM_BLOCK",
+		"RequireEIP155":                              "ETH_REQUIRE_EIP155",
 		"RootDir":                                    "ROOT",
 		"SecureCookies":                              "SECURE_COOKIES",
+		"SendOnlyNodeMinAccepts":                     "ETH_SEND_ONLY_NODE_MIN_ACCEPTS",
 		"SessionTimeout":                             "SESSION_TIMEOUT",
 		"StatsPusherLogging":                         "STATS_PUSHER_LOGGING",
 		"TelemetryIngressLogging":                    "TELEMETRY_INGRESS_LOGGING",
@@ -195,3 +235,75 @@ func TestConfigSchema(t *testing.T) {
 		assert.Equal(t, item, env)
 	}
 }
+
+func TestConfigKeys(t *testing.T) {
+	keys := ConfigKeys()
+	assert.Len(t, keys, reflect.TypeOf(ConfigSchema{}).NumField())
+	assert.True(t, sort.SliceIsSorted(keys, func(i, j int) bool { return keys[i].Name < keys[j].Name }))
+
+	byName := make(map[string]ConfigKeyMeta, len(keys))
+	for _, key := range keys {
+		assert.NotEmpty(t, key.EnvVar, key.Name)
+		assert.NotEmpty(t, key.Type, key.Name)
+		_, persisted := persistedFields[key.Name]
+		assert.Equal(t, persisted, key.Persistable, key.Name)
+		byName[key.Name] = key
+	}
+	for field := range persistedFields {
+		assert.Contains(t, byName, field)
+	}
+	assert.Equal(t, ConfigKeyMeta{Name: "EvmFinalityDepth", EnvVar: "ETH_FINALITY_DEPTH", Type: "uint"}, byName["EvmFinalityDepth"])
+	assert.Equal(t, ConfigKeyMeta{Name: "EvmCallTimeout", EnvVar: "ETH_CALL_TIMEOUT", Type: "time.Duration", Persistable: true}, byName["EvmCallTimeout"])
+
+	// Getters that read more than one key
+	composite := map[string][]string{
+		"NodeRateLimit": {"NodeRateLimitRPS", "NodeRateLimitBurst"},
+	}
+	// Methods that are not backed by a key of their own, either because they
+	// derive a value from other keys or chain defaults, or are not getters
+	notKeys := map[string]bool{
+		"ApplyGasLimitMultiplier":           true,
+		"ApplyTOML":                         true,
+		"BlockEmissionIdleWarningThreshold": true,
+		"ClampGasPrice":                     true,
+		"Close":                             true,
+		"ConfigOverrideConflicts":           true,
+		"EffectiveIncomingConfirmations":    true,
+		"EffectiveOutgoingConfirmations":    true,
+		"EvmChainEnabled":                   true,
+		"EvmDefaultBatchSize":               true,
+		"EvmServiceDisabled":                true,
+		"ExportTOML":                        true,
+		"GasPriceEnvelope":                  true,
+		"IsTxFinal":                         true,
+		"NextBumpedGasPrice":                true,
+		"OCRTimeouts":                       true,
+		"ReloadPersistedConfig":             true,
+		"SeedEvmGasPriceDefault":            true,
+		"SetEvmGasPriceDefault":             true,
+		"SetEvmGasPriceDefaultCtx":          true,
+		"SetEvmMaxGasPriceWei":              true,
+		"SignerChainID":                     true,
+		"Validate":                          true,
+		"ValidatePersisted":                 true,
+	}
+
+	evmT := reflect.TypeOf((*EVMOnlyConfig)(nil)).Elem()
+	for i := 0; i < evmT.NumMethod(); i++ {
+		name := evmT.Method(i).Name
+		if notKeys[name] {
+			continue
+		}
+		if fields, ok := composite[name]; ok {
+			for _, field := range fields {
+				assert.Contains(t, byName, field, "%s reads %s, which must be in ConfigSchema", name, field)
+			}
+			continue
+		}
+		assert.Contains(t, byName, name, "EVMOnlyConfig.%s has no ConfigSchema field; add one, or list it as not a key if it is derived", name)
+	}
+	for name := range notKeys {
+		_, ok := evmT.MethodByName(name)
+		assert.True(t, ok, "%s is not an EVMOnlyConfig method", name)
+	}
+}
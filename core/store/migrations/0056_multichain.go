@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"math/big"
 	"os"
+	"strconv"
 
 	"gorm.io/gorm"
 )
@@ -33,7 +34,9 @@ CREATE TABLE nodes (
 
 CREATE INDEX idx_nodes_evm_chain_id ON nodes (evm_chain_id);
 CREATE UNIQUE INDEX idx_nodes_unique_name ON nodes (lower(name));
+`
 
+const seed56 = `
 INSERT INTO evm_chains (id, created_at, updated_at) VALUES (%[1]s, NOW(), NOW());
 `
 
@@ -46,6 +49,16 @@ func init() {
 	Migrations = append(Migrations, &Migration{
 		ID: "0056_multichain",
 		Migrate: func(db *gorm.DB) error {
+			if err := db.Exec(up56).Error; err != nil {
+				return err
+			}
+
+			// Fresh multichain installs may set this to add their chains
+			// explicitly instead of seeding the legacy single chain
+			if skip, _ := strconv.ParseBool(os.Getenv("CHAINLINK_SKIP_LEGACY_CHAIN_SEED")); skip {
+				return nil
+			}
+
 			chainIDStr := os.Getenv("ETH_CHAIN_ID")
 			if chainIDStr == "" {
 				chainIDStr = "1"
@@ -55,7 +68,7 @@ func init() {
 				panic(fmt.Sprintf("ETH_CHAIN_ID was invalid, expected a number, got: %s", chainIDStr))
 			}
 
-			sql := fmt.Sprintf(up56, chainID.String())
+			sql := fmt.Sprintf(seed56, chainID.String())
 			return db.Exec(sql).Error
 		},
 		Rollback: func(db *gorm.DB) error {
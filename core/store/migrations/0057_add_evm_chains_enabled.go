@@ -0,0 +1,25 @@
+package migrations
+
+import (
+	"gorm.io/gorm"
+)
+
+const up57 = `
+ALTER TABLE evm_chains ADD COLUMN enabled BOOL NOT NULL DEFAULT TRUE;
+`
+
+const down57 = `
+ALTER TABLE evm_chains DROP COLUMN enabled;
+`
+
+func init() {
+	Migrations = append(Migrations, &Migration{
+		ID: "0057_add_evm_chains_enabled",
+		Migrate: func(db *gorm.DB) error {
+			return db.Exec(up57).Error
+		},
+		Rollback: func(db *gorm.DB) error {
+			return db.Exec(down57).Error
+		},
+	})
+}
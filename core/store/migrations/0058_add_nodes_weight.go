@@ -0,0 +1,25 @@
+package migrations
+
+import (
+	"gorm.io/gorm"
+)
+
+const up58 = `
+ALTER TABLE nodes ADD COLUMN weight INT NOT NULL DEFAULT 1 CHECK (weight >= 0);
+`
+
+const down58 = `
+ALTER TABLE nodes DROP COLUMN weight;
+`
+
+func init() {
+	Migrations = append(Migrations, &Migration{
+		ID: "0058_add_nodes_weight",
+		Migrate: func(db *gorm.DB) error {
+			return db.Exec(up58).Error
+		},
+		Rollback: func(db *gorm.DB) error {
+			return db.Exec(down58).Error
+		},
+	})
+}
@@ -0,0 +1,27 @@
+package migrations
+
+import (
+	"gorm.io/gorm"
+)
+
+// Existing rows predate ChainCfg versioning, so they are stamped as version 1
+// and upgraded in Go on load
+const up59 = `
+UPDATE evm_chains SET cfg = jsonb_set(cfg, '{cfgVersion}', '1') WHERE cfg->'cfgVersion' IS NULL;
+`
+
+const down59 = `
+UPDATE evm_chains SET cfg = cfg - 'cfgVersion';
+`
+
+func init() {
+	Migrations = append(Migrations, &Migration{
+		ID: "0059_stamp_evm_chains_cfg_version",
+		Migrate: func(db *gorm.DB) error {
+			return db.Exec(up59).Error
+		},
+		Rollback: func(db *gorm.DB) error {
+			return db.Exec(down59).Error
+		},
+	})
+}
@@ -0,0 +1,34 @@
+package migrations
+
+import (
+	"gorm.io/gorm"
+)
+
+const up60 = `
+CREATE TABLE evm_chain_config_audit (
+	id bigserial PRIMARY KEY,
+	evm_chain_id numeric(78,0) NOT NULL,
+	key text NOT NULL,
+	old_value text,
+	new_value text NOT NULL,
+	changed_at timestamptz NOT NULL
+);
+
+CREATE INDEX idx_evm_chain_config_audit_evm_chain_id_key ON evm_chain_config_audit (evm_chain_id, key, changed_at);
+`
+
+const down60 = `
+DROP TABLE evm_chain_config_audit;
+`
+
+func init() {
+	Migrations = append(Migrations, &Migration{
+		ID: "0060_add_evm_chain_config_audit",
+		Migrate: func(db *gorm.DB) error {
+			return db.Exec(up60).Error
+		},
+		Rollback: func(db *gorm.DB) error {
+			return db.Exec(down60).Error
+		},
+	})
+}
@@ -0,0 +1,25 @@
+package migrations
+
+import (
+	"gorm.io/gorm"
+)
+
+const up61 = `
+ALTER TABLE nodes ADD COLUMN tags jsonb NOT NULL DEFAULT '{}';
+`
+
+const down61 = `
+ALTER TABLE nodes DROP COLUMN tags;
+`
+
+func init() {
+	Migrations = append(Migrations, &Migration{
+		ID: "0061_add_nodes_tags",
+		Migrate: func(db *gorm.DB) error {
+			return db.Exec(up61).Error
+		},
+		Rollback: func(db *gorm.DB) error {
+			return db.Exec(down61).Error
+		},
+	})
+}
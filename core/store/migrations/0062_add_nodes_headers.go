@@ -0,0 +1,25 @@
+package migrations
+
+import (
+	"gorm.io/gorm"
+)
+
+const up62 = `
+ALTER TABLE nodes ADD COLUMN headers jsonb NOT NULL DEFAULT '{}';
+`
+
+const down62 = `
+ALTER TABLE nodes DROP COLUMN headers;
+`
+
+func init() {
+	Migrations = append(Migrations, &Migration{
+		ID: "0062_add_nodes_headers",
+		Migrate: func(db *gorm.DB) error {
+			return db.Exec(up62).Error
+		},
+		Rollback: func(db *gorm.DB) error {
+			return db.Exec(down62).Error
+		},
+	})
+}
@@ -0,0 +1,25 @@
+package migrations
+
+import (
+	"gorm.io/gorm"
+)
+
+const up63 = `
+ALTER TABLE nodes ADD COLUMN max_batch_size integer CHECK (max_batch_size > 0);
+`
+
+const down63 = `
+ALTER TABLE nodes DROP COLUMN max_batch_size;
+`
+
+func init() {
+	Migrations = append(Migrations, &Migration{
+		ID: "0063_add_nodes_max_batch_size",
+		Migrate: func(db *gorm.DB) error {
+			return db.Exec(up63).Error
+		},
+		Rollback: func(db *gorm.DB) error {
+			return db.Exec(down63).Error
+		},
+	})
+}
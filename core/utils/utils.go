@@ -209,6 +209,18 @@ func NewBackoffSleeper() *BackoffSleeper {
 	}
 }
 
+// NewBackoffSleeperWithBounds returns a BackoffSleeper that sleeps for 0
+// seconds initially, then backs off from min to max.
+func NewBackoffSleeperWithBounds(min, max time.Duration) *BackoffSleeper {
+	return &BackoffSleeper{
+		Backoff: backoff.Backoff{
+			Min: min,
+			Max: max,
+		},
+		beenRun: abool.New(),
+	}
+}
+
 // Sleep waits for the given duration, incrementing the back off.
 func (bs *BackoffSleeper) Sleep() {
 	if bs.beenRun.SetToIf(false, true) {
@@ -95,6 +95,17 @@ func TestUtils_BackoffSleeper(t *testing.T) {
 	assert.Equal(t, time.Duration(0), bs.Duration(), "should initially return immediately")
 }
 
+func TestUtils_BackoffSleeperWithBounds(t *testing.T) {
+	t.Parallel()
+
+	bs := utils.NewBackoffSleeperWithBounds(5*time.Second, 12*time.Second)
+	assert.Equal(t, time.Duration(0), bs.After(), "should initially return immediately")
+	assert.Equal(t, 5*time.Second, bs.After())
+	assert.Equal(t, 10*time.Second, bs.After())
+	assert.Equal(t, 12*time.Second, bs.After())
+	assert.Equal(t, 12*time.Second, bs.After())
+}
+
 func TestUtils_DurationFromNow(t *testing.T) {
 	t.Parallel()
 	future := time.Now().Add(time.Second)
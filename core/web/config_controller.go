@@ -67,7 +67,7 @@ func (cc *ConfigController) Patch(c *gin.Context) {
 
 	// TODO: Remove this from the configurations ORM after multichain
 	// See: https://app.clubhouse.io/chainlinklabs/story/12739/generalise-necessary-models-tables-on-the-send-side-to-support-the-concept-of-multiple-chains
-	if err := cc.App.GetEVMConfig().SetEvmGasPriceDefault(request.EvmGasPriceDefault.ToInt()); err != nil {
+	if err := cc.App.GetEVMConfig().SetEvmGasPriceDefaultCtx(c.Request.Context(), request.EvmGasPriceDefault.ToInt()); err != nil {
 		jsonAPIError(c, http.StatusInternalServerError, fmt.Errorf("failed to set gas price default: %+v", err))
 		return
 	}
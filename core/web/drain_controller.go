@@ -0,0 +1,73 @@
+package web
+
+import (
+	"math/big"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/store/config"
+)
+
+type DrainController struct {
+	App chainlink.Application
+}
+
+// Drain stops the transaction manager of the chain given by the optional
+// evmChainID query parameter, or the default chain, from accepting new
+// transactions, so that pending ones can confirm before a restart
+// Example:
+//  "<application>/v2/transactions/drain?evmChainID=1"
+func (dc *DrainController) Drain(c *gin.Context) {
+	dc.setDraining(c, true)
+}
+
+// Undrain makes a drained transaction manager accept new transactions again
+// Example:
+//  "<application>/v2/transactions/drain?evmChainID=1"
+func (dc *DrainController) Undrain(c *gin.Context) {
+	dc.setDraining(c, false)
+}
+
+func (dc *DrainController) setDraining(c *gin.Context, draining bool) {
+	var chainID *big.Int
+	if c.Query("evmChainID") != "" {
+		var ok bool
+		chainID, ok = new(big.Int).SetString(c.Query("evmChainID"), 10)
+		if !ok {
+			jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("invalid evmChainID: %s", c.Query("evmChainID")))
+			return
+		}
+	}
+
+	if err := dc.App.SetTxManagerDraining(chainID, draining); errors.Is(err, config.ErrChainNotFound) {
+		jsonAPIError(c, http.StatusNotFound, err)
+		return
+	} else if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, &DrainResponse{Draining: draining}, "drain")
+}
+
+type DrainResponse struct {
+	Draining bool `json:"draining"`
+}
+
+// GetID returns the jsonapi ID.
+func (DrainResponse) GetID() string {
+	return "drain"
+}
+
+// GetName returns the collection name for jsonapi.
+func (DrainResponse) GetName() string {
+	return "drain"
+}
+
+// SetID is used to conform to the UnmarshallIdentifier interface for
+// deserializing from jsonapi documents.
+func (*DrainResponse) SetID(string) error {
+	return nil
+}
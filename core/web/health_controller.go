@@ -1,6 +1,7 @@
 package web
 
 import (
+	"fmt"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
@@ -9,6 +10,9 @@ import (
 	"github.com/smartcontractkit/chainlink/core/web/presenters"
 )
 
+// pinnedNodeCheck names the informational check reporting a pinned node
+const pinnedNodeCheck = "EthClient.PinnedNode"
+
 type HealthController struct {
 	App chainlink.Application
 }
@@ -89,6 +93,16 @@ func (hc *HealthController) Health(c *gin.Context) {
 		})
 	}
 
+	// A pinned node is worth knowing about when debugging, but is not a failure
+	if name, pinned, err := hc.App.PinnedNode(nil); err == nil && pinned {
+		checks = append(checks, presenters.Check{
+			JAID:   presenters.NewJAID(pinnedNodeCheck),
+			Name:   pinnedNodeCheck,
+			Status: health.StatusPassing,
+			Output: fmt.Sprintf("all traffic is pinned to node %s", name),
+		})
+	}
+
 	// return a json description of all the checks
 	jsonAPIResponse(c, checks, "checks")
 }
@@ -0,0 +1,92 @@
+package web
+
+import (
+	"math/big"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/services/eth"
+	"github.com/smartcontractkit/chainlink/core/store/config"
+)
+
+type NodePinController struct {
+	App chainlink.Application
+}
+
+// Pin sends all traffic of the chain given by the optional evmChainID query
+// parameter, or the default chain, to a single node, bypassing rotation and
+// circuit breakers. The primary has ID 0 and secondaries are numbered from 1.
+// Pinning is not persisted.
+// Example:
+//  "<application>/v2/nodes/pin/1?evmChainID=1"
+func (npc *NodePinController) Pin(c *gin.Context) {
+	nodeID, err := strconv.ParseInt(c.Param("nodeID"), 10, 32)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("invalid node ID: %s", c.Param("nodeID")))
+		return
+	}
+	npc.setPinned(c, func(chainID *big.Int) error {
+		return npc.App.PinNode(chainID, int32(nodeID))
+	})
+}
+
+// Unpin restores normal rotation after Pin
+// Example:
+//  "<application>/v2/nodes/pin?evmChainID=1"
+func (npc *NodePinController) Unpin(c *gin.Context) {
+	npc.setPinned(c, npc.App.UnpinNode)
+}
+
+func (npc *NodePinController) setPinned(c *gin.Context, set func(chainID *big.Int) error) {
+	var chainID *big.Int
+	if c.Query("evmChainID") != "" {
+		var ok bool
+		chainID, ok = new(big.Int).SetString(c.Query("evmChainID"), 10)
+		if !ok {
+			jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("invalid evmChainID: %s", c.Query("evmChainID")))
+			return
+		}
+	}
+
+	err := set(chainID)
+	var resp NodePinResponse
+	if err == nil {
+		resp.NodeName, resp.Pinned, err = npc.App.PinnedNode(chainID)
+	}
+	if errors.Is(err, config.ErrChainNotFound) {
+		jsonAPIError(c, http.StatusNotFound, err)
+		return
+	} else if errors.Is(err, eth.ErrNodeNotFound) || errors.Is(err, chainlink.ErrNodePinningNotSupported) {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	} else if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, &resp, "node_pin")
+}
+
+type NodePinResponse struct {
+	Pinned   bool   `json:"pinned"`
+	NodeName string `json:"nodeName,omitempty"`
+}
+
+// GetID returns the jsonapi ID.
+func (NodePinResponse) GetID() string {
+	return "node_pin"
+}
+
+// GetName returns the collection name for jsonapi.
+func (NodePinResponse) GetName() string {
+	return "node_pin"
+}
+
+// SetID is used to conform to the UnmarshallIdentifier interface for
+// deserializing from jsonapi documents.
+func (*NodePinResponse) SetID(string) error {
+	return nil
+}
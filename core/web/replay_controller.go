@@ -1,12 +1,14 @@
 package web
 
 import (
+	"math/big"
 	"net/http"
 	"strconv"
 
 	"github.com/gin-gonic/gin"
 	"github.com/pkg/errors"
 	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/store/config"
 )
 
 type ReplayController struct {
@@ -14,8 +16,10 @@ type ReplayController struct {
 }
 
 // ReplayFromBlock causes the node to process blocks again from the given block number
+// on the chain given by the optional evmChainID query parameter, or the
+// default chain
 // Example:
-//  "<application>/v2/replay_from_block/:number"
+//  "<application>/v2/replay_from_block/:number?evmChainID=1"
 func (bdc *ReplayController) ReplayFromBlock(c *gin.Context) {
 
 	if c.Param("number") == "" {
@@ -32,7 +36,24 @@ func (bdc *ReplayController) ReplayFromBlock(c *gin.Context) {
 		jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("block number cannot be negative: %v", blockNumber))
 		return
 	}
-	if err := bdc.App.ReplayFromBlock(uint64(blockNumber)); err != nil {
+
+	var chainID *big.Int
+	if c.Query("evmChainID") != "" {
+		var ok bool
+		chainID, ok = new(big.Int).SetString(c.Query("evmChainID"), 10)
+		if !ok {
+			jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("invalid evmChainID: %s", c.Query("evmChainID")))
+			return
+		}
+	}
+
+	if err := bdc.App.ReplayFromBlock(c.Request.Context(), chainID, uint64(blockNumber)); errors.Is(err, config.ErrChainNotFound) {
+		jsonAPIError(c, http.StatusNotFound, err)
+		return
+	} else if errors.Is(err, chainlink.ErrReplayBeyondHead) {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	} else if err != nil {
 		jsonAPIError(c, http.StatusInternalServerError, err)
 		return
 	}
@@ -244,6 +244,14 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		authv2.GET("/transactions", paginatedRequest(txs.Index))
 		authv2.GET("/transactions/:TxHash", txs.Show)
 
+		dc := DrainController{app}
+		authv2.POST("/transactions/drain", dc.Drain)
+		authv2.DELETE("/transactions/drain", dc.Undrain)
+
+		npc := NodePinController{app}
+		authv2.POST("/nodes/pin/:nodeID", npc.Pin)
+		authv2.DELETE("/nodes/pin", npc.Unpin)
+
 		rc := ReplayController{app}
 		authv2.POST("/replay_from_block/:number", rc.ReplayFromBlock)
 